@@ -34,7 +34,8 @@ func EntryCheck(want, have any, opts ...any) error {
 		check.Equal(w.m, h.m, fName("m")),
 		check.Equal(w.raw, h.raw, fName("raw")),
 		check.Equal(w.idx, h.idx, fName("idx")),
-		check.Fields(5, w, fName("{field count}")),
+		check.Equal(w.meta, h.meta, fName("meta")),
+		check.Fields(7, w, fName("{field count}")),
 	}
 	return notice.Join(ers...)
 }
@@ -45,7 +46,7 @@ func MustEntries(t tester.T, raws ...string) Entries {
 	cfg := DefaultConfig()
 	ets := Entries{cfg: cfg, ets: make([]Entry, 0, 10), t: t}
 	for i, raw := range raws {
-		ent := Entry{cfg: cfg, raw: raw, idx: i, t: t}
+		ent := Entry{cfg: cfg, raw: raw, idx: i, t: t, exact: &exactFields{}}
 		if err := json.Unmarshal([]byte(raw), &ent.m); err != nil {
 			panic(err.Error())
 		}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// ReadBaseline reads the log volume baseline (the number of log entries) from
+// the file at the given path. It marks the test as failed and returns -1 if
+// the file cannot be read or does not contain an integer.
+//
+// Example usage:
+//
+//	base := logkit.ReadBaseline(t, "testdata/hot_path.baseline")
+//	tst.Entries().AssertCountNear(base, 10)
+func ReadBaseline(t tester.T, pth string) int {
+	t.Helper()
+	buf, err := os.ReadFile(pth)
+	if err != nil {
+		t.Error(err)
+		return -1
+	}
+	cnt, err := strconv.Atoi(strings.TrimSpace(string(buf)))
+	if err != nil {
+		msg := notice.New("expected baseline file to contain an integer").
+			Append("path", "%s", pth).
+			Append("error", "%s", err)
+		t.Error(msg)
+		return -1
+	}
+	return cnt
+}
+
+// WriteBaseline writes the log volume baseline (the number of log entries) to
+// the file at the given path, creating or truncating it. It marks the test as
+// failed if the file cannot be written.
+func WriteBaseline(t tester.T, pth string, cnt int) {
+	t.Helper()
+	data := []byte(strconv.Itoa(cnt) + "\n")
+	if err := os.WriteFile(pth, data, 0o644); err != nil {
+		t.Error(err)
+	}
+}
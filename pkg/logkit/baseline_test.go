@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_ReadBaseline(t *testing.T) {
+	t.Run("read baseline", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		// --- When ---
+		have := ReadBaseline(tspy, "testdata/baseline.txt")
+
+		// --- Then ---
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("error - file does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "open testdata/not_existing.txt: no such file or directory"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := ReadBaseline(tspy, "testdata/not_existing.txt")
+
+		// --- Then ---
+		assert.Equal(t, -1, have)
+	})
+
+	t.Run("error - not an integer", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected baseline file to contain an integer")
+		tspy.Close()
+
+		// --- When ---
+		have := ReadBaseline(tspy, "testdata/log.log")
+
+		// --- Then ---
+		assert.Equal(t, -1, have)
+	})
+}
+
+func Test_WriteBaseline(t *testing.T) {
+	t.Run("write baseline", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "baseline.txt")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		// --- When ---
+		WriteBaseline(tspy, pth, 123)
+
+		// --- Then ---
+		assert.Equal(t, "123\n", string(must.Value(os.ReadFile(pth))))
+		assert.Equal(t, 123, ReadBaseline(tspy, pth))
+		fi := must.Value(os.Stat(pth))
+		assert.Equal(t, os.FileMode(0o044), fi.Mode().Perm()&0o044)
+	})
+
+	t.Run("error - directory does not exist", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "not_existing", "baseline.txt")
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("no such file or directory")
+		tspy.Close()
+
+		// --- When ---
+		WriteBaseline(tspy, pth, 123)
+	})
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Caller represents the source code location a log entry was written from.
+type Caller struct {
+	File string // Source file path as logged.
+	Line int    // Line number, zero when not logged.
+	Func string // Function name, empty when not logged.
+}
+
+// String returns the caller in the "file:line" format. The line is omitted
+// when it's zero.
+func (cll Caller) String() string {
+	if cll.Line == 0 {
+		return cll.File
+	}
+	return cll.File + ":" + strconv.Itoa(cll.Line)
+}
+
+// matchFile returns true if the caller file path is equal to the given path
+// or ends with it on a path separator boundary.
+func (cll Caller) matchFile(pth string) bool {
+	return cll.File == pth || strings.HasSuffix(cll.File, "/"+pth)
+}
+
+// contains returns true if the caller function name or its "file:line"
+// representation contains the given value.
+func (cll Caller) contains(want string) bool {
+	return strings.Contains(cll.Func, want) ||
+		strings.Contains(cll.String(), want)
+}
+
+// parseCaller parses caller in the "file:line" format used by `zerolog`,
+// `zap`, `logrus` and `hclog`. When the line is missing, the whole string is
+// used as the file path.
+func parseCaller(str string) Caller {
+	if idx := strings.LastIndexByte(str, ':'); idx > 0 {
+		if line, err := strconv.Atoi(str[idx+1:]); err == nil {
+			return Caller{File: str[:idx], Line: line}
+		}
+	}
+	return Caller{File: str}
+}
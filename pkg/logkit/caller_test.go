@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_Caller_String(t *testing.T) {
+	t.Run("with line", func(t *testing.T) {
+		// --- Given ---
+		cll := Caller{File: "pkg/file.go", Line: 42}
+
+		// --- When ---
+		have := cll.String()
+
+		// --- Then ---
+		assert.Equal(t, "pkg/file.go:42", have)
+	})
+
+	t.Run("without line", func(t *testing.T) {
+		// --- Given ---
+		cll := Caller{File: "pkg/file.go"}
+
+		// --- When ---
+		have := cll.String()
+
+		// --- Then ---
+		assert.Equal(t, "pkg/file.go", have)
+	})
+}
+
+func Test_Caller_matchFile(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		file string
+		pth  string
+		want bool
+	}{
+		{"equal", "pkg/file.go", "pkg/file.go", true},
+		{"suffix", "/src/app/pkg/file.go", "pkg/file.go", true},
+		{"file name", "/src/app/pkg/file.go", "file.go", true},
+		{"partial name", "/src/app/pkg/myfile.go", "file.go", false},
+		{"different", "/src/app/pkg/file.go", "other.go", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			cll := Caller{File: tc.file}
+
+			// --- When ---
+			have := cll.matchFile(tc.pth)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Caller_contains(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		want string
+		ok   bool
+	}{
+		{"function", "pkg.Run", true},
+		{"file", "pkg/file.go", true},
+		{"file and line", "file.go:42", true},
+		{"different", "other", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			cll := Caller{File: "/src/pkg/file.go", Line: 42, Func: "pkg.Run"}
+
+			// --- When ---
+			have := cll.contains(tc.want)
+
+			// --- Then ---
+			assert.Equal(t, tc.ok, have)
+		})
+	}
+}
+
+func Test_parseCaller(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		str  string
+		file string
+		line int
+	}{
+		{"file and line", "pkg/file.go:42", "pkg/file.go", 42},
+		{"file only", "pkg/file.go", "pkg/file.go", 0},
+		{"windows path", `C:\app\file.go:7`, `C:\app\file.go`, 7},
+		{"not a line", "file.go:abc", "file.go:abc", 0},
+		{"empty", "", "", 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := parseCaller(tc.str)
+
+			// --- Then ---
+			assert.Equal(t, Caller{File: tc.file, Line: tc.line}, have)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"io"
+	"os"
+
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// CaptureStderr creates a new [Tester] and redirects [os.Stderr] to it until
+// the test ends. Log entries get the [StreamField] field set to
+// [StreamStderr], and lines which are not JSON objects are captured the same
+// way as in [Tester.AttachCmd]. It is useful for third-party libraries which
+// construct their own loggers writing to [os.Stderr].
+//
+// On Unix systems, except Solaris, the standard error file descriptor is
+// redirected, so writes to [os.Stderr] obtained before the call, by the
+// runtime and by the child processes are captured too. On other systems only
+// code using the [os.Stderr] variable after the call is captured. Tests using
+// it must not run in parallel.
+func CaptureStderr(t tester.T, opts ...func(*Tester)) *Tester {
+	t.Helper()
+	return capture(t, &os.Stderr, StreamStderr, opts...)
+}
+
+// CaptureStdout works like [CaptureStderr] but redirects [os.Stdout] and sets
+// the [StreamField] field to [StreamStdout]. Since the "go test" command
+// writes its output to the standard output, the output of the test framework
+// written while capturing is captured as well.
+func CaptureStdout(t tester.T, opts ...func(*Tester)) *Tester {
+	t.Helper()
+	return capture(t, &os.Stdout, StreamStdout, opts...)
+}
+
+// capture creates a new [Tester] and redirects the file to it until the test
+// ends.
+func capture(
+	t tester.T,
+	fil **os.File,
+	stream string,
+	opts ...func(*Tester),
+) *Tester {
+
+	t.Helper()
+	tst := New(t, opts...)
+	rdr, wrt, err := os.Pipe()
+	if err != nil {
+		t.Error(err)
+		return nil
+	}
+
+	restore, err := redirect(fil, wrt)
+	if err != nil {
+		_ = rdr.Close()
+		_ = wrt.Close()
+		t.Error(err)
+		return nil
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lw := &lineWriter{fn: tst.streamLine(stream)}
+		_, _ = io.Copy(lw, rdr)
+		lw.flush()
+	}()
+
+	t.Cleanup(func() {
+		restore()
+		_ = wrt.Close()
+		<-done
+		_ = rdr.Close()
+	})
+	return tst
+}
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+//go:build unix && !linux && !solaris
+
+package logkit
+
+import (
+	"syscall"
+)
+
+// dup2 duplicates the oldfd file descriptor to newfd.
+func dup2(oldfd, newfd int) error {
+	return syscall.Dup2(oldfd, newfd)
+}
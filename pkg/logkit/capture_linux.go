@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"syscall"
+)
+
+// dup2 duplicates the oldfd file descriptor to newfd. The dup3 system call
+// is used since not all Linux architectures have the dup2 one.
+func dup2(oldfd, newfd int) error {
+	return syscall.Dup3(oldfd, newfd, 0)
+}
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+//go:build !unix || solaris
+
+package logkit
+
+import (
+	"os"
+)
+
+// redirect replaces the file with the pipe writer. Returns the function
+// restoring the original file.
+func redirect(fil **os.File, wrt *os.File) (func(), error) {
+	orig := *fil
+	*fil = wrt
+	return func() { *fil = orig }, nil
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_CaptureStderr(t *testing.T) {
+	// --- Given ---
+	orig := os.Stderr
+
+	tspy := tester.New(t)
+	tspy.ExpectCleanups(1)
+	tspy.Close()
+
+	// --- When ---
+	tst := CaptureStderr(tspy)
+
+	// --- Then ---
+	_, _ = fmt.Fprintln(os.Stderr, `{"level":"error","message":"msg0"}`)
+	_, _ = fmt.Fprint(os.Stderr, `text`)
+	tspy.Finish()
+
+	assert.Same(t, orig, os.Stderr)
+	want := "" +
+		`{"stream":"stderr","level":"error","message":"msg0"}` + "\n" +
+		`{"stream":"stderr","message":"text"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
+
+func Test_CaptureStdout(t *testing.T) {
+	// --- Given ---
+	orig := os.Stdout
+
+	tspy := tester.New(t)
+	tspy.ExpectCleanups(1)
+	tspy.Close()
+
+	// --- When ---
+	tst := CaptureStdout(tspy, WithMaxEntries(1))
+
+	// --- Then ---
+	_, _ = fmt.Fprintln(os.Stdout, `{"message":"msg0"}`)
+	_, _ = fmt.Fprintln(os.Stdout, `{"message":"msg1"}`)
+	tspy.Finish()
+
+	assert.Same(t, orig, os.Stdout)
+	want := `{"stream":"stdout","message":"msg1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
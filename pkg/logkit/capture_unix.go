@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+//go:build unix && !solaris
+
+package logkit
+
+import (
+	"os"
+	"syscall"
+)
+
+// redirect makes the file descriptor of the file refer to the pipe writer,
+// so all writes to it, also through the copies of the file obtained before
+// the call, go to the pipe. Returns the function restoring the original file
+// descriptor.
+func redirect(fil **os.File, wrt *os.File) (func(), error) {
+	fd := int((*fil).Fd())
+	saved, err := syscall.Dup(fd)
+	if err != nil {
+		return nil, err
+	}
+	if err = dup2(int(wrt.Fd()), fd); err != nil {
+		_ = syscall.Close(saved)
+		return nil, err
+	}
+	return func() {
+		_ = dup2(saved, fd)
+		_ = syscall.Close(saved)
+	}, nil
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+//go:build unix && !solaris
+
+package logkit
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_CaptureStderr_fileDescriptor(t *testing.T) {
+	// --- Given ---
+	orig := os.Stderr
+	origFi := must.Value(orig.Stat())
+
+	tspy := tester.New(t)
+	tspy.ExpectCleanups(1)
+	tspy.Close()
+
+	// --- When ---
+	tst := CaptureStderr(tspy)
+
+	// --- Then ---
+	_, _ = orig.WriteString(`{"message":"msg0"}` + "\n")
+	_, _ = syscall.Write(2, []byte(`{"message":"msg1"}`+"\n"))
+	tspy.Finish()
+
+	want := "" +
+		`{"stream":"stderr","message":"msg0"}` + "\n" +
+		`{"stream":"stderr","message":"msg1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+	assert.True(t, os.SameFile(origFi, must.Value(orig.Stat())))
+}
@@ -4,8 +4,17 @@
 package logkit
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ctx42/testing/pkg/check"
@@ -25,6 +34,9 @@ var (
 
 	// ErrValue represents an error for invalid log entry field value.
 	ErrValue = errors.New("invalid log entry field value")
+
+	// ErrNotFound represents an error for no log entry passing the checks.
+	ErrNotFound = errors.New("log entry not found")
 )
 
 // CheckBool returns a function that takes an [Entry] and checks if the
@@ -108,6 +120,152 @@ func CheckContain(field, want string) Checker {
 	}
 }
 
+// CheckMatch returns a function that takes an [Entry] and checks if the
+// specified field exists with a string value matching the given regular
+// expression. The pattern is compiled once, when the checker is created.
+// Returns nil if the field exists, is a string, and matches. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a
+// string, or does not match (or the pattern is invalid), respectively.
+func CheckMatch(field, pattern string) Checker {
+	var rx any = pattern
+	if r, err := regexp.Compile(pattern); err == nil {
+		rx = r
+	}
+	return func(ent Entry) error {
+		have, err := HasStr(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Regexp(rx, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckAbsent returns a function that takes an [Entry] and checks if the
+// specified field does not exist. The dotted field names match the nested
+// fields the same way as in other checks. Returns nil if the field is absent.
+// Returns [ErrValue] if the field exists.
+func CheckAbsent(field string) Checker {
+	return func(ent Entry) error {
+		if _, err := hasKey(ent, field); err != nil {
+			return nil
+		}
+		return notice.New("[log entry] expected log entry field not to be present").
+			Append("field", "%s", field).
+			Wrap(ErrValue)
+	}
+}
+
+// CheckFieldsExactly returns a function that takes an [Entry] and checks if
+// the log entry has exactly the specified top-level fields, no more and no
+// less. Returns nil if the field sets are equal. Returns [ErrValue] listing
+// the missing and extra fields otherwise.
+func CheckFieldsExactly(fields ...string) Checker {
+	return func(ent Entry) error {
+		want := slices.Sorted(slices.Values(fields))
+		var missing, extra []string
+		for _, field := range want {
+			if _, ok := ent.m[field]; !ok {
+				missing = append(missing, field)
+			}
+		}
+		for _, field := range ent.Fields() {
+			if !slices.Contains(fields, field) {
+				extra = append(extra, field)
+			}
+		}
+		if len(missing) == 0 && len(extra) == 0 {
+			return nil
+		}
+		mHeader := "[log entry] expected log entry to have exactly the fields"
+		msg := notice.New(mHeader).
+			Want("%s", strings.Join(want, ", ")).
+			Have("%s", strings.Join(ent.Fields(), ", "))
+		if len(missing) > 0 {
+			msg = msg.Append("missing", "%s", strings.Join(missing, ", "))
+		}
+		if len(extra) > 0 {
+			msg = msg.Append("extra", "%s", strings.Join(extra, ", "))
+		}
+		return msg.Wrap(ErrValue)
+	}
+}
+
+// CheckUUID returns a function that takes an [Entry] and checks if the
+// specified field exists with a string value in the canonical UUID format
+// (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx). Returns nil if the field exists, is
+// a string, and is a valid UUID. Returns [ErrMissing], [ErrType], or
+// [ErrFormat] if the field is missing, not a string, or not a valid UUID,
+// respectively.
+func CheckUUID(field string) Checker {
+	return checkFormat(field, "UUID", func(val string) error {
+		if !uuidRx.MatchString(val) {
+			return errors.New("invalid UUID format")
+		}
+		return nil
+	})
+}
+
+// CheckIP returns a function that takes an [Entry] and checks if the
+// specified field exists with a string value which is a valid IPv4 or IPv6
+// address. Returns nil if the field exists, is a string, and is a valid IP
+// address. Returns [ErrMissing], [ErrType], or [ErrFormat] if the field is
+// missing, not a string, or not a valid IP address, respectively.
+func CheckIP(field string) Checker {
+	return checkFormat(field, "IP address", func(val string) error {
+		_, err := netip.ParseAddr(val)
+		return err
+	})
+}
+
+// CheckURL returns a function that takes an [Entry] and checks if the
+// specified field exists with a string value which is an absolute URL (has
+// the scheme and the host). Returns nil if the field exists, is a string, and
+// is a valid URL. Returns [ErrMissing], [ErrType], or [ErrFormat] if the field
+// is missing, not a string, or not a valid URL, respectively.
+func CheckURL(field string) Checker {
+	return checkFormat(field, "URL", func(val string) error {
+		u, err := url.Parse(val)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return errors.New("expected absolute URL")
+		}
+		return nil
+	})
+}
+
+// uuidRx matches UUIDs in the canonical format.
+var uuidRx = regexp.MustCompile(
+	`^(?i)[0-9a-f]{8}(-[0-9a-f]{4}){3}-[0-9a-f]{12}$`,
+)
+
+// checkFormat returns a function that takes an [Entry] and checks if the
+// specified field exists with a string value for which the "valid" function
+// returns nil. The "name" is the name of the format used in error messages.
+func checkFormat(field, name string, valid func(string) error) Checker {
+	return func(ent Entry) error {
+		have, err := HasStr(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = valid(have); err != nil {
+			mHeader := "[log entry] expected log entry field to be a valid %s"
+			return notice.New(mHeader, name).
+				Append("field", "%s", field).
+				Append("value", "%q", have).
+				Append("error", "%s", err).
+				Wrap(ErrFormat)
+		}
+		return nil
+	}
+}
+
 // CheckMsg returns a function that takes an [Entry] and checks if the
 // [Config.MessageField] field exists with a string value equal to the given
 // value. Returns nil if the field exists, is a string, and matches. Returns
@@ -130,6 +288,102 @@ func CheckMsgContain(want string) Checker {
 	}
 }
 
+// CheckService returns a function that takes an [Entry] and checks if the
+// [Config.ServiceField] field exists with a string value equal to the given
+// value. Returns nil if the field exists, is a string, and matches. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
+// or does not match, respectively.
+func CheckService(want string) Checker {
+	return func(ent Entry) error {
+		return CheckStr(ent.cfg.ServiceField, want)(ent)
+	}
+}
+
+// CheckComponent returns a function that takes an [Entry] and checks if the
+// [Config.ComponentField] field exists with a string value equal to the given
+// value. Returns nil if the field exists, is a string, and matches. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
+// or does not match, respectively.
+func CheckComponent(want string) Checker {
+	return func(ent Entry) error {
+		return CheckStr(ent.cfg.ComponentField, want)(ent)
+	}
+}
+
+// CheckCallerFile returns a function that takes an [Entry] and checks if the
+// [Config.CallerField] field exists with a caller, see [HasCaller], which file
+// path is equal to the given path or ends with it (e.g. "pkg/file.go"
+// matches "/src/app/pkg/file.go"). Returns nil if the field exists, is a
+// caller, and matches. Returns [ErrMissing], [ErrType], or [ErrValue] if the
+// field is missing, not a caller, or does not match, respectively.
+func CheckCallerFile(want string) Checker {
+	return func(ent Entry) error {
+		have, err := HasCaller(ent, ent.cfg.CallerField)
+		if err != nil {
+			return err
+		}
+		if !have.matchFile(want) {
+			mHeader := "[log entry] expected log entry caller file"
+			return notice.New(mHeader).
+				Append("field", "%s", ent.cfg.CallerField).
+				Want("%s", want).
+				Have("%s", have.String()).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckCallerContains returns a function that takes an [Entry] and checks if
+// the [Config.CallerField] field exists with a caller, see [HasCaller], which
+// "file:line" representation or function name contains the given value.
+// Returns nil if the field exists, is a caller, and contains the value.
+// Returns [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not
+// a caller, or does not contain the value, respectively.
+func CheckCallerContains(want string) Checker {
+	return func(ent Entry) error {
+		have, err := HasCaller(ent, ent.cfg.CallerField)
+		if err != nil {
+			return err
+		}
+		if !have.contains(want) {
+			mHeader := "[log entry] expected log entry caller to contain"
+			return notice.New(mHeader).
+				Append("field", "%s", ent.cfg.CallerField).
+				Want("%s", want).
+				Have("%s", have.String()).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckStackContains returns a function that takes an [Entry] and checks if
+// the [Config.StackField] field exists with a stack trace, see [HasStack],
+// having a frame which function name or "file:line" representation contains
+// the given value. Returns nil if the field exists, is a stack trace, and has
+// such frame. Returns [ErrMissing], [ErrType], or [ErrValue] if the field is
+// missing, not a stack trace, or has no such frame, respectively.
+func CheckStackContains(want string) Checker {
+	return func(ent Entry) error {
+		frames, err := HasStack(ent, ent.cfg.StackField)
+		if err != nil {
+			return err
+		}
+		for _, frame := range frames {
+			if frame.contains(want) {
+				return nil
+			}
+		}
+		mHeader := "[log entry] expected log entry stack trace to contain frame"
+		return notice.New(mHeader).
+			Append("field", "%s", ent.cfg.StackField).
+			Want("%s", want).
+			Append("frames", "%d", len(frames)).
+			Wrap(ErrValue)
+	}
+}
+
 // CheckErrContain returns a function that takes an [Entry] and checks if the
 // [Config.ErrorField] field exists with a string value containing the given
 // value. Returns nil if the field exists, is a string, and contains the value.
@@ -142,8 +396,9 @@ func CheckErrContain(want string) Checker {
 }
 
 // CheckTime returns a function that takes an [Entry] and checks if the
-// specified field exists with a time value, parsed using [Config.TimeFormat],
-// equal to the given time. Returns nil if the field exists, is a valid time,
+// specified field exists with a time value, parsed using [Config.TimeFormat]
+// or as a number of [Config.TimeUnit] since the Unix epoch, equal to the
+// given time. Returns nil if the field exists, is a valid time,
 // and matches. Returns [ErrMissing], [ErrType], or [ErrValue] if the field is
 // missing, not a valid time, or does not match, respectively.
 func CheckTime(field string, want time.Time) Checker {
@@ -161,11 +416,52 @@ func CheckTime(field string, want time.Time) Checker {
 	}
 }
 
+// CheckTimeAfter returns a function that takes an [Entry] and checks if the
+// specified field exists with a time value, parsed using [Config.TimeFormat],
+// after the given mark. Returns nil if the field exists, is a valid time, and
+// is after the mark. Returns [ErrMissing], [ErrType], or [ErrValue] if the
+// field is missing, not a valid time, or is not after the mark, respectively.
+func CheckTimeAfter(field string, mark time.Time) Checker {
+	return func(ent Entry) error {
+		have, err := HasTime(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.After(mark, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckTimeBefore returns a function that takes an [Entry] and checks if the
+// specified field exists with a time value, parsed using [Config.TimeFormat],
+// before the given mark. Returns nil if the field exists, is a valid time,
+// and is before the mark. Returns [ErrMissing], [ErrType], or [ErrValue] if
+// the field is missing, not a valid time, or is not before the mark,
+// respectively.
+func CheckTimeBefore(field string, mark time.Time) Checker {
+	return func(ent Entry) error {
+		have, err := HasTime(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Before(mark, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
 // CheckDuration returns a function that takes an [Entry] and checks if the
-// specified field exists with an integer value, in [DurationFieldUnit], equal
-// to the given duration. Returns nil if the field exists, is an integer, and
-// matches. Returns [ErrMissing], [ErrType], or [ErrValue] if the field is
-// missing, not an integer, or does not match, respectively.
+// specified field exists with a duration value, see [HasDur], equal to the
+// given duration. Returns nil if the field exists, is a duration, and
+// matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a duration, or does not match, respectively.
 func CheckDuration(field string, want time.Duration) Checker {
 	return func(ent Entry) error {
 		have, err := HasDur(ent, field)
@@ -173,106 +469,148 @@ func CheckDuration(field string, want time.Duration) Checker {
 			return err
 		}
 		if err = check.Duration(want, have); err != nil {
+			unit := ent.cfg.durationUnit(field)
 			return notice.From(err, "log entry").
 				Prepend("field", "%s", field).
-				Want("%d (%s)", want/ent.cfg.DurationUnit, want.String()).
-				Have("%d (%s)", have/ent.cfg.DurationUnit, have.String()).
+				Want("%d (%s)", want/unit, want.String()).
+				Have("%d (%s)", have/unit, have.String()).
 				Wrap(ErrValue)
 		}
 		return nil
 	}
 }
 
+// CheckDurationWithin returns a function that takes an [Entry] and checks if
+// the specified field exists with a duration value, see [HasDur], within the
+// given delta from the "want" duration (|want - have| <= delta). Returns nil
+// if the field exists, is a duration, and is within the delta. Returns
+// [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if the field is
+// missing, not a duration, or not within the delta, respectively.
+func CheckDurationWithin(field string, want, delta time.Duration) Checker {
+	return func(ent Entry) error {
+		have, err := HasDur(ent, field)
+		if err != nil {
+			return err
+		}
+		diff := want - have
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff <= delta {
+			return nil
+		}
+		mHeader := "[log entry] expected durations to be within the given delta"
+		return notice.New(mHeader).
+			Append("field", "%s", field).
+			Want("%s", want.String()).
+			Have("%s", have.String()).
+			Append("want delta", "%s", delta.String()).
+			Append("have delta", "%s", diff.String()).
+			Wrap(ErrValue)
+	}
+}
+
 // CheckLevel returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field exists with a string value equal to the given
-// value. Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to the
+// given value. Returns nil if the field exists, is a valid level, and matches.
+// Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if the field
+// is missing, not a valid level, or does not match, respectively.
 func CheckLevel(want string) Checker {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, want)(ent)
+		have, err := HasLevel(ent)
+		if err != nil {
+			return err
+		}
+		if err = check.Equal(want, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", ent.cfg.LevelField).
+				Wrap(ErrValue)
+		}
+		return nil
 	}
 }
 
 // CheckDebug returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field is a string equal to [Config.LevelDebugValue].
-// Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to
+// [Config.LevelDebugValue]. Returns nil if the field exists, is a valid level,
+// and matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a valid level, or does not match, respectively.
 func CheckDebug() Checker {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, ent.cfg.LevelDebugValue)(ent)
+		return CheckLevel(ent.cfg.LevelDebugValue)(ent)
 	}
 }
 
 // CheckInfo returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field is a string equal to [Config.LevelInfoValue].
-// Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to
+// [Config.LevelInfoValue]. Returns nil if the field exists, is a valid level,
+// and matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a valid level, or does not match, respectively.
 func CheckInfo() Checker {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, ent.cfg.LevelInfoValue)(ent)
+		return CheckLevel(ent.cfg.LevelInfoValue)(ent)
 	}
 }
 
 // CheckWarn returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field is a string equal to [Config.LevelWarnValue].
-// Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to
+// [Config.LevelWarnValue]. Returns nil if the field exists, is a valid level,
+// and matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a valid level, or does not match, respectively.
 func CheckWarn() Checker {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, ent.cfg.LevelWarnValue)(ent)
+		return CheckLevel(ent.cfg.LevelWarnValue)(ent)
 	}
 }
 
 // CheckError returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field is a string equal to [Config.LevelErrorValue].
-// Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to
+// [Config.LevelErrorValue]. Returns nil if the field exists, is a valid level,
+// and matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a valid level, or does not match, respectively.
 func CheckError() Checker {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, ent.cfg.LevelErrorValue)(ent)
+		return CheckLevel(ent.cfg.LevelErrorValue)(ent)
 	}
 }
 
 // CheckFatal returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field is a string equal to [Config.LevelFatalValue].
-// Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to
+// [Config.LevelFatalValue]. Returns nil if the field exists, is a valid level,
+// and matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a valid level, or does not match, respectively.
 func CheckFatal() Checker {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, ent.cfg.LevelFatalValue)(ent)
+		return CheckLevel(ent.cfg.LevelFatalValue)(ent)
 	}
 }
 
 // CheckPanic returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field is a string equal to [Config.LevelPanicValue].
-// Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to
+// [Config.LevelPanicValue]. Returns nil if the field exists, is a valid level,
+// and matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a valid level, or does not match, respectively.
 func CheckPanic() Checker {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, ent.cfg.LevelPanicValue)(ent)
+		return CheckLevel(ent.cfg.LevelPanicValue)(ent)
 	}
 }
 
 // CheckTrace returns a function that takes an [Entry] and checks if the
-// [Config.LevelField] field is a string equal to [Config.LevelTraceValue].
-// Returns nil if the field exists, is a string, and matches. Returns
-// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a string,
-// or does not match, respectively.
+// [Config.LevelField] field exists with a level, see [HasLevel], equal to
+// [Config.LevelTraceValue]. Returns nil if the field exists, is a valid level,
+// and matches. Returns [ErrMissing], [ErrType] or [ErrFormat], or [ErrValue] if
+// the field is missing, not a valid level, or does not match, respectively.
 func CheckTrace() func(ent Entry) error {
 	return func(ent Entry) error {
-		return CheckStr(ent.cfg.LevelField, ent.cfg.LevelTraceValue)(ent)
+		return CheckLevel(ent.cfg.LevelTraceValue)(ent)
 	}
 }
 
 // CheckNumber returns a function that takes an [Entry] and checks if the
-// specified field exists with a number value equal to the given value. Returns
+// specified field exists with a number value equal to the given value. The
+// integral values are compared with the number in the raw log entry, so the
+// ones which cannot be represented as float64 do not match. Returns
 // nil if the field exists, is a number, and matches. Returns [ErrMissing],
 // [ErrType], or [ErrValue] if the field is missing, not a number, or does not
 // match, respectively.
@@ -282,9 +620,12 @@ func CheckNumber(field string, want float64) Checker {
 		if err != nil {
 			return err
 		}
-		if err = check.Equal(want, have); err != nil {
+		haveStr := rawNumber(ent, field)
+		if haveStr == "" {
+			haveStr = strconv.FormatFloat(have, 'f', -1, 64)
+		}
+		if !numberEqual(haveStr, want) {
 			wantStr := strconv.FormatFloat(want, 'f', -1, 64)
-			haveStr := strconv.FormatFloat(have, 'f', -1, 64)
 			return notice.New("error checking log entry").
 				Prepend("field", "%s", field).
 				Want("%s", wantStr).
@@ -295,6 +636,388 @@ func CheckNumber(field string, want float64) Checker {
 	}
 }
 
+// numberEqual reports whether the JSON number equals the value. Integral
+// numbers are compared exactly, so the ones which cannot be represented as
+// float64 are not equal to their nearest float64 value.
+func numberEqual(num string, want float64) bool {
+	have, err := strconv.ParseFloat(num, 64)
+	if err != nil || have != want {
+		return false
+	}
+	rat, ok := new(big.Rat).SetString(num)
+	if !ok || !rat.IsInt() {
+		return true
+	}
+	return rat.Cmp(new(big.Rat).SetFloat64(want)) == 0
+}
+
+// CheckInt returns a function that takes an [Entry] and checks if the
+// specified field exists with an integer value equal to the given value, see
+// [HasInt]. Returns nil if the field exists, is an int, and matches. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not an int,
+// or does not match, respectively.
+func CheckInt(field string, want int) Checker {
+	return func(ent Entry) error {
+		have, err := HasInt(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Equal(want, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckInt64 returns a function that takes an [Entry] and checks if the
+// specified field exists with an integer value equal to the given value, see
+// [HasInt64]. Returns nil if the field exists, is an int64, and matches.
+// Returns [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not
+// an int64, or does not match, respectively.
+func CheckInt64(field string, want int64) Checker {
+	return func(ent Entry) error {
+		have, err := HasInt64(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Equal(want, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckUint64 returns a function that takes an [Entry] and checks if the
+// specified field exists with an integer value equal to the given value, see
+// [HasUint64]. Returns nil if the field exists, is a uint64, and matches.
+// Returns [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not
+// a uint64, or does not match, respectively.
+func CheckUint64(field string, want uint64) Checker {
+	return func(ent Entry) error {
+		have, err := HasUint64(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Equal(want, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckSliceLen returns a function that takes an [Entry] and checks if the
+// specified field exists with an array value of the given length. Returns nil
+// if the field exists, is an array, and has the given length. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not an
+// array, or has a different length, respectively.
+func CheckSliceLen(field string, want int) Checker {
+	return func(ent Entry) error {
+		have, err := HasSlice(ent, field)
+		if err != nil {
+			return err
+		}
+		if len(have) != want {
+			return notice.New("[log entry] expected array to have length").
+				Append("field", "%s", field).
+				Want("%d", want).
+				Have("%d", len(have)).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckSliceContains returns a function that takes an [Entry] and checks if
+// the specified field exists with an array value containing an element deeply
+// equal to the given value. Remember that JSON numbers are decoded as float64.
+// Returns nil if the field exists, is an array, and contains the value.
+// Returns [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not
+// an array, or does not contain the value, respectively.
+func CheckSliceContains(field string, want any) Checker {
+	return func(ent Entry) error {
+		have, err := HasSlice(ent, field)
+		if err != nil {
+			return err
+		}
+		for _, val := range have {
+			if check.Equal(want, val) == nil {
+				return nil
+			}
+		}
+		return notice.New("[log entry] expected array to contain the value").
+			Append("field", "%s", field).
+			Want("%#v", want).
+			Have("%#v", have).
+			Wrap(ErrValue)
+	}
+}
+
+// CheckSubset returns a function that takes an [Entry] and checks if all the
+// keys of the "want" map are present in the log entry with equal values.
+// Nested maps are compared recursively, so only their provided keys are
+// checked. Other fields are ignored. The "want" map is normalized with a JSON
+// round trip before the comparison, so Go numbers match JSON numbers. Returns
+// nil if the log entry is a superset. Returns [ErrMissing] or [ErrValue] if
+// any of the keys is missing or has a different value, respectively.
+func CheckSubset(want map[string]any) Checker {
+	return func(ent Entry) error {
+		norm, err := jsonNormalize(want)
+		if err != nil {
+			return err
+		}
+		wm, _ := norm.(map[string]any)
+		return mapSubset(wm, ent.m, "")
+	}
+}
+
+// CheckOneOf returns a function that takes an [Entry] and checks if the
+// specified field exists with a value deeply equal to one of the given
+// values. The "want" values are normalized with a JSON round trip before the
+// comparison, so Go numbers match JSON numbers. Returns nil if the field
+// exists and matches any of the values. Returns [ErrMissing] or [ErrValue] if
+// the field is missing or does not match any of the values, respectively.
+func CheckOneOf(field string, want ...any) Checker {
+	return func(ent Entry) error {
+		have, err := hasKey(ent, field)
+		if err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Remove("key").
+				Wrap(ErrMissing)
+		}
+		norm, err := jsonNormalize(want)
+		if err != nil {
+			return err
+		}
+		vals, _ := norm.([]any)
+		for _, val := range vals {
+			if check.Equal(val, have) == nil {
+				return nil
+			}
+		}
+		return notice.New("[log entry] expected log entry field to be one of").
+			Append("field", "%s", field).
+			Want("%s", fieldString(vals)).
+			Have("%s", fieldString(have)).
+			Wrap(ErrValue)
+	}
+}
+
+// jsonNormalize returns the value after a JSON round trip. Returns error with
+// [ErrValue] in its chain if the value cannot be represented as JSON.
+func jsonNormalize(val any) (any, error) {
+	var norm any
+	data, err := json.Marshal(val)
+	if err == nil {
+		err = json.Unmarshal(data, &norm)
+	}
+	if err != nil {
+		return nil, notice.New("[log entry] expected want to be JSON compatible").
+			Append("error", "%s", err).
+			Wrap(ErrValue)
+	}
+	return norm, nil
+}
+
+// mapSubset checks if all the keys of the "want" map are present in the
+// "have" map with equal values. Nested maps are checked recursively. The
+// "trail" is the dot-separated path to the checked maps.
+func mapSubset(want, have map[string]any, trail string) error {
+	for _, key := range slices.Sorted(maps.Keys(want)) {
+		field := key
+		if trail != "" {
+			field = trail + "." + key
+		}
+		hVal, ok := have[key]
+		if !ok {
+			return notice.New("[log entry] expected log entry field to be present").
+				Append("field", "%s", field).
+				Wrap(ErrMissing)
+		}
+		wMap, wIsMap := want[key].(map[string]any)
+		hMap, hIsMap := hVal.(map[string]any)
+		if wIsMap && hIsMap {
+			if err := mapSubset(wMap, hMap, field); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := check.Equal(want[key], hVal); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+	}
+	return nil
+}
+
+// CheckNot returns a function that takes an [Entry] and inverts the result of
+// the given checker. Returns nil if the checker returns an error, including
+// when the checked field is missing. Returns [ErrValue] if the checker passes.
+//
+// Example usage:
+//
+//	notFoo := logkit.CheckNot(logkit.CheckStr("service", "foo"))
+//	tst.WaitFor("1s", logkit.CheckError(), notFoo)
+func CheckNot(chk Checker) Checker {
+	return func(ent Entry) error {
+		if chk(ent) != nil {
+			return nil
+		}
+		return notice.New("[log entry] expected log entry not to pass the check").
+			Wrap(ErrValue)
+	}
+}
+
+// CheckNotStrict works like [CheckNot], but the missing field does not count
+// as not matching. Returns the checker error when it has [ErrMissing] in its
+// chain.
+func CheckNotStrict(chk Checker) Checker {
+	return func(ent Entry) error {
+		err := chk(ent)
+		if errors.Is(err, ErrMissing) {
+			return err
+		}
+		if err != nil {
+			return nil
+		}
+		return notice.New("[log entry] expected log entry not to pass the check").
+			Wrap(ErrValue)
+	}
+}
+
+// CheckNumberNear returns a function that takes an [Entry] and checks if the
+// specified field exists with a number value within the given delta from the
+// "want" value (|want - have| <= delta). Returns nil if the field exists, is
+// a number, and is within the delta. Returns [ErrMissing], [ErrType], or
+// [ErrValue] if the field is missing, not a number, or not within the delta,
+// respectively.
+func CheckNumberNear(field string, want, delta float64) Checker {
+	return func(ent Entry) error {
+		have, err := HasNum(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Delta(want, delta, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckNumberGreater returns a function that takes an [Entry] and checks if
+// the specified field exists with a number value greater than the given
+// value. Returns nil if the field exists, is a number, and is greater.
+// Returns [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not
+// a number, or not greater, respectively.
+func CheckNumberGreater(field string, than float64) Checker {
+	return func(ent Entry) error {
+		have, err := HasNum(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Greater(than, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckNumberLess returns a function that takes an [Entry] and checks if the
+// specified field exists with a number value less than the given value.
+// Returns nil if the field exists, is a number, and is less. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a
+// number, or not less, respectively.
+func CheckNumberLess(field string, than float64) Checker {
+	return func(ent Entry) error {
+		have, err := HasNum(ent, field)
+		if err != nil {
+			return err
+		}
+		if err = check.Smaller(than, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckAllOf returns a function that takes an [Entry] and runs all the
+// provided checks on it. Returns nil if all checks pass (or there are no
+// checks), otherwise returns the error from the first failing check. It can
+// be used to compose a list of checks once and reuse it.
+//
+// Example usage:
+//
+//	failed := logkit.CheckAllOf(logkit.CheckError(), logkit.CheckMsg("failed"))
+//	tst.WaitFor("1s", failed)
+func CheckAllOf(checks ...Checker) Checker {
+	return func(ent Entry) error {
+		for _, chk := range checks {
+			if err := chk(ent); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// CheckAnyOf returns a function that takes an [Entry] and runs the provided
+// checks on it until one of them passes. Returns nil if any of the checks
+// passes. Otherwise, including when there are no checks, it returns an error
+// with [ErrValue] in its chain listing the errors of all the checks.
+func CheckAnyOf(checks ...Checker) Checker {
+	return func(ent Entry) error {
+		errs := make([]error, 0, len(checks))
+		for _, chk := range checks {
+			err := chk(ent)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		msg := notice.New("[log entry] expected any of the checks to pass").
+			Append("checks", "%d", len(checks))
+		for i, err := range errs {
+			msg.Append(fmt.Sprintf("error %d", i), "%s", err)
+		}
+		return msg.Wrap(ErrValue)
+	}
+}
+
+// checkEqual returns a function that takes an [Entry] and checks if the
+// specified field exists with a value deeply equal to the given value. Returns
+// nil if the field exists and matches. Returns [ErrMissing] or [ErrValue] if
+// the field is missing or does not match, respectively.
+func checkEqual(field string, want any) Checker {
+	return func(ent Entry) error {
+		have, err := hasKey(ent, field)
+		if err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Remove("key").
+				Wrap(ErrMissing)
+		}
+		if err = check.Equal(want, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("field", "%s", field).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
 // CheckMap returns a function that takes an [Entry] and checks if the
 // specified field exists with a map[string]any value deeply equal to the given
 // value. Returns nil if the field exists, is a map, and matches. Returns
@@ -312,3 +1035,23 @@ func CheckMap(field string, want map[string]any) Checker {
 		return nil
 	}
 }
+
+// CheckPath returns a function that takes an [Entry] and checks if the value
+// at the specified path, see [HasPath], is deeply equal to the given value.
+// Remember that JSON numbers are decoded as float64. Returns nil if the path
+// exists and matches. Returns [ErrMissing], [ErrType], or [ErrValue] if the
+// path is missing, cannot be followed, or does not match, respectively.
+func CheckPath(path string, want any) Checker {
+	return func(ent Entry) error {
+		have, err := HasPath(ent, path)
+		if err != nil {
+			return err
+		}
+		if err = check.Equal(want, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("path", "%s", path).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
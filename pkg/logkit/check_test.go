@@ -290,16 +290,16 @@ func Test_CheckMsgContain(t *testing.T) {
 	})
 }
 
-func Test_CheckErrContain(t *testing.T) {
+func Test_CheckService(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"error": "abc def ghi"},
+			m:   map[string]any{"service": "abc", "number": 42.0},
 		}
 
 		// --- When ---
-		err := CheckErrContain("def")(ent)
+		err := CheckService("abc")(ent)
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -309,19 +309,18 @@ func Test_CheckErrContain(t *testing.T) {
 		// --- Given ---
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"error": "abc def ghi"},
+			m:   map[string]any{"service": "abc", "number": 42.0},
 		}
 
 		// --- When ---
-		err := CheckErrContain("xyz")(ent)
+		err := CheckService("xyz")(ent)
 
 		// --- Then ---
 		wMsg := "" +
-			"[log entry] expected string to contain substring:\n" +
-			"      field: %s\n" +
-			"     string: \"abc def ghi\"\n" +
-			"  substring: \"xyz\""
-		wMsg = fmt.Sprintf(wMsg, "error")
+			"[log entry] expected values to be equal:\n" +
+			"  field: service\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrValue, err)
 	})
@@ -334,25 +333,23 @@ func Test_CheckErrContain(t *testing.T) {
 		}
 
 		// --- When ---
-		err := CheckErrContain("abc")(ent)
+		err := CheckService("abc")(ent)
 
 		// --- Then ---
 		assert.ErrorIs(t, ErrMissing, err)
 	})
 }
 
-func Test_CheckTime(t *testing.T) {
+func Test_CheckComponent(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS, "number": 42.0},
+			m:   map[string]any{"component": "abc", "number": 42.0},
 		}
 
 		// --- When ---
-		err := CheckTime("time", entTim)(ent)
+		err := CheckComponent("abc")(ent)
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -360,158 +357,174 @@ func Test_CheckTime(t *testing.T) {
 
 	t.Run("error - when a field is not equal", func(t *testing.T) {
 		// --- Given ---
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-		wantTim := time.Date(2222, 1, 2, 3, 4, 5, 0, time.UTC)
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS, "number": 42.0},
+			m:   map[string]any{"component": "abc", "number": 42.0},
 		}
 
 		// --- When ---
-		err := CheckTime("time", wantTim)(ent)
+		err := CheckComponent("xyz")(ent)
 
 		// --- Then ---
 		wMsg := "" +
-			"[log entry] expected equal dates:\n" +
-			"  field: time\n" +
-			"   want: 2222-01-02T03:04:05Z\n" +
-			"   have: 2000-01-02T03:04:05Z\n" +
-			"   diff: 1946016h0m0s"
+			"[log entry] expected values to be equal:\n" +
+			"  field: component\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrValue, err)
 	})
 
 	t.Run("error - when a field does not exist", func(t *testing.T) {
 		// --- Given ---
-		ent := Entry{m: make(map[string]any)}
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"number": 42.0},
+		}
 
 		// --- When ---
-		err := CheckTime("missing", time.Now())(ent)
+		err := CheckComponent("abc")(ent)
 
 		// --- Then ---
 		assert.ErrorIs(t, ErrMissing, err)
 	})
 }
 
-func Test_CheckDuration(t *testing.T) {
+func Test_CheckCallerFile(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"dur": 1000.0, "number": 42.0},
+			m:   map[string]any{"caller": "/src/app/pkg/file.go:42"},
 		}
 
 		// --- When ---
-		err := CheckDuration("dur", time.Second)(ent)
+		err := CheckCallerFile("pkg/file.go")(ent)
 
 		// --- Then ---
 		assert.NoError(t, err)
 	})
 
-	t.Run("error - when a field is not equal", func(t *testing.T) {
+	t.Run("error - when a file does not match", func(t *testing.T) {
 		// --- Given ---
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"dur": 1000.0, "number": 42.0},
+			m:   map[string]any{"caller": "/src/app/pkg/file.go:42"},
 		}
 
 		// --- When ---
-		err := CheckDuration("dur", time.Hour)(ent)
+		err := CheckCallerFile("other/file.go")(ent)
 
 		// --- Then ---
 		wMsg := "" +
-			"[log entry] expected equal time durations:\n" +
-			"  field: dur\n" +
-			"   want: 3600000 (1h0m0s)\n" +
-			"   have: 1000 (1s)"
+			"[log entry] expected log entry caller file:\n" +
+			"  field: caller\n" +
+			"   want: other/file.go\n" +
+			"   have: /src/app/pkg/file.go:42"
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrValue, err)
 	})
 
 	t.Run("error - when a field does not exist", func(t *testing.T) {
 		// --- Given ---
-		ent := Entry{m: make(map[string]any)}
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"number": 42.0},
+		}
 
 		// --- When ---
-		err := CheckDuration("missing", time.Second)(ent)
+		err := CheckCallerFile("pkg/file.go")(ent)
 
 		// --- Then ---
 		assert.ErrorIs(t, ErrMissing, err)
 	})
 }
 
-func Test_CheckNumber(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_CheckCallerContains(t *testing.T) {
+	t.Run("contains file", func(t *testing.T) {
 		// --- Given ---
-		ent := Entry{m: map[string]any{"float": 42.0, "str": "abc"}}
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"caller": "/src/app/pkg/file.go:42"},
+		}
 
 		// --- When ---
-		err := CheckNumber("float", 42)(ent)
+		err := CheckCallerContains("app/pkg/")(ent)
 
 		// --- Then ---
 		assert.NoError(t, err)
 	})
 
-	t.Run("error - when a field is not equal", func(t *testing.T) {
+	t.Run("contains function", func(t *testing.T) {
 		// --- Given ---
-		ent := Entry{m: map[string]any{"float": 42.0, "str": "abc"}}
+		src := map[string]any{"function": "app/pkg.Run", "file": "file.go"}
+		ent := Entry{
+			cfg: SlogConfig(),
+			m:   map[string]any{"source": src},
+		}
 
 		// --- When ---
-		err := CheckNumber("float", 43)(ent)
+		err := CheckCallerContains("pkg.Run")(ent)
 
 		// --- Then ---
-		wMsg := "error checking log entry:\n" +
-			"  field: float\n" +
-			"   want: 43\n" +
-			"   have: 42"
-		assert.ErrorEqual(t, wMsg, err)
-		assert.ErrorIs(t, ErrValue, err)
+		assert.NoError(t, err)
 	})
 
-	t.Run("error - when a field does not exist", func(t *testing.T) {
+	t.Run("error - when caller does not contain", func(t *testing.T) {
 		// --- Given ---
-		ent := Entry{m: make(map[string]any)}
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"caller": "/src/app/pkg/file.go:42"},
+		}
 
 		// --- When ---
-		err := CheckNumber("missing", 42)(ent)
+		err := CheckCallerContains("other")(ent)
 
 		// --- Then ---
-		assert.ErrorIs(t, ErrMissing, err)
+		wMsg := "" +
+			"[log entry] expected log entry caller to contain:\n" +
+			"  field: caller\n" +
+			"   want: other\n" +
+			"   have: /src/app/pkg/file.go:42"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
 	})
 }
 
-func Test_CheckLevel(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_CheckStackContains(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
 		// --- Given ---
 		ent := Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"level": "info"},
+			cfg: ZapConfig(),
+			m: map[string]any{
+				"stacktrace": "main.run\n\t/app/main.go:42\n" +
+					"main.main\n\t/app/main.go:10",
+			},
 		}
 
 		// --- When ---
-		err := CheckLevel("info")(ent)
+		err := CheckStackContains("main.main")(ent)
 
 		// --- Then ---
 		assert.NoError(t, err)
 	})
 
-	t.Run("error - when a field is not equal", func(t *testing.T) {
+	t.Run("error - when no frame contains", func(t *testing.T) {
 		// --- Given ---
 		ent := Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"level": "info"},
+			cfg: ZapConfig(),
+			m:   map[string]any{"stacktrace": "main.run\n\t/app/main.go:42"},
 		}
 
 		// --- When ---
-		err := CheckLevel("error")(ent)
+		err := CheckStackContains("db.Query")(ent)
 
 		// --- Then ---
 		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
-			"  field: level\n" +
-			"   want: \"error\"\n" +
-			"   have: \"info\""
+			"[log entry] expected log entry stack trace to contain frame:\n" +
+			"   field: stacktrace\n" +
+			"    want: db.Query\n" +
+			"  frames: 1"
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrValue, err)
 	})
@@ -520,106 +533,97 @@ func Test_CheckLevel(t *testing.T) {
 		// --- Given ---
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   make(map[string]any),
+			m:   map[string]any{"number": 42.0},
 		}
 
 		// --- When ---
-		err := CheckLevel("info")(ent)
+		err := CheckStackContains("main.run")(ent)
 
 		// --- Then ---
 		assert.ErrorIs(t, ErrMissing, err)
 	})
 }
 
-func Test_check_level_success_tabular(t *testing.T) {
-	tt := []struct {
-		testN string
+func Test_CheckErrContain(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"error": "abc def ghi"},
+		}
 
-		check Checker
-		level string
-	}{
-		{"debug", CheckDebug(), DefaultConfig().LevelDebugValue},
-		{"info", CheckInfo(), DefaultConfig().LevelInfoValue},
-		{"warn", CheckWarn(), DefaultConfig().LevelWarnValue},
-		{"error", CheckError(), DefaultConfig().LevelErrorValue},
-		{"fatal", CheckFatal(), DefaultConfig().LevelFatalValue},
-		{"panic", CheckPanic(), DefaultConfig().LevelPanicValue},
-		{"trace", CheckTrace(), DefaultConfig().LevelTraceValue},
-	}
+		// --- When ---
+		err := CheckErrContain("def")(ent)
 
-	for _, tc := range tt {
-		tc := tc
-		t.Run(tc.testN, func(t *testing.T) {
-			// --- Given ---
-			tspy := tester.New(t, 0)
-			tspy.Close()
+		// --- Then ---
+		assert.NoError(t, err)
+	})
 
-			ent := Entry{
-				cfg: DefaultConfig(),
-				m:   map[string]any{"level": tc.level},
-			}
+	t.Run("error - when a field is not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"error": "abc def ghi"},
+		}
 
-			// --- When ---
-			err := tc.check(ent)
+		// --- When ---
+		err := CheckErrContain("xyz")(ent)
 
-			// --- Then ---
-			assert.NoError(t, err)
-		})
-	}
-}
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected string to contain substring:\n" +
+			"      field: %s\n" +
+			"     string: \"abc def ghi\"\n" +
+			"  substring: \"xyz\""
+		wMsg = fmt.Sprintf(wMsg, "error")
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
 
-func Test_check_level_failure_tabular(t *testing.T) {
-	tt := []struct {
-		testN string
+	t.Run("error - when a field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"number": 42.0},
+		}
 
-		check Checker
-		want  string
-		have  string
-	}{
-		{"debug", CheckDebug(), DefaultConfig().LevelDebugValue, "info"},
-		{"info", CheckInfo(), "info", DefaultConfig().LevelTraceValue},
-		{"warn", CheckWarn(), DefaultConfig().LevelWarnValue, "info"},
-		{"error", CheckError(), DefaultConfig().LevelErrorValue, "info"},
-		{"fatal", CheckFatal(), DefaultConfig().LevelFatalValue, "info"},
-		{"panic", CheckPanic(), DefaultConfig().LevelPanicValue, "info"},
-		{"trace", CheckTrace(), DefaultConfig().LevelTraceValue, "info"},
-	}
+		// --- When ---
+		err := CheckErrContain("abc")(ent)
 
-	for _, tc := range tt {
-		tc := tc
-		t.Run(tc.testN, func(t *testing.T) {
-			// --- Given ---
-			tspy := tester.New(t, 0)
-			tspy.Close()
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
 
-			ent := Entry{
-				cfg: DefaultConfig(),
-				m:   map[string]any{"level": tc.have},
-			}
+func Test_CheckTime(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS, "number": 42.0},
+		}
 
-			// --- When ---
-			err := tc.check(ent)
+		// --- When ---
+		err := CheckTime("time", entTim)(ent)
 
-			// --- Then ---
-			wMsg := "" +
-				"[log entry] expected values to be equal:\n" +
-				"  field: level\n" +
-				"   want: %q\n" +
-				"   have: %q"
-			wMsg = fmt.Sprintf(wMsg, tc.want, tc.have)
-			assert.ErrorEqual(t, wMsg, err)
-			assert.ErrorIs(t, ErrValue, err)
-		})
-	}
-}
+		// --- Then ---
+		assert.NoError(t, err)
+	})
 
-func Test_CheckMap(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+	t.Run("equal numeric time", func(t *testing.T) {
 		// --- Given ---
-		ent := Entry{m: map[string]any{"map": map[string]any{"str": "abc"}}}
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		cfg := DefaultConfig()
+		cfg.TimeAsNumber = true
+		ent := Entry{
+			cfg: cfg,
+			m:   map[string]any{"time": float64(entTim.Unix())},
+		}
 
 		// --- When ---
-		err := CheckMap("map", map[string]any{"str": "abc"})(ent)
+		err := CheckTime("time", entTim)(ent)
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -627,17 +631,24 @@ func Test_CheckMap(t *testing.T) {
 
 	t.Run("error - when a field is not equal", func(t *testing.T) {
 		// --- Given ---
-		ent := Entry{m: map[string]any{"map": map[string]any{"str": "abc"}}}
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+		wantTim := time.Date(2222, 1, 2, 3, 4, 5, 0, time.UTC)
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS, "number": 42.0},
+		}
 
 		// --- When ---
-		err := CheckMap("map", map[string]any{"str": "xyz"})(ent)
+		err := CheckTime("time", wantTim)(ent)
 
 		// --- Then ---
 		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
-			"  trail: map[\"str\"]\n" +
-			"   want: \"xyz\"\n" +
-			"   have: \"abc\""
+			"[log entry] expected equal dates:\n" +
+			"  field: time\n" +
+			"   want: 2222-01-02T03:04:05Z\n" +
+			"   have: 2000-01-02T03:04:05Z\n" +
+			"   diff: 1946016h0m0s"
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrValue, err)
 	})
@@ -647,9 +658,1670 @@ func Test_CheckMap(t *testing.T) {
 		ent := Entry{m: make(map[string]any)}
 
 		// --- When ---
-		err := CheckMap("missing", map[string]any{"str": "abc"})(ent)
+		err := CheckTime("missing", time.Now())(ent)
 
 		// --- Then ---
 		assert.ErrorIs(t, ErrMissing, err)
 	})
 }
+
+func Test_CheckDuration(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": 1000.0, "number": 42.0},
+		}
+
+		// --- When ---
+		err := CheckDuration("dur", time.Second)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("equal string duration", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": "1.5s"},
+		}
+
+		// --- When ---
+		err := CheckDuration("dur", 1500*time.Millisecond)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - when a field is not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": 1000.0, "number": 42.0},
+		}
+
+		// --- When ---
+		err := CheckDuration("dur", time.Hour)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected equal time durations:\n" +
+			"  field: dur\n" +
+			"   want: 3600000 (1h0m0s)\n" +
+			"   have: 1000 (1s)"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - not equal uses field unit", func(t *testing.T) {
+		// --- Given ---
+		cfg := DefaultConfig()
+		cfg.DurationUnits = map[string]time.Duration{"dur": time.Second}
+		ent := Entry{
+			cfg: cfg,
+			m:   map[string]any{"dur": 1.0},
+		}
+
+		// --- When ---
+		err := CheckDuration("dur", time.Hour)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected equal time durations:\n" +
+			"  field: dur\n" +
+			"   want: 3600 (1h0m0s)\n" +
+			"   have: 1 (1s)"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - when a field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: make(map[string]any)}
+
+		// --- When ---
+		err := CheckDuration("missing", time.Second)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckNumber(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"float": 42.0, "str": "abc"}}
+
+		// --- When ---
+		err := CheckNumber("float", 42)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - when a field is not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"float": 42.0, "str": "abc"}}
+
+		// --- When ---
+		err := CheckNumber("float", 43)(ent)
+
+		// --- Then ---
+		wMsg := "error checking log entry:\n" +
+			"  field: float\n" +
+			"   want: 43\n" +
+			"   have: 42"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - when a field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: make(map[string]any)}
+
+		// --- When ---
+		err := CheckNumber("missing", 42)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+
+	t.Run("fraction", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"float": 0.1}`).ets[0]
+
+		// --- When ---
+		err := CheckNumber("float", 0.1)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("large integer", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"int": 9007199254740992}`).ets[0]
+
+		// --- When ---
+		err := CheckNumber("int", 9007199254740992)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - large integer loses precision", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"int": 9007199254740993}`).ets[0]
+
+		// --- When ---
+		err := CheckNumber("int", 9007199254740992)(ent)
+
+		// --- Then ---
+		wMsg := "error checking log entry:\n" +
+			"  field: int\n" +
+			"   want: 9007199254740992\n" +
+			"   have: 9007199254740993"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_numberEqual(t *testing.T) {
+	tests := []struct {
+		testN string
+
+		num  string
+		want float64
+		exp  bool
+	}{
+		{"integer", "42", 42, true},
+		{"integer with exponent", "4.2e1", 42, true},
+		{"fraction", "0.1", 0.1, true},
+		{"not equal", "42", 43, false},
+		{"large integer", "9007199254740992", 9007199254740992, true},
+		{"large integer rounded", "9007199254740993", 9007199254740992, false},
+		{"overflow", "1e400", 0, false},
+		{"invalid", "abc", 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := numberEqual(tc.num, tc.want)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_CheckLevel(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"level": "info"},
+		}
+
+		// --- When ---
+		err := CheckLevel("info")(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - when a field is not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"level": "info"},
+		}
+
+		// --- When ---
+		err := CheckLevel("error")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: level\n" +
+			"   want: \"error\"\n" +
+			"   have: \"info\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - when a field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   make(map[string]any),
+		}
+
+		// --- When ---
+		err := CheckLevel("info")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+
+	t.Run("with level parser", func(t *testing.T) {
+		// --- Given ---
+		cfg := DefaultConfig()
+		cfg.LevelParser = func(val any) (string, error) {
+			if val == 30.0 {
+				return "info", nil
+			}
+			return "", errors.New("unknown level")
+		}
+		ent := Entry{cfg: cfg, m: map[string]any{"level": 30.0}}
+
+		// --- When ---
+		err := CheckInfo()(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - level parser error", func(t *testing.T) {
+		// --- Given ---
+		cfg := DefaultConfig()
+		cfg.LevelParser = func(val any) (string, error) {
+			return "", errors.New("unknown level")
+		}
+		ent := Entry{cfg: cfg, m: map[string]any{"level": 30.0}}
+
+		// --- When ---
+		err := CheckLevel("info")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrFormat, err)
+	})
+}
+
+func Test_check_level_success_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		check Checker
+		level string
+	}{
+		{"debug", CheckDebug(), DefaultConfig().LevelDebugValue},
+		{"info", CheckInfo(), DefaultConfig().LevelInfoValue},
+		{"warn", CheckWarn(), DefaultConfig().LevelWarnValue},
+		{"error", CheckError(), DefaultConfig().LevelErrorValue},
+		{"fatal", CheckFatal(), DefaultConfig().LevelFatalValue},
+		{"panic", CheckPanic(), DefaultConfig().LevelPanicValue},
+		{"trace", CheckTrace(), DefaultConfig().LevelTraceValue},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			tspy := tester.New(t, 0)
+			tspy.Close()
+
+			ent := Entry{
+				cfg: DefaultConfig(),
+				m:   map[string]any{"level": tc.level},
+			}
+
+			// --- When ---
+			err := tc.check(ent)
+
+			// --- Then ---
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_check_level_failure_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		check Checker
+		want  string
+		have  string
+	}{
+		{"debug", CheckDebug(), DefaultConfig().LevelDebugValue, "info"},
+		{"info", CheckInfo(), "info", DefaultConfig().LevelTraceValue},
+		{"warn", CheckWarn(), DefaultConfig().LevelWarnValue, "info"},
+		{"error", CheckError(), DefaultConfig().LevelErrorValue, "info"},
+		{"fatal", CheckFatal(), DefaultConfig().LevelFatalValue, "info"},
+		{"panic", CheckPanic(), DefaultConfig().LevelPanicValue, "info"},
+		{"trace", CheckTrace(), DefaultConfig().LevelTraceValue, "info"},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			tspy := tester.New(t, 0)
+			tspy.Close()
+
+			ent := Entry{
+				cfg: DefaultConfig(),
+				m:   map[string]any{"level": tc.have},
+			}
+
+			// --- When ---
+			err := tc.check(ent)
+
+			// --- Then ---
+			wMsg := "" +
+				"[log entry] expected values to be equal:\n" +
+				"  field: level\n" +
+				"   want: %q\n" +
+				"   have: %q"
+			wMsg = fmt.Sprintf(wMsg, tc.want, tc.have)
+			assert.ErrorEqual(t, wMsg, err)
+			assert.ErrorIs(t, ErrValue, err)
+		})
+	}
+}
+
+func Test_CheckAllOf(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": "b"}}
+
+		// --- When ---
+		err := CheckAllOf(CheckStr("A", "a"), CheckStr("B", "b"))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("no checks", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a"}}
+
+		// --- When ---
+		err := CheckAllOf()(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - returns the first failing check error", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": "b"}}
+
+		// --- When ---
+		err := CheckAllOf(
+			CheckStr("A", "a"),
+			CheckStr("B", "x"),
+			CheckStr("C", "c"),
+		)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: B\n" +
+			"   want: \"x\"\n" +
+			"   have: \"b\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_checkEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{1.0, "a"}}}
+
+		// --- When ---
+		err := checkEqual("arr", []any{1.0, "a"})(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("equal nil", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"null": nil}}
+
+		// --- When ---
+		err := checkEqual("null", nil)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - when a field is not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{1.0, "a"}}}
+
+		// --- When ---
+		err := checkEqual("arr", []any{1.0, "b"})(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+		assert.ErrorContain(t, "field: arr", err)
+	})
+
+	t.Run("error - when a field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: make(map[string]any)}
+
+		// --- When ---
+		err := checkEqual("missing", []any{1.0})(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckMap(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"map": map[string]any{"str": "abc"}}}
+
+		// --- When ---
+		err := CheckMap("map", map[string]any{"str": "abc"})(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - when a field is not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"map": map[string]any{"str": "abc"}}}
+
+		// --- When ---
+		err := CheckMap("map", map[string]any{"str": "xyz"})(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  trail: map[\"str\"]\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - when a field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: make(map[string]any)}
+
+		// --- When ---
+		err := CheckMap("missing", map[string]any{"str": "abc"})(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckPath(t *testing.T) {
+	m := map[string]any{
+		"http":  map[string]any{"method": "GET"},
+		"items": []any{1.0, 2.0},
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		err := CheckPath("http.method", "GET")(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("equal array element", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		err := CheckPath("items[1]", 2.0)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		err := CheckPath("http.method", "POST")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  path: http.method\n" +
+			"  want: \"POST\"\n" +
+			"  have: \"GET\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - path does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		err := CheckPath("http.code", 200.0)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckInt(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"int": 123}`, m: map[string]any{"int": 123.0}}
+
+		// --- When ---
+		err := CheckInt("int", 123)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"int": 123}`, m: map[string]any{"int": 123.0}}
+
+		// --- When ---
+		err := CheckInt("int", 124)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: int\n" +
+			"   want: 124\n" +
+			"   have: 123"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - not integral", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"num": 1.5}`, m: map[string]any{"num": 1.5}}
+
+		// --- When ---
+		err := CheckInt("num", 1)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+	})
+}
+
+func Test_CheckInt64(t *testing.T) {
+	const lin = `{"int": 9007199254740993}`
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		err := CheckInt64("int", 9007199254740993)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		err := CheckInt64("int", 9007199254740992)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: int\n" +
+			"   want: 9007199254740992\n" +
+			"   have: 9007199254740993"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{}`).ets[0]
+
+		// --- When ---
+		err := CheckInt64("int", 1)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckUint64(t *testing.T) {
+	const lin = `{"int": 18446744073709551615}`
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		err := CheckUint64("int", 18446744073709551615)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		err := CheckUint64("int", 1)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckSliceLen(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{"abc", "def"}}}
+
+		// --- When ---
+		err := CheckSliceLen("arr", 2)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{"abc", "def"}}}
+
+		// --- When ---
+		err := CheckSliceLen("arr", 3)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected array to have length:\n" +
+			"  field: arr\n" +
+			"   want: 3\n" +
+			"   have: 2"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field is not an array", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		err := CheckSliceLen("str", 3)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+	})
+}
+
+func Test_CheckSliceContains(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{"abc", 1.0}}}
+
+		// --- When ---
+		err := CheckSliceContains("arr", 1.0)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - does not contain", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{"abc", 1.0}}}
+
+		// --- When ---
+		err := CheckSliceContains("arr", "xyz")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected array to contain the value:\n" +
+			"  field: arr\n" +
+			"   want: \"xyz\"\n" +
+			"   have: []interface {}{\"abc\", 1}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{}}
+
+		// --- When ---
+		err := CheckSliceContains("arr", "xyz")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckSubset(t *testing.T) {
+	m := map[string]any{
+		"str": "abc",
+		"num": 1.0,
+		"http": map[string]any{
+			"method": "GET",
+			"status": 200.0,
+			"header": map[string]any{"A": "a", "B": "b"},
+		},
+	}
+
+	t.Run("subset", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+		want := map[string]any{
+			"num": 1,
+			"http": map[string]any{
+				"status": 200,
+				"header": map[string]any{"B": "b"},
+			},
+		}
+
+		// --- When ---
+		err := CheckSubset(want)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		err := CheckSubset(map[string]any{})(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - nested value not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+		want := map[string]any{
+			"http": map[string]any{"header": map[string]any{"B": "x"}},
+		}
+
+		// --- When ---
+		err := CheckSubset(want)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: http.header.B\n" +
+			"   want: \"x\"\n" +
+			"   have: \"b\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - nested field missing", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+		want := map[string]any{"http": map[string]any{"path": "/"}}
+
+		// --- When ---
+		err := CheckSubset(want)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to be present:\n" +
+			"  field: http.path"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+
+	t.Run("error - map and not a map", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+		want := map[string]any{"str": map[string]any{"A": 1}}
+
+		// --- When ---
+		err := CheckSubset(want)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		err := CheckSubset(nil)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - want not JSON compatible", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+		want := map[string]any{"ch": make(chan int)}
+
+		// --- When ---
+		err := CheckSubset(want)(ent)
+
+		// --- Then ---
+		assert.ErrorContain(t, "expected want to be JSON compatible", err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckMatch(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "processed 123 items in 4.5ms"}}
+
+		// --- When ---
+		err := CheckMatch("str", `^processed \d+ items in [\d.]+ms$`)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - no match", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		err := CheckMatch("str", `^\d+$`)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected regexp to match:\n" +
+			"   field: str\n" +
+			"  regexp: ^\\d+$\n" +
+			"    have: \"abc\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - invalid pattern", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		err := CheckMatch("str", `[`)(ent)
+
+		// --- Then ---
+		assert.ErrorContain(t, "[log entry] expected valid regexp", err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field is not a string", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 1.0}}
+
+		// --- When ---
+		err := CheckMatch("num", `1`)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+	})
+}
+
+func Test_CheckNot(t *testing.T) {
+	t.Run("checker fails", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		err := CheckNot(CheckStr("str", "xyz"))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("field missing", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{}}
+
+		// --- When ---
+		err := CheckNot(CheckStr("str", "xyz"))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - checker passes", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		err := CheckNot(CheckStr("str", "abc"))(ent)
+
+		// --- Then ---
+		wMsg := "[log entry] expected log entry not to pass the check"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckNotStrict(t *testing.T) {
+	t.Run("checker fails", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		err := CheckNotStrict(CheckStr("str", "xyz"))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": 1.0}}
+
+		// --- When ---
+		err := CheckNotStrict(CheckStr("str", "xyz"))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - field missing", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{}}
+
+		// --- When ---
+		err := CheckNotStrict(CheckStr("str", "xyz"))(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+
+	t.Run("error - checker passes", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		err := CheckNotStrict(CheckStr("str", "abc"))(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckAnyOf(t *testing.T) {
+	t.Run("first check passes", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": "b"}}
+
+		// --- When ---
+		err := CheckAnyOf(CheckStr("A", "a"), CheckStr("B", "x"))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("last check passes", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": "b"}}
+
+		// --- When ---
+		err := CheckAnyOf(CheckStr("A", "x"), CheckStr("B", "b"))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - no checks", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a"}}
+
+		// --- When ---
+		err := CheckAnyOf()(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected any of the checks to pass:\n" +
+			"  checks: 0"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - no check passes", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a"}}
+
+		// --- When ---
+		err := CheckAnyOf(CheckStr("A", "x"), CheckStr("A", "y"))(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected any of the checks to pass:\n" +
+			"   checks: 2\n" +
+			"  error 0:\n" +
+			"           [log entry] expected values to be equal:\n" +
+			"             field: A\n" +
+			"              want: \"x\"\n" +
+			"              have: \"a\"\n" +
+			"  error 1:\n" +
+			"           [log entry] expected values to be equal:\n" +
+			"             field: A\n" +
+			"              want: \"y\"\n" +
+			"              have: \"a\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckAbsent(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a"}}
+
+		// --- When ---
+		err := CheckAbsent("B")(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - present", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a"}}
+
+		// --- When ---
+		err := CheckAbsent("A")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field not to be present:\n" +
+			"  field: A"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - present with null value", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": nil}}
+
+		// --- When ---
+		err := CheckAbsent("A")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+	})
+	t.Run("nested field absent", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"error": {"type": "io"}}`).ets[0]
+
+		// --- When ---
+		err := CheckAbsent("error.message")(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - nested field present", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"error": {"message": "boom"}}`).ets[0]
+
+		// --- When ---
+		err := CheckAbsent("error.message")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field not to be present:\n" +
+			"  field: error.message"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckFieldsExactly(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": 1.0}}
+
+		// --- When ---
+		err := CheckFieldsExactly("B", "A")(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - missing and extra fields", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "C": 1.0, "D": true}}
+
+		// --- When ---
+		err := CheckFieldsExactly("B", "A")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to have exactly the fields:\n" +
+			"     want: A, B\n" +
+			"     have: A, C, D\n" +
+			"  missing: B\n" +
+			"    extra: C, D"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - extra fields", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": 1.0}}
+
+		// --- When ---
+		err := CheckFieldsExactly("A")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to have exactly the fields:\n" +
+			"   want: A\n" +
+			"   have: A, B\n" +
+			"  extra: B"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+}
+
+func Test_CheckNumberNear(t *testing.T) {
+	t.Run("within delta", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 4.5}}
+
+		// --- When ---
+		err := CheckNumberNear("num", 5, 0.5)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not within delta", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 4.5}}
+
+		// --- When ---
+		err := CheckNumberNear("num", 5, 0.1)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected numbers to be within the given delta:\n" +
+			"       field: num\n" +
+			"        want: 5\n" +
+			"        have: 4.5\n" +
+			"  want delta: 0.1\n" +
+			"  have delta: 0.5"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{}}
+
+		// --- When ---
+		err := CheckNumberNear("num", 5, 0.1)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckNumberGreater(t *testing.T) {
+	t.Run("greater", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 4.5}}
+
+		// --- When ---
+		err := CheckNumberGreater("num", 4)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 4.5}}
+
+		// --- When ---
+		err := CheckNumberGreater("num", 4.5)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected value to be greater:\n" +
+			"         field: num\n" +
+			"  greater than: 4.5\n" +
+			"          have: 4.5"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field is not a number", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": "abc"}}
+
+		// --- When ---
+		err := CheckNumberGreater("num", 4)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+	})
+}
+
+func Test_CheckNumberLess(t *testing.T) {
+	t.Run("less", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 4.5}}
+
+		// --- When ---
+		err := CheckNumberLess("num", 5)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - greater", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 4.5}}
+
+		// --- When ---
+		err := CheckNumberLess("num", 4)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected value to be smaller:\n" +
+			"         field: num\n" +
+			"  smaller than: 4\n" +
+			"          have: 4.5"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{}}
+
+		// --- When ---
+		err := CheckNumberLess("num", 4)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckTimeAfter(t *testing.T) {
+	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	m := map[string]any{"time": entTim.Format(time.RFC3339)}
+
+	t.Run("after", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: m}
+
+		// --- When ---
+		err := CheckTimeAfter("time", entTim.Add(-time.Second))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - equal", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: m}
+
+		// --- When ---
+		err := CheckTimeAfter("time", entTim)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected date to be after mark:\n" +
+			"  field: time\n" +
+			"   date: 2000-01-02T03:04:05Z\n" +
+			"   mark: 2000-01-02T03:04:05Z\n" +
+			"   diff: 0s"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: map[string]any{}}
+
+		// --- When ---
+		err := CheckTimeAfter("time", entTim)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckTimeBefore(t *testing.T) {
+	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	m := map[string]any{"time": entTim.Format(time.RFC3339)}
+
+	t.Run("before", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: m}
+
+		// --- When ---
+		err := CheckTimeBefore("time", entTim.Add(time.Second))(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - after", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: m}
+
+		// --- When ---
+		err := CheckTimeBefore("time", entTim.Add(-time.Second))(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected date to be before mark:\n" +
+			"  field: time\n" +
+			"   date: 2000-01-02T03:04:05Z\n" +
+			"   mark: 2000-01-02T03:04:04Z\n" +
+			"   diff: 1s"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field is not a time", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: map[string]any{"time": 1.0}}
+
+		// --- When ---
+		err := CheckTimeBefore("time", entTim)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+	})
+}
+
+func Test_CheckDurationWithin(t *testing.T) {
+	t.Run("within delta", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: map[string]any{"dur": 1050.0}}
+
+		// --- When ---
+		err := CheckDurationWithin("dur", time.Second, 50*time.Millisecond)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("within delta below", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: map[string]any{"dur": 950.0}}
+
+		// --- When ---
+		err := CheckDurationWithin("dur", time.Second, 50*time.Millisecond)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not within delta", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: map[string]any{"dur": 1100.0}}
+
+		// --- When ---
+		err := CheckDurationWithin("dur", time.Second, 50*time.Millisecond)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected durations to be within the given delta:\n" +
+			"       field: dur\n" +
+			"        want: 1s\n" +
+			"        have: 1.1s\n" +
+			"  want delta: 50ms\n" +
+			"  have delta: 100ms"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{cfg: DefaultConfig(), m: map[string]any{}}
+
+		// --- When ---
+		err := CheckDurationWithin("dur", time.Second, time.Second)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckUUID(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+		ok  bool
+	}{
+		{"lower case", "f47ac10b-58cc-4372-a567-0e02b2c3d479", true},
+		{"upper case", "F47AC10B-58CC-4372-A567-0E02B2C3D479", true},
+		{"no dashes", "f47ac10b58cc4372a5670e02b2c3d479", false},
+		{"too short", "f47ac10b-58cc-4372-a567-0e02b2c3d47", false},
+		{"not hex", "g47ac10b-58cc-4372-a567-0e02b2c3d479", false},
+		{"empty", "", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ent := Entry{m: map[string]any{"id": tc.val}}
+
+			// --- When ---
+			err := CheckUUID("id")(ent)
+
+			// --- Then ---
+			if tc.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, ErrFormat, err)
+			}
+		})
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"id": "abc"}}
+
+		// --- When ---
+		err := CheckUUID("id")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to be a valid UUID:\n" +
+			"  field: id\n" +
+			"  value: \"abc\"\n" +
+			"  error: invalid UUID format"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{}}
+
+		// --- When ---
+		err := CheckUUID("id")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
+
+func Test_CheckIP(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+		ok  bool
+	}{
+		{"IPv4", "192.168.1.1", true},
+		{"IPv6", "2001:db8::1", true},
+		{"IPv4 out of range", "256.1.1.1", false},
+		{"with port", "127.0.0.1:80", false},
+		{"host name", "localhost", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ent := Entry{m: map[string]any{"ip": tc.val}}
+
+			// --- When ---
+			err := CheckIP("ip")(ent)
+
+			// --- Then ---
+			if tc.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, ErrFormat, err)
+			}
+		})
+	}
+
+	t.Run("error - field is not a string", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"ip": 1.0}}
+
+		// --- When ---
+		err := CheckIP("ip")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+	})
+}
+
+func Test_CheckURL(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val string
+		ok  bool
+	}{
+		{"http", "http://example.com/path?a=1", true},
+		{"https with port", "https://example.com:8080", true},
+		{"relative", "/path", false},
+		{"no scheme", "example.com", false},
+		{"invalid", "http://exa mple.com", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ent := Entry{m: map[string]any{"url": tc.val}}
+
+			// --- When ---
+			err := CheckURL("url")(ent)
+
+			// --- Then ---
+			if tc.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, ErrFormat, err)
+			}
+		})
+	}
+}
+
+func Test_CheckOneOf(t *testing.T) {
+	t.Run("one of strings", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"status": "gave_up"}}
+
+		// --- When ---
+		err := CheckOneOf("status", "retrying", "gave_up")(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("one of numbers", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"code": 404.0}}
+
+		// --- When ---
+		err := CheckOneOf("code", 400, 404)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not one of", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"status": "done"}}
+
+		// --- When ---
+		err := CheckOneOf("status", "retrying", "gave_up")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to be one of:\n" +
+			"  field: status\n" +
+			"   want: [\"retrying\",\"gave_up\"]\n" +
+			"   have: done"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - no values", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"status": "done"}}
+
+		// --- When ---
+		err := CheckOneOf("status")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{}}
+
+		// --- When ---
+		err := CheckOneOf("status", "done")(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+
+	t.Run("error - want not JSON compatible", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"status": "done"}}
+
+		// --- When ---
+		err := CheckOneOf("status", make(chan int))(ent)
+
+		// --- Then ---
+		assert.ErrorContain(t, "expected want to be JSON compatible", err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_jsonNormalize(t *testing.T) {
+	t.Run("normalize", func(t *testing.T) {
+		// --- When ---
+		have, err := jsonNormalize(map[string]any{"A": 1, "B": []int{2}})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{"A": 1.0, "B": []any{2.0}}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error - not JSON compatible", func(t *testing.T) {
+		// --- When ---
+		have, err := jsonNormalize(make(chan int))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+		assert.Nil(t, have)
+	})
+}
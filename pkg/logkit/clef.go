@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+// Names of the Serilog compact log event format (CLEF) fields.
+const (
+	CLEFTime      = "@t"
+	CLEFLevel     = "@l"
+	CLEFMessage   = "@m"
+	CLEFTemplate  = "@mt"
+	CLEFException = "@x"
+	CLEFEventID   = "@i"
+)
+
+// CLEFConfig returns the instance of [Config] configured for Serilog compact
+// log event format (CLEF). Use [Tester.CLEFWriter] to write CLEF log lines
+// which omit the level or the rendered message.
+func CLEFConfig() *Config {
+	return &Config{
+		TimeField:    CLEFTime,
+		LevelField:   CLEFLevel,
+		MessageField: CLEFMessage,
+		ErrorField:   CLEFException,
+
+		ServiceField:   "Application",
+		ComponentField: "SourceContext",
+		CallerField:    "", // Not used in Serilog.
+		StackField:     "", // Not used in Serilog.
+
+		TimeFormat:   time.RFC3339Nano,
+		DurationUnit: time.Millisecond,
+
+		LevelTraceValue: "Verbose",
+		LevelDebugValue: "Debug",
+		LevelInfoValue:  "Information",
+		LevelWarnValue:  "Warning",
+		LevelErrorValue: "Error",
+		LevelFatalValue: "Fatal",
+		LevelPanicValue: "Panic", // Not supported by Serilog.
+	}
+}
+
+// CLEFWriter returns an [io.Writer] for Serilog compact log event format
+// (CLEF) log lines which decodes them before writing to the [Tester]. Lines
+// without the [CLEFLevel] field get the [Config.LevelInfoValue] level, and
+// lines without the [CLEFMessage] field get the message rendered from the
+// [CLEFTemplate] message template, see [RenderTemplate]. Lines which are not
+// JSON objects are written as they are.
+//
+// Example usage:
+//
+//	tst := logkit.New(t, logkit.WithConfig(logkit.CLEFConfig()))
+//	cmd.Stdout = tst.CLEFWriter()
+//	tst.WaitFor("5s", logkit.CheckMsg(`User "alice" logged in`))
+func (tst *Tester) CLEFWriter() io.Writer {
+	return &lineWriter{fn: tst.clefLine}
+}
+
+// clefLine decodes CLEF log line and writes it to the [Tester].
+func (tst *Tester) clefLine(line []byte) {
+	if len(bytes.TrimSpace(line)) == 0 {
+		return
+	}
+	var m map[string]any
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	if err := dec.Decode(&m); err != nil || m == nil {
+		_, _ = tst.Write(line)
+		return
+	}
+	if _, ok := m[CLEFLevel]; !ok {
+		m[CLEFLevel] = tst.cfg.LevelInfoValue
+	}
+	if _, ok := m[CLEFMessage]; !ok {
+		if tpl, ok := m[CLEFTemplate].(string); ok {
+			m[CLEFMessage] = RenderTemplate(tpl, m)
+		}
+	}
+	data, _ := json.Marshal(m) // Decoded JSON always marshals.
+	_, _ = tst.Write(append(data, '\n'))
+}
+
+// RenderTemplate renders Serilog message template using the given
+// properties. As in Serilog, string values are quoted unless the ":l" format
+// is used, other values are rendered as JSON, and the "{{" and "}}" are
+// rendered as braces. Format and alignment specifiers are otherwise ignored,
+// and properties missing in the map are rendered as they are in the template.
+func RenderTemplate(tpl string, props map[string]any) string {
+	var buf strings.Builder
+	for i := 0; i < len(tpl); i++ {
+		chr := tpl[i]
+		if (chr == '{' || chr == '}') && i+1 < len(tpl) && tpl[i+1] == chr {
+			buf.WriteByte(chr)
+			i++
+			continue
+		}
+		end := strings.IndexByte(tpl[i:], '}')
+		if chr != '{' || end < 0 {
+			buf.WriteByte(chr)
+			continue
+		}
+		token := tpl[i : i+end+1]
+		name := strings.TrimLeft(token[1:len(token)-1], "@$")
+		name, format, _ := strings.Cut(name, ":")
+		name, _, _ = strings.Cut(name, ",")
+		val, ok := props[name]
+		switch {
+		case !ok:
+			buf.WriteString(token)
+		case format == "l":
+			if str, ok := val.(string); ok {
+				buf.WriteString(str)
+				break
+			}
+			fallthrough
+		default:
+			var data bytes.Buffer
+			enc := json.NewEncoder(&data)
+			enc.SetEscapeHTML(false)
+			_ = enc.Encode(val)
+			buf.Write(bytes.TrimRight(data.Bytes(), "\n"))
+		}
+		i += end
+	}
+	return buf.String()
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_CLEFConfig(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	lin := `{"@t":"2025-01-02T03:04:05.1234567Z","@l":"Error",` +
+		`"@m":"Request failed","@x":"System.Exception: boom",` +
+		`"Application":"api"}`
+
+	// --- When ---
+	tst := New(tspy, WithConfig(CLEFConfig()), WithString(lin))
+
+	// --- Then ---
+	ent := tst.FirstEntry()
+	want := time.Date(2025, 1, 2, 3, 4, 5, 123456700, time.UTC)
+	assert.True(t, ent.AssertLevel("Error"))
+	assert.True(t, ent.AssertMsg("Request failed"))
+	assert.True(t, ent.AssertError("System.Exception: boom"))
+	assert.True(t, ent.AssertTime("@t", want))
+	assert.Equal(t, "api", must.Value(ent.Service()))
+	assert.Len(t, 1, tst.Filter(CheckError()).Get())
+	assert.NoError(t, CLEFConfig().Validate())
+}
+
+func Test_Tester_CLEFWriter(t *testing.T) {
+	t.Run("renders message and sets level", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithConfig(CLEFConfig()))
+		w := tst.CLEFWriter()
+
+		// --- When ---
+		must.Value(w.Write([]byte("" +
+			`{"@t":"2025-01-02T03:04:05Z","@mt":"User {User} logged in",` +
+			`"User":"alice","Id":12345678901234567890}` + "\n",
+		)))
+
+		// --- Then ---
+		ent := tst.FirstEntry()
+		assert.True(t, ent.AssertLevel("Information"))
+		assert.True(t, ent.AssertMsg(`User "alice" logged in`))
+		assert.True(t, ent.AssertUint64("Id", 12345678901234567890))
+	})
+
+	t.Run("keeps level and message", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithConfig(CLEFConfig()))
+		w := tst.CLEFWriter()
+
+		// --- When ---
+		must.Value(w.Write([]byte("" +
+			`{"@l":"Warning","@m":"rendered","@mt":"template"}` + "\n",
+		)))
+
+		// --- Then ---
+		ent := tst.FirstEntry()
+		assert.True(t, ent.AssertLevel("Warning"))
+		assert.True(t, ent.AssertMsg("rendered"))
+	})
+
+	t.Run("not JSON object", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithConfig(CLEFConfig()))
+		w := tst.CLEFWriter()
+
+		// --- When ---
+		must.Value(w.Write([]byte("\nnot json\n")))
+
+		// --- Then ---
+		assert.Equal(t, "not json\n", tst.String())
+	})
+}
+
+func Test_RenderTemplate(t *testing.T) {
+	props := map[string]any{
+		"User":  "alice",
+		"Count": json.Number("3"),
+		"Tags":  []any{"a", "b"},
+		"Html":  "<b>",
+	}
+
+	tt := []struct {
+		testN string
+
+		tpl  string
+		want string
+	}{
+		{"no properties", "plain text", "plain text"},
+		{"string", "User {User}", `User "alice"`},
+		{"literal string", "User {User:l}", "User alice"},
+		{"number", "Count {Count}", "Count 3"},
+		{"literal number", "Count {Count:l}", "Count 3"},
+		{"destructured", "Tags {@Tags}", `Tags ["a","b"]`},
+		{"stringified", "User {$User}", `User "alice"`},
+		{"alignment", "User {User,10}", `User "alice"`},
+		{"format", "Count {Count:000}", "Count 3"},
+		{"missing", "Id {Id}", "Id {Id}"},
+		{"escaped braces", "{{User}} }}", "{User} }"},
+		{"unclosed", "User {User", "User {User"},
+		{"no HTML escaping", "{Html}", `"<b>"`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := RenderTemplate(tc.tpl, props)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
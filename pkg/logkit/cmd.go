@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"sync"
+
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// StreamField is the name of the field added to log entries captured from
+// subprocesses with the name of the stream the entry was written to.
+const StreamField = "stream"
+
+// Names of the subprocess streams used as [StreamField] values.
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+)
+
+// Command creates a new [Tester] and attaches it to the command with
+// [Tester.AttachCmd]. It must be called before the command is started. The
+// last line written by the command without the ending new line is captured
+// when the test ends.
+//
+// Example usage:
+//
+//	cmd := exec.Command("./app")
+//	tst := logkit.Command(t, cmd)
+//	must.Nil(cmd.Start())
+//	tst.WaitFor("5s", logkit.CheckMsg("started"))
+func Command(t tester.T, cmd *exec.Cmd, opts ...func(*Tester)) *Tester {
+	t.Helper()
+	tst := New(t, opts...)
+	t.Cleanup(tst.AttachCmd(cmd))
+	return tst
+}
+
+// AttachCmd sets the command standard output and error to write log lines to
+// the [Tester]. Each log entry gets the [StreamField] field set to the name
+// of the stream it was written to. If the field already exists in the log
+// line, its value is preserved. Lines which are not JSON objects are captured
+// as entries with the line in the [Config.MessageField] field. It must be
+// called before the command is started. Returns a function which captures
+// the last lines written by the command without the ending new line; call it
+// after the command exits.
+//
+// Example usage:
+//
+//	flush := tst.AttachCmd(cmd)
+//	must.Nil(cmd.Run())
+//	flush()
+func (tst *Tester) AttachCmd(cmd *exec.Cmd) func() {
+	stdout := &lineWriter{fn: tst.streamLine(StreamStdout)}
+	stderr := &lineWriter{fn: tst.streamLine(StreamStderr)}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	return func() {
+		stdout.flush()
+		stderr.flush()
+	}
+}
+
+// streamLine returns a function writing log lines to the [Tester] with the
+// [StreamField] field set to the given stream name.
+func (tst *Tester) streamLine(stream string) func(line []byte) {
+	return func(line []byte) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			return
+		}
+		line = prependField(tst.cfg, line, StreamField, stream)
+		_, _ = tst.Write(append(line, '\n'))
+	}
+}
+
+// prependField returns the log line with the field prepended. When the field
+// already exists in the log line, the line is returned unchanged. Lines which
+// are not JSON objects are converted to objects with the line in the
+// [Config.MessageField] field.
+func prependField(cfg *Config, line []byte, name, value string) []byte {
+	if len(line) < 2 || line[0] != '{' || !json.Valid(line) {
+		m := map[string]string{cfg.MessageField: string(line)}
+		line, _ = json.Marshal(m) // Map of strings always marshals.
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(line, &m); err == nil {
+		if _, ok := m[name]; ok {
+			return line
+		}
+	}
+	fld, _ := json.Marshal(name)
+	val, _ := json.Marshal(value)
+
+	buf := make([]byte, 0, len(line)+len(fld)+len(val)+3)
+	buf = append(buf, '{')
+	buf = append(buf, fld...)
+	buf = append(buf, ':')
+	buf = append(buf, val...)
+	if rest := bytes.TrimSpace(line[1:]); rest[0] != '}' {
+		buf = append(buf, ',')
+	}
+	return append(buf, line[1:]...)
+}
+
+// lineWriter represents an [io.Writer] which splits written data into lines
+// and calls a function for each complete line.
+type lineWriter struct {
+	fn   func(line []byte) // Function called for each line.
+	rest []byte            // Incomplete line written so far.
+	mx   sync.Mutex        // Guards the structure fields.
+}
+
+// Write implements [io.Writer] interface. It never returns an error.
+func (lw *lineWriter) Write(p []byte) (int, error) {
+	lw.mx.Lock()
+	defer lw.mx.Unlock()
+
+	lw.rest = append(lw.rest, p...)
+	for {
+		idx := bytes.IndexByte(lw.rest, '\n')
+		if idx < 0 {
+			break
+		}
+		lw.fn(lw.rest[:idx+1])
+		lw.rest = lw.rest[idx+1:]
+	}
+	return len(p), nil
+}
+
+// flush calls the function for the incomplete line written so far, if any.
+func (lw *lineWriter) flush() {
+	lw.mx.Lock()
+	defer lw.mx.Unlock()
+	if len(lw.rest) > 0 {
+		lw.fn(lw.rest)
+		lw.rest = nil
+	}
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Command(t *testing.T) {
+	t.Run("command", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		script := "" +
+			`echo '{"level":"info","message":"msg0"}';` +
+			`echo '{"level":"error","message":"msg1"}' >&2`
+		cmd := exec.Command("sh", "-c", script)
+
+		// --- When ---
+		tst := Command(tspy, cmd)
+
+		// --- Then ---
+		must.Nil(cmd.Run())
+		assert.Equal(t, 2, tst.Len())
+		tst.Filter(CheckStr(StreamField, StreamStdout)).AssertLen(1)
+		tst.Filter(CheckStr(StreamField, StreamStderr)).AssertLen(1)
+		ent := tst.Filter(CheckMsg("msg1")).Get()[0]
+		assert.Equal(t, StreamStderr, must.Value(ent.Str(StreamField)))
+		tspy.Finish()
+	})
+
+	t.Run("unterminated line captured at the test end", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		cmd := exec.Command("sh", "-c", `printf '{"message":"msg0"}'`)
+		tst := Command(tspy, cmd)
+		must.Nil(cmd.Run())
+
+		// --- When ---
+		tspy.Finish()
+
+		// --- Then ---
+		want := `{"stream":"stdout","message":"msg0"}` + "\n"
+		assert.Equal(t, want, tst.String())
+	})
+}
+
+func Test_Tester_AttachCmd(t *testing.T) {
+	t.Run("attach", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		cmd := exec.Command("sh", "-c", `echo '{"message":"msg0"}'`)
+
+		// --- When ---
+		tst.AttachCmd(cmd)
+
+		// --- Then ---
+		must.Nil(cmd.Run())
+		want := `{"stream":"stdout","message":"msg0"}` + "\n"
+		assert.Equal(t, want, tst.String())
+	})
+
+	t.Run("flush unterminated lines", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		script := "" +
+			`printf '{"message":"msg0"}';` +
+			`printf '{"message":"msg1"}' >&2`
+		cmd := exec.Command("sh", "-c", script)
+
+		// --- When ---
+		flush := tst.AttachCmd(cmd)
+
+		// --- Then ---
+		must.Nil(cmd.Run())
+		assert.Equal(t, 0, tst.Len())
+		flush()
+		want := "" +
+			`{"stream":"stdout","message":"msg0"}` + "\n" +
+			`{"stream":"stderr","message":"msg1"}` + "\n"
+		assert.Equal(t, want, tst.String())
+	})
+}
+
+func Test_Tester_streamLine(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		line string
+		want string
+	}{
+		{"object", `{"A":1}`, `{"stream":"stdout","A":1}` + "\n"},
+		{"spaces", ` { "A" : 1 } `, `{"stream":"stdout", "A" : 1 }` + "\n"},
+		{"empty object", `{}`, `{"stream":"stdout"}` + "\n"},
+		{"empty object spaces", `{ }`, `{"stream":"stdout" }` + "\n"},
+		{"not JSON", `text`, `{"stream":"stdout","message":"text"}` + "\n"},
+		{"array", `[1]`, `{"stream":"stdout","message":"[1]"}` + "\n"},
+		{"invalid", `{"A"`, `{"stream":"stdout","message":"{\"A\""}` + "\n"},
+		{"existing", `{"stream":"x","A":1}`, `{"stream":"x","A":1}` + "\n"},
+		{"empty", " \n", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			tspy := tester.New(t)
+			tspy.Close()
+
+			tst := New(tspy)
+
+			// --- When ---
+			tst.streamLine(StreamStdout)([]byte(tc.line))
+
+			// --- Then ---
+			assert.Equal(t, tc.want, tst.String())
+		})
+	}
+}
+
+func Test_lineWriter_Write(t *testing.T) {
+	// --- Given ---
+	var have []string
+	fn := func(line []byte) { have = append(have, string(line)) }
+	lw := &lineWriter{fn: fn}
+
+	// --- When ---
+	n0, err0 := lw.Write([]byte("abc\nde"))
+	n1, err1 := lw.Write([]byte("f\n\nghi"))
+
+	// --- Then ---
+	assert.NoError(t, err0)
+	assert.Equal(t, 6, n0)
+	assert.NoError(t, err1)
+	assert.Equal(t, 6, n1)
+	assert.Equal(t, []string{"abc\n", "def\n", "\n"}, have)
+	assert.Equal(t, "ghi", string(lw.rest))
+}
+
+func Test_lineWriter_flush(t *testing.T) {
+	t.Run("incomplete line", func(t *testing.T) {
+		// --- Given ---
+		var have []string
+		fn := func(line []byte) { have = append(have, string(line)) }
+		lw := &lineWriter{fn: fn, rest: []byte("abc")}
+
+		// --- When ---
+		lw.flush()
+
+		// --- Then ---
+		assert.Equal(t, []string{"abc"}, have)
+		assert.Nil(t, lw.rest)
+	})
+
+	t.Run("nothing to flush", func(t *testing.T) {
+		// --- Given ---
+		var have []string
+		fn := func(line []byte) { have = append(have, string(line)) }
+		lw := &lineWriter{fn: fn}
+
+		// --- When ---
+		lw.flush()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
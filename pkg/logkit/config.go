@@ -4,16 +4,130 @@
 package logkit
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
+
+	"github.com/ctx42/testing/pkg/notice"
 )
 
+// RedactedValue is the value used in place of [Config.RedactFields] values.
+const RedactedValue = "[REDACTED]"
+
+// ErrConfig represents an error for invalid [Config].
+var ErrConfig = errors.New("invalid log config")
+
+// ConfigOption represents [NewConfig] option.
+type ConfigOption func(*Config)
+
+// WithTimeField is an option for [NewConfig] setting [Config.TimeField].
+func WithTimeField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.TimeField = name }
+}
+
+// WithLevelField is an option for [NewConfig] setting [Config.LevelField].
+func WithLevelField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.LevelField = name }
+}
+
+// WithMessageField is an option for [NewConfig] setting
+// [Config.MessageField].
+func WithMessageField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.MessageField = name }
+}
+
+// WithErrorField is an option for [NewConfig] setting [Config.ErrorField].
+func WithErrorField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.ErrorField = name }
+}
+
+// WithServiceField is an option for [NewConfig] setting
+// [Config.ServiceField].
+func WithServiceField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.ServiceField = name }
+}
+
+// WithComponentField is an option for [NewConfig] setting
+// [Config.ComponentField].
+func WithComponentField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.ComponentField = name }
+}
+
+// WithCallerField is an option for [NewConfig] setting [Config.CallerField].
+func WithCallerField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.CallerField = name }
+}
+
+// WithStackField is an option for [NewConfig] setting [Config.StackField].
+func WithStackField(name string) ConfigOption {
+	return func(cfg *Config) { cfg.StackField = name }
+}
+
+// WithTimeFormat is an option for [NewConfig] setting [Config.TimeFormat].
+func WithTimeFormat(format string) ConfigOption {
+	return func(cfg *Config) { cfg.TimeFormat = format }
+}
+
+// WithDurationUnit is an option for [NewConfig] setting
+// [Config.DurationUnit].
+func WithDurationUnit(unit time.Duration) ConfigOption {
+	return func(cfg *Config) { cfg.DurationUnit = unit }
+}
+
+// WithLevelValues is an option for [NewConfig] setting the [Config.LevelField]
+// values for trace, debug, info, warn, error, fatal and panic levels.
+func WithLevelValues(trc, dbg, inf, wrn, err, ftl, pnc string) ConfigOption {
+	return func(cfg *Config) {
+		cfg.LevelTraceValue = trc
+		cfg.LevelDebugValue = dbg
+		cfg.LevelInfoValue = inf
+		cfg.LevelWarnValue = wrn
+		cfg.LevelErrorValue = err
+		cfg.LevelFatalValue = ftl
+		cfg.LevelPanicValue = pnc
+	}
+}
+
+// WithLevelParser is an option for [NewConfig] setting [Config.LevelParser].
+func WithLevelParser(fn func(any) (string, error)) ConfigOption {
+	return func(cfg *Config) { cfg.LevelParser = fn }
+}
+
+// WithLineDecoder is an option for [NewConfig] setting [Config.LineDecoder].
+func WithLineDecoder(
+	fn func(line []byte) ([]byte, map[string]string, error),
+) ConfigOption {
+	return func(cfg *Config) { cfg.LineDecoder = fn }
+}
+
+// WithRequiredFields is an option for [NewConfig] setting
+// [Config.RequiredFields].
+func WithRequiredFields(fields ...string) ConfigOption {
+	return func(cfg *Config) { cfg.RequiredFields = fields }
+}
+
+// WithStrictKeys is an option for [NewConfig] setting [Config.StrictKeys].
+func WithStrictKeys() ConfigOption {
+	return func(cfg *Config) { cfg.StrictKeys = true }
+}
+
 // Config holds information about the log messages fields and their formats.
+// Field names with dots, like "error.message", match both the fields with
+// such names and the nested objects ({"error": {"message": "..."}}).
 type Config struct {
 	TimeField    string // Log message time field name.
 	LevelField   string // Log message level field name.
 	MessageField string // Log message field name.
 	ErrorField   string // Log message error field name.
 
+	ServiceField   string // Log message service name field name.
+	ComponentField string // Log message component name field name.
+	CallerField    string // Log message caller field name.
+	StackField     string // Log message stack trace field name.
+
 	LevelTraceValue string // The [Config.LevelField] trace level value.
 	LevelDebugValue string // The [Config.LevelField] debug level value.
 	LevelInfoValue  string // The [Config.LevelField] info level value.
@@ -24,6 +138,133 @@ type Config struct {
 
 	TimeFormat   string        // The [Config.TimeField] time format.
 	DurationUnit time.Duration // The [time.Duration] unit.
+
+	// Duration units for the fields which don't use [Config.DurationUnit].
+	DurationUnits map[string]time.Duration
+
+	// When not zero, numeric time field values are parsed as the number of
+	// units since the Unix epoch. String values are still parsed using
+	// [Config.TimeFormat].
+	TimeUnit time.Duration
+
+	// When true, time field values must be numbers of [Config.TimeUnit]
+	// (seconds when not set) since the Unix epoch, and string values are
+	// rejected.
+	TimeAsNumber bool
+
+	// When set, it's used to normalize the [Config.LevelField] values (numeric
+	// severities, localized strings, enums, etc.) into canonical levels used
+	// by all level assertions. When nil, the level field must be a string.
+	LevelParser func(any) (string, error)
+
+	// When set, it's called with every log line before it's decoded and
+	// returns the log entry line and its metadata, see [Entry.Meta]. It's
+	// used to unwrap log lines captured by container runtimes, see
+	// [DockerLine]. Decoded lines which are not JSON objects are captured as
+	// entries with the line in the [Config.MessageField] field.
+	LineDecoder func(line []byte) ([]byte, map[string]string, error)
+
+	// Names of the volatile fields (time, caller, pid, etc.) which are
+	// ignored when log entries are compared with [Entry.AssertRaw] and
+	// [Entries.AssertRaw].
+	RawIgnoreFields []string
+
+	// Names of the fields with sensitive values (tokens, emails, etc.) which
+	// are replaced with [RedactedValue] when log entries are summarized or
+	// printed to the test log.
+	RedactFields []string
+
+	// Names of the fields every log entry must have, see
+	// [Entries.AssertSchemaCompliant]. When empty, the [Config.TimeField],
+	// [Config.LevelField] and [Config.MessageField] fields are required.
+	RequiredFields []string
+
+	// When true, the log entries with the same key appearing more than once
+	// in an object mark the test as failed when they are written to the
+	// [Tester], see [CheckNoDuplicateKeys].
+	StrictKeys bool
+}
+
+// NewConfig returns a new instance of [Config] starting from [DefaultConfig]
+// and applying the given options. Use [Config.Validate] to check the result.
+//
+// Example usage:
+//
+//	cfg := logkit.NewConfig(
+//		logkit.WithMessageField("msg"),
+//		logkit.WithTimeFormat(time.RFC3339Nano),
+//	)
+//	must.Nil(cfg.Validate())
+func NewConfig(opts ...ConfigOption) *Config {
+	cfg := DefaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// Validate checks the configuration is usable by the assertions. It returns
+// an error having [ErrConfig] in its chain listing all the required fields
+// which are not set. The required fields are the time, level and message
+// field names, all level values, the time format (unless
+// [Config.TimeAsNumber] is set), and a positive duration unit.
+func (cfg *Config) Validate() error {
+	var empty []string
+	add := func(name string, ok bool) {
+		if !ok {
+			empty = append(empty, name)
+		}
+	}
+	add("TimeField", cfg.TimeField != "")
+	add("LevelField", cfg.LevelField != "")
+	add("MessageField", cfg.MessageField != "")
+	add("LevelTraceValue", cfg.LevelTraceValue != "")
+	add("LevelDebugValue", cfg.LevelDebugValue != "")
+	add("LevelInfoValue", cfg.LevelInfoValue != "")
+	add("LevelWarnValue", cfg.LevelWarnValue != "")
+	add("LevelErrorValue", cfg.LevelErrorValue != "")
+	add("LevelFatalValue", cfg.LevelFatalValue != "")
+	add("LevelPanicValue", cfg.LevelPanicValue != "")
+	add("TimeFormat", cfg.TimeFormat != "" || cfg.TimeAsNumber)
+	add("DurationUnit", cfg.DurationUnit > 0)
+	if len(empty) == 0 {
+		return nil
+	}
+	return notice.New("[log config] expected config fields to be set").
+		Append("fields", "%s", strings.Join(empty, ", ")).
+		Wrap(ErrConfig)
+}
+
+// requiredFields returns the names of the fields every log entry must have.
+func (cfg *Config) requiredFields() []string {
+	if len(cfg.RequiredFields) > 0 {
+		return cfg.RequiredFields
+	}
+	return []string{cfg.TimeField, cfg.LevelField, cfg.MessageField}
+}
+
+// levelRank returns the severity rank of the level value, from 0 for
+// [Config.LevelTraceValue] to 6 for [Config.LevelPanicValue]. Returns -1 for
+// unknown level values.
+func (cfg *Config) levelRank(level string) int {
+	levels := []string{
+		cfg.LevelTraceValue,
+		cfg.LevelDebugValue,
+		cfg.LevelInfoValue,
+		cfg.LevelWarnValue,
+		cfg.LevelErrorValue,
+		cfg.LevelFatalValue,
+		cfg.LevelPanicValue,
+	}
+	return slices.Index(levels, level)
+}
+
+// durationUnit returns the duration unit for the given field.
+func (cfg *Config) durationUnit(field string) time.Duration {
+	if unit, ok := cfg.DurationUnits[field]; ok {
+		return unit
+	}
+	return cfg.DurationUnit
 }
 
 // DefaultConfig returns the default instance of [Config] which matches the
@@ -35,6 +276,11 @@ func DefaultConfig() *Config {
 		MessageField: "message",
 		ErrorField:   "error",
 
+		ServiceField:   "service",
+		ComponentField: "component",
+		CallerField:    "caller",
+		StackField:     "stack",
+
 		TimeFormat:   time.RFC3339,
 		DurationUnit: time.Millisecond,
 
@@ -57,6 +303,11 @@ func SlogConfig() *Config {
 		MessageField: "msg",
 		ErrorField:   "error",
 
+		ServiceField:   "service",
+		ComponentField: "component",
+		CallerField:    "source",
+		StackField:     "stack",
+
 		TimeFormat:   time.RFC3339,
 		DurationUnit: time.Millisecond,
 
@@ -79,6 +330,11 @@ func LogrusConfig() *Config {
 		MessageField: "msg",
 		ErrorField:   "error",
 
+		ServiceField:   "service",
+		ComponentField: "component",
+		CallerField:    "file",
+		StackField:     "stack",
+
 		TimeFormat:   time.RFC3339,
 		DurationUnit: time.Nanosecond,
 
@@ -100,6 +356,11 @@ func ZapConfig() *Config {
 		MessageField: "msg",
 		ErrorField:   "", // Not used in zap.
 
+		ServiceField:   "service",
+		ComponentField: "component",
+		CallerField:    "caller",
+		StackField:     "stacktrace",
+
 		TimeFormat:   time.RFC3339,
 		DurationUnit: time.Second,
 
@@ -112,3 +373,157 @@ func ZapConfig() *Config {
 		LevelPanicValue: "panic",
 	}
 }
+
+// ECSConfig returns the instance of [Config] configured for Elastic Common
+// Schema (ECS) loggers like `ecszap` and other `ecs-logging` libraries.
+func ECSConfig() *Config {
+	return &Config{
+		TimeField:    "@timestamp",
+		LevelField:   "log.level",
+		MessageField: "message",
+		ErrorField:   "error.message",
+
+		ServiceField:   "service.name",
+		ComponentField: "log.logger",
+		CallerField:    "log.origin",
+		StackField:     "error.stack_trace",
+
+		TimeFormat:   time.RFC3339Nano,
+		DurationUnit: time.Nanosecond,
+
+		LevelTraceValue: "trace",
+		LevelDebugValue: "debug",
+		LevelInfoValue:  "info",
+		LevelWarnValue:  "warn",
+		LevelErrorValue: "error",
+		LevelFatalValue: "fatal",
+		LevelPanicValue: "panic",
+	}
+}
+
+// ZapProductionConfig returns the instance of [Config] configured for `zap`
+// production encoder, which logs time as floating point epoch seconds.
+func ZapProductionConfig() *Config {
+	cfg := ZapConfig()
+	cfg.ErrorField = "error"
+	cfg.TimeUnit = time.Second
+	cfg.TimeAsNumber = true
+	return cfg
+}
+
+// HclogConfig returns the instance of [Config] configured for `hclog` JSON
+// output.
+func HclogConfig() *Config {
+	return &Config{
+		TimeField:    "@timestamp",
+		LevelField:   "@level",
+		MessageField: "@message",
+		ErrorField:   "error",
+
+		ServiceField:   "service",
+		ComponentField: "@module",
+		CallerField:    "@caller",
+		StackField:     "stack",
+
+		TimeFormat:   "2006-01-02T15:04:05.000000Z07:00",
+		DurationUnit: time.Nanosecond,
+
+		LevelTraceValue: "trace",
+		LevelDebugValue: "debug",
+		LevelInfoValue:  "info",
+		LevelWarnValue:  "warn",
+		LevelErrorValue: "error",
+		LevelFatalValue: "fatal", // Not supported by hclog.
+		LevelPanicValue: "panic", // Not supported by hclog.
+	}
+}
+
+// BunyanConfig returns the instance of [Config] configured for `bunyan` and
+// other loggers using its numeric levels.
+func BunyanConfig() *Config {
+	return &Config{
+		TimeField:    "time",
+		LevelField:   "level",
+		MessageField: "msg",
+		ErrorField:   "err",
+
+		ServiceField:   "name",
+		ComponentField: "component",
+		CallerField:    "src",
+		StackField:     "stack",
+
+		TimeFormat:   time.RFC3339,
+		DurationUnit: time.Millisecond,
+
+		LevelTraceValue: "trace",
+		LevelDebugValue: "debug",
+		LevelInfoValue:  "info",
+		LevelWarnValue:  "warn",
+		LevelErrorValue: "error",
+		LevelFatalValue: "fatal",
+		LevelPanicValue: "panic", // Not supported by bunyan.
+
+		LevelParser: NumericLevels(BunyanLevels),
+	}
+}
+
+// PinoConfig returns the instance of [Config] configured for `pino`.
+func PinoConfig() *Config {
+	return &Config{
+		TimeField:    "time",
+		LevelField:   "level",
+		MessageField: "msg",
+		ErrorField:   "err",
+
+		ServiceField:   "name",
+		ComponentField: "component",
+		CallerField:    "caller",
+		StackField:     "stack",
+
+		TimeFormat:   time.RFC3339,
+		TimeUnit:     time.Millisecond,
+		DurationUnit: time.Millisecond,
+
+		LevelTraceValue: "trace",
+		LevelDebugValue: "debug",
+		LevelInfoValue:  "info",
+		LevelWarnValue:  "warn",
+		LevelErrorValue: "error",
+		LevelFatalValue: "fatal",
+		LevelPanicValue: "panic", // Not supported by pino.
+
+		LevelParser: NumericLevels(BunyanLevels),
+	}
+}
+
+// BunyanLevels maps `bunyan` and `pino` numeric levels to their names.
+var BunyanLevels = map[int]string{
+	10: "trace",
+	20: "debug",
+	30: "info",
+	40: "warn",
+	50: "error",
+	60: "fatal",
+}
+
+// NumericLevels returns a function, to be used as [Config.LevelParser], which
+// converts numeric level values to level names using the given map. String
+// level values are returned as they are. For any other values or numbers
+// missing in the map, it returns an error.
+func NumericLevels(levels map[int]string) func(any) (string, error) {
+	return func(val any) (string, error) {
+		switch v := val.(type) {
+		case string:
+			return v, nil
+		case float64:
+			if v == math.Trunc(v) {
+				if name, ok := levels[int(v)]; ok {
+					return name, nil
+				}
+			}
+			return "", fmt.Errorf("unknown numeric level: %v", v)
+		default:
+			return "", fmt.Errorf("expected numeric level, got %T", val)
+		}
+	}
+}
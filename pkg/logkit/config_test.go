@@ -0,0 +1,380 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_ConfigOptions(t *testing.T) {
+	t.Run("field names", func(t *testing.T) {
+		// --- When ---
+		have := NewConfig(
+			WithTimeField("ts"),
+			WithLevelField("lvl"),
+			WithMessageField("msg"),
+			WithErrorField("err"),
+			WithServiceField("svc"),
+			WithComponentField("cmp"),
+			WithCallerField("src"),
+			WithStackField("trace"),
+		)
+
+		// --- Then ---
+		assert.Equal(t, "ts", have.TimeField)
+		assert.Equal(t, "lvl", have.LevelField)
+		assert.Equal(t, "msg", have.MessageField)
+		assert.Equal(t, "err", have.ErrorField)
+		assert.Equal(t, "svc", have.ServiceField)
+		assert.Equal(t, "cmp", have.ComponentField)
+		assert.Equal(t, "src", have.CallerField)
+		assert.Equal(t, "trace", have.StackField)
+	})
+
+	t.Run("formats", func(t *testing.T) {
+		// --- When ---
+		have := NewConfig(
+			WithTimeFormat(time.RFC3339Nano),
+			WithDurationUnit(time.Second),
+		)
+
+		// --- Then ---
+		assert.Equal(t, time.RFC3339Nano, have.TimeFormat)
+		assert.Equal(t, time.Second, have.DurationUnit)
+	})
+
+	t.Run("levels", func(t *testing.T) {
+		// --- Given ---
+		fn := NumericLevels(BunyanLevels)
+
+		// --- When ---
+		have := NewConfig(
+			WithLevelValues("T", "D", "I", "W", "E", "F", "P"),
+			WithLevelParser(fn),
+		)
+
+		// --- Then ---
+		assert.Equal(t, "T", have.LevelTraceValue)
+		assert.Equal(t, "D", have.LevelDebugValue)
+		assert.Equal(t, "I", have.LevelInfoValue)
+		assert.Equal(t, "W", have.LevelWarnValue)
+		assert.Equal(t, "E", have.LevelErrorValue)
+		assert.Equal(t, "F", have.LevelFatalValue)
+		assert.Equal(t, "P", have.LevelPanicValue)
+		assert.Same(t, fn, have.LevelParser)
+	})
+
+	t.Run("line decoder", func(t *testing.T) {
+		// --- When ---
+		have := NewConfig(WithLineDecoder(DockerLine))
+
+		// --- Then ---
+		assert.Same(t, DockerLine, have.LineDecoder)
+	})
+
+	t.Run("required fields", func(t *testing.T) {
+		// --- When ---
+		have := NewConfig(WithRequiredFields("time", "service"))
+
+		// --- Then ---
+		assert.Equal(t, []string{"time", "service"}, have.RequiredFields)
+	})
+
+	t.Run("strict keys", func(t *testing.T) {
+		// --- When ---
+		have := NewConfig(WithStrictKeys())
+
+		// --- Then ---
+		assert.True(t, have.StrictKeys)
+	})
+}
+
+func Test_NewConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- When ---
+		have := NewConfig()
+
+		// --- Then ---
+		assert.Equal(t, DefaultConfig(), have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		have := NewConfig(WithMessageField("msg"), WithLevelField("lvl"))
+
+		// --- Then ---
+		want := DefaultConfig()
+		want.MessageField = "msg"
+		want.LevelField = "lvl"
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_Config_Validate(t *testing.T) {
+	t.Run("presets are valid", func(t *testing.T) {
+		// --- Given ---
+		cfgs := []*Config{
+			DefaultConfig(),
+			SlogConfig(),
+			LogrusConfig(),
+			ZapConfig(),
+			ZapProductionConfig(),
+			HclogConfig(),
+			BunyanConfig(),
+			PinoConfig(),
+			ECSConfig(),
+			OTelConfig(),
+			CLEFConfig(),
+		}
+
+		for _, cfg := range cfgs {
+			// --- When ---
+			err := cfg.Validate()
+
+			// --- Then ---
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("time as number does not need format", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(WithTimeFormat(""))
+		cfg.TimeAsNumber = true
+
+		// --- When ---
+		err := cfg.Validate()
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - empty fields", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(
+			WithLevelField(""),
+			WithTimeFormat(""),
+			WithLevelValues("", "debug", "info", "warn", "error", "fatal", ""),
+			WithDurationUnit(0),
+		)
+
+		// --- When ---
+		err := cfg.Validate()
+
+		// --- Then ---
+		wMsg := "" +
+			"[log config] expected config fields to be set:\n" +
+			"  fields: LevelField, LevelTraceValue, LevelPanicValue, " +
+			"TimeFormat, DurationUnit"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrConfig, err)
+	})
+}
+
+func Test_ECSConfig(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	lin := `{"log.level":"error","@timestamp":"2025-01-02T03:04:05.123Z",` +
+		`"message":"request failed","ecs.version":"1.6.0",` +
+		`"service":{"name":"api"},` +
+		`"log":{"logger":"http","origin":{"file":{"name":"srv.go",` +
+		`"line":42},"function":"main.serve"}},` +
+		`"error":{"message":"boom","stack_trace":"main.serve\n\tsrv.go:42"}}`
+
+	// --- When ---
+	tst := New(tspy, WithConfig(ECSConfig()), WithString(lin))
+
+	// --- Then ---
+	ent := tst.FirstEntry()
+	want := time.Date(2025, 1, 2, 3, 4, 5, 123000000, time.UTC)
+	assert.True(t, ent.AssertLevel("error"))
+	assert.True(t, ent.AssertMsg("request failed"))
+	assert.True(t, ent.AssertError("boom"))
+	assert.True(t, ent.AssertTime("@timestamp", want))
+	assert.True(t, ent.AssertCallerFile("srv.go"))
+	assert.True(t, ent.AssertCallerContains("main.serve"))
+	assert.True(t, ent.AssertStackContains("srv.go:42"))
+	assert.Equal(t, "api", must.Value(ent.Service()))
+	assert.Equal(t, "http", must.Value(ent.Component()))
+	assert.Len(t, 1, tst.Filter(CheckErrContain("boo")).Get())
+	assert.NoError(t, ECSConfig().Validate())
+}
+
+func Test_ZapProductionConfig(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	lin := `{"level":"info","ts":1735787045.5,"caller":"app/main.go:1",` +
+		`"msg":"msg"}`
+
+	// --- When ---
+	tst := New(tspy, WithConfig(ZapProductionConfig()), WithString(lin))
+
+	// --- Then ---
+	ent := tst.FirstEntry()
+	want := time.Date(2025, 1, 2, 3, 4, 5, 500000000, time.UTC)
+	assert.True(t, ent.AssertLevel("info"))
+	assert.True(t, ent.AssertMsg("msg"))
+	assert.True(t, ent.AssertTime("ts", want))
+	assert.True(t, ent.AssertLoggedWithin(want, "1ms"))
+}
+
+func Test_HclogConfig(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	lin := `{"@level":"warn","@message":"msg","@module":"vault",` +
+		`"@timestamp":"2025-01-02T03:04:05.123456Z","error":"err"}`
+
+	// --- When ---
+	ent := New(tspy, WithConfig(HclogConfig()), WithString(lin)).FirstEntry()
+
+	// --- Then ---
+	want := time.Date(2025, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	assert.True(t, ent.AssertLevel("warn"))
+	assert.True(t, ent.AssertMsg("msg"))
+	assert.True(t, ent.AssertError("err"))
+	assert.True(t, ent.AssertTime("@timestamp", want))
+	assert.Equal(t, "vault", must.Value(ent.Component()))
+}
+
+func Test_BunyanConfig(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	lin := `{"name":"app","hostname":"host","pid":1,"level":40,` +
+		`"msg":"msg","time":"2025-01-02T03:04:05.123Z","v":0}`
+
+	// --- When ---
+	tst := New(tspy, WithConfig(BunyanConfig()), WithString(lin))
+
+	// --- Then ---
+	ent := tst.FirstEntry()
+	want := time.Date(2025, 1, 2, 3, 4, 5, 123000000, time.UTC)
+	assert.True(t, ent.AssertLevel("warn"))
+	assert.True(t, ent.AssertMsg("msg"))
+	assert.True(t, ent.AssertTime("time", want))
+	assert.Equal(t, "app", must.Value(ent.Service()))
+	assert.Len(t, 1, tst.Filter(CheckLevel("warn")).Get())
+	assert.Len(t, 0, tst.Filter(CheckLevel("info")).Get())
+}
+
+func Test_PinoConfig(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	lin := `{"level":30,"time":1735787045123,"pid":1,"hostname":"host",` +
+		`"name":"app","msg":"msg"}`
+
+	// --- When ---
+	tst := New(tspy, WithConfig(PinoConfig()), WithString(lin))
+
+	// --- Then ---
+	ent := tst.FirstEntry()
+	want := time.Date(2025, 1, 2, 3, 4, 5, 123000000, time.UTC)
+	assert.True(t, ent.AssertLevel("info"))
+	assert.True(t, ent.AssertMsg("msg"))
+	assert.True(t, ent.AssertTime("time", want))
+	assert.True(t, ent.AssertLoggedWithin(want, "1ms"))
+	assert.Equal(t, "app", must.Value(ent.Service()))
+}
+
+func Test_NumericLevels(t *testing.T) {
+	t.Run("numeric level", func(t *testing.T) {
+		// --- Given ---
+		fn := NumericLevels(BunyanLevels)
+
+		// --- When ---
+		have, err := fn(30.0)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "info", have)
+	})
+
+	t.Run("string level", func(t *testing.T) {
+		// --- Given ---
+		fn := NumericLevels(BunyanLevels)
+
+		// --- When ---
+		have, err := fn("info")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "info", have)
+	})
+
+	t.Run("error - unknown numeric level", func(t *testing.T) {
+		// --- Given ---
+		fn := NumericLevels(BunyanLevels)
+
+		// --- When ---
+		have, err := fn(35.0)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "unknown numeric level: 35", err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - fractional level", func(t *testing.T) {
+		// --- Given ---
+		fn := NumericLevels(BunyanLevels)
+
+		// --- When ---
+		have, err := fn(30.5)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "unknown numeric level: 30.5", err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// --- Given ---
+		fn := NumericLevels(BunyanLevels)
+
+		// --- When ---
+		have, err := fn(true)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected numeric level, got bool", err)
+		assert.Empty(t, have)
+	})
+}
+
+func Test_Config_levelRank(t *testing.T) {
+	tests := []struct {
+		testN string
+
+		level string
+		want  int
+	}{
+		{"trace", "trace", 0},
+		{"info", "info", 2},
+		{"panic", "panic", 6},
+		{"unknown", "warning", -1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			cfg := DefaultConfig()
+
+			// --- When ---
+			have := cfg.levelRank(tc.level)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
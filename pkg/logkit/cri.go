@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"time"
+)
+
+// MetaTag is the key of the CRI log line tag metadata ("F" for full and "P"
+// for partial lines), see [CRILine].
+const MetaTag = "tag"
+
+// CRILine is a [Config.LineDecoder] for the Kubernetes container runtime
+// (CRI) log lines, as captured by "kubectl logs" or in the node's
+// "/var/log/pods" files, like:
+//
+//	2024-01-02T03:04:05.000Z stdout F {"msg":"started"}
+//
+// It returns the log line after the prefix with the [MetaTime], [MetaStream]
+// and [MetaTag] metadata. Lines without the CRI prefix are returned as they
+// are, without metadata.
+//
+// The decoder sees one line at a time, so it returns the partial ("P") lines
+// as they are. The [Tester] joins them with the following lines of the same
+// stream up to the full ("F") line and returns the joined line as one log
+// entry. Partial lines without the full line are not returned. The matchers
+// used by [Tester.WaitFor] and the [Config.StrictKeys] check see the partial
+// lines one by one.
+//
+// Example usage:
+//
+//	cfg := logkit.NewConfig(logkit.WithLineDecoder(logkit.CRILine))
+//	tst := logkit.Load(t, "testdata/pod.log", logkit.WithConfig(cfg))
+func CRILine(line []byte) ([]byte, map[string]string, error) {
+	parts := bytes.SplitN(line, []byte(" "), 4)
+	if len(parts) < 3 {
+		return line, nil, nil
+	}
+	tim, stream, tag := string(parts[0]), string(parts[1]), string(parts[2])
+	if _, err := time.Parse(time.RFC3339Nano, tim); err != nil {
+		return line, nil, nil
+	}
+	if stream != "stdout" && stream != "stderr" {
+		return line, nil, nil
+	}
+	if tag != "F" && tag != "P" {
+		return line, nil, nil
+	}
+	meta := map[string]string{MetaTime: tim, MetaStream: stream, MetaTag: tag}
+	if len(parts) == 3 {
+		return nil, meta, nil
+	}
+	return parts[3], meta, nil
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_CRILine(t *testing.T) {
+	t.Run("json log line", func(t *testing.T) {
+		// --- Given ---
+		lin := `2024-01-02T03:04:05.000Z stdout F {"message":"msg0"}`
+
+		// --- When ---
+		have, meta, err := CRILine([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"message":"msg0"}`, string(have))
+		wMeta := map[string]string{
+			MetaTime:   "2024-01-02T03:04:05.000Z",
+			MetaStream: "stdout",
+			MetaTag:    "F",
+		}
+		assert.Equal(t, wMeta, meta)
+	})
+
+	t.Run("partial line", func(t *testing.T) {
+		// --- Given ---
+		lin := `2024-01-02T03:04:05.000Z stderr P part one`
+
+		// --- When ---
+		have, meta, err := CRILine([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "part one", string(have))
+		assert.Equal(t, "stderr", meta[MetaStream])
+		assert.Equal(t, "P", meta[MetaTag])
+	})
+
+	t.Run("empty line", func(t *testing.T) {
+		// --- Given ---
+		lin := `2024-01-02T03:04:05.000Z stdout F`
+
+		// --- When ---
+		have, meta, err := CRILine([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Empty(t, have)
+		assert.Equal(t, "F", meta[MetaTag])
+	})
+
+	t.Run("not CRI line", func(t *testing.T) {
+		tests := []struct {
+			testN string
+
+			line string
+		}{
+			{"json", `{"message":"msg0"}`},
+			{"too short", `2024-01-02T03:04:05Z stdout`},
+			{"invalid time", `2024-01-02 stdout F {}`},
+			{"invalid stream", `2024-01-02T03:04:05Z stdin F {}`},
+			{"invalid tag", `2024-01-02T03:04:05Z stdout X {}`},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.testN, func(t *testing.T) {
+				// --- When ---
+				have, meta, err := CRILine([]byte(tc.line))
+
+				// --- Then ---
+				assert.NoError(t, err)
+				assert.Equal(t, tc.line, string(have))
+				assert.Nil(t, meta)
+			})
+		}
+	})
+}
+
+func Test_Load_CRILine(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	cfg := NewConfig(WithLineDecoder(CRILine))
+
+	// --- When ---
+	tst := Load(tspy, "testdata/pod.log", WithConfig(cfg))
+
+	// --- Then ---
+	ets := tst.Entries().Get()
+	assert.Len(t, 2, ets)
+	assert.Equal(t, `{"level":"info","message":"msg0"}`, ets[0].String())
+	assert.Equal(t, "stdout", ets[0].Meta(MetaStream))
+	assert.Equal(t, "2024-01-02T03:04:05.000000001Z", ets[0].Meta(MetaTime))
+	assert.Equal(t, `{"message":"panic: boom"}`, ets[1].String())
+	assert.Equal(t, "stderr", ets[1].Meta(MetaStream))
+}
+
+func Test_Tester_CRILine_partial(t *testing.T) {
+	t.Run("joined with full line", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		cfg := NewConfig(WithLineDecoder(CRILine))
+		tst := New(tspy, WithConfig(cfg))
+
+		// --- When ---
+		must.Value(tst.Write([]byte("" +
+			`2024-01-02T03:04:05.000Z stdout P {"message":"he` + "\n" +
+			`2024-01-02T03:04:05.000Z stderr F {"message":"err"}` + "\n" +
+			`2024-01-02T03:04:05.000Z stdout P l` + "\n" +
+			`2024-01-02T03:04:05.000Z stdout F lo"}` + "\n",
+		)))
+
+		// --- Then ---
+		ets := tst.Entries().Get()
+		assert.Len(t, 2, ets)
+		assert.Equal(t, `{"message":"err"}`, ets[0].String())
+		assert.Equal(t, 0, ets[0].Index())
+		assert.Equal(t, `{"message":"hello"}`, ets[1].String())
+		assert.Equal(t, "stdout", ets[1].Meta(MetaStream))
+		assert.Equal(t, "F", ets[1].Meta(MetaTag))
+		assert.Equal(t, 1, ets[1].Index())
+	})
+
+	t.Run("without full line", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		cfg := NewConfig(WithLineDecoder(CRILine))
+		tst := New(tspy, WithConfig(cfg))
+
+		// --- When ---
+		must.Value(tst.Write([]byte("" +
+			`2024-01-02T03:04:05.000Z stdout F {"message":"msg0"}` + "\n" +
+			`2024-01-02T03:04:05.000Z stdout P {"message":"he` + "\n",
+		)))
+
+		// --- Then ---
+		ets := tst.Entries().Get()
+		assert.Len(t, 1, ets)
+		assert.Equal(t, `{"message":"msg0"}`, ets[0].String())
+	})
+}
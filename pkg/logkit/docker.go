@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Keys of the log line metadata set by the container log line decoders, see
+// [Entry.Meta].
+const (
+	MetaStream = "stream" // The stream name ("stdout" or "stderr").
+	MetaTime   = "time"   // The time the container runtime captured the line.
+)
+
+// DockerLine is a [Config.LineDecoder] for the Docker "json-file" logging
+// driver lines like:
+//
+//	{"log":"{\"msg\":\"started\"}\n","stream":"stdout","time":"..."}
+//
+// It returns the inner log line with the [MetaStream] and [MetaTime]
+// metadata. Lines which are not in the Docker format are returned as they
+// are, without metadata.
+//
+// Example usage:
+//
+//	cfg := logkit.NewConfig(logkit.WithLineDecoder(logkit.DockerLine))
+//	tst := logkit.New(t, logkit.WithConfig(cfg))
+//	go tailFile(tst, "/var/lib/docker/containers/<id>/<id>-json.log")
+//	tst.WaitFor("5s", logkit.CheckMsg("started"))
+func DockerLine(line []byte) ([]byte, map[string]string, error) {
+	var dst struct {
+		Log    *string `json:"log"`
+		Stream string  `json:"stream"`
+		Time   string  `json:"time"`
+	}
+	if !bytes.HasPrefix(line, []byte("{")) {
+		return line, nil, nil
+	}
+	if err := json.Unmarshal(line, &dst); err != nil || dst.Log == nil {
+		return line, nil, nil
+	}
+	meta := map[string]string{MetaStream: dst.Stream, MetaTime: dst.Time}
+	return []byte(strings.TrimRight(*dst.Log, "\r\n")), meta, nil
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_DockerLine(t *testing.T) {
+	t.Run("json log line", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"log":"{\"message\":\"msg0\"}\n","stream":"stdout",` +
+			`"time":"2024-01-02T03:04:05.123456789Z"}`
+
+		// --- When ---
+		have, meta, err := DockerLine([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"message":"msg0"}`, string(have))
+		wMeta := map[string]string{
+			MetaStream: "stdout",
+			MetaTime:   "2024-01-02T03:04:05.123456789Z",
+		}
+		assert.Equal(t, wMeta, meta)
+	})
+
+	t.Run("text log line", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"log":"panic: boom\r\n","stream":"stderr","time":"T"}`
+
+		// --- When ---
+		have, meta, err := DockerLine([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "panic: boom", string(have))
+		assert.Equal(t, "stderr", meta[MetaStream])
+	})
+
+	t.Run("not docker line", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"level":"info","message":"msg0"}`
+
+		// --- When ---
+		have, meta, err := DockerLine([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, lin, string(have))
+		assert.Nil(t, meta)
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		// --- When ---
+		have, meta, err := DockerLine([]byte("text"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "text", string(have))
+		assert.Nil(t, meta)
+	})
+}
+
+func Test_Tester_lineDecoder(t *testing.T) {
+	// --- Given ---
+	cfg := NewConfig(WithLineDecoder(DockerLine))
+	tst := New(t, WithConfig(cfg))
+
+	// --- When ---
+	MustWriteLine(tst, `{"log":"{\"level\":\"info\",\"message\":\"msg0\"}\n",`+
+		`"stream":"stdout","time":"2024-01-02T03:04:05Z"}`)
+	MustWriteLine(tst, `{"log":"boom\n","stream":"stderr",`+
+		`"time":"2024-01-02T03:04:06Z"}`)
+
+	// --- Then ---
+	ent := tst.WaitFor("1s", CheckMsg("boom"))
+	assert.Equal(t, "stderr", ent.Meta(MetaStream))
+
+	ets := tst.Entries().Get()
+	assert.Len(t, 2, ets)
+	assert.Equal(t, `{"level":"info","message":"msg0"}`, ets[0].String())
+	assert.Equal(t, "stdout", ets[0].Meta(MetaStream))
+	assert.Equal(t, "2024-01-02T03:04:05Z", ets[0].Meta(MetaTime))
+	assert.Equal(t, `{"message":"boom"}`, ets[1].String())
+	assert.Equal(t, "stderr", ets[1].Meta(MetaStream))
+	assert.Equal(t, 1, ets[1].Index())
+}
+
+func Test_Matcher_MatchLine_lineDecoder(t *testing.T) {
+	t.Run("decoded line", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		cfg := NewConfig(WithLineDecoder(DockerLine))
+		mcr := NewMatcher(tspy, cfg, CheckMsg("msg0"))
+		lin := `{"log":"{\"message\":\"msg0\"}\n","stream":"stdout"}`
+
+		// --- When ---
+		have := mcr.MatchLine(1, []byte(lin))
+
+		// --- Then ---
+		assert.Equal(t, `{"message":"msg0"}`, have.String())
+		assert.Equal(t, "stdout", have.Meta(MetaStream))
+		assert.Equal(t, 1, have.Index())
+	})
+
+	t.Run("error - decoder error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("matcher line 1: decoder error")
+		tspy.Close()
+
+		fn := func([]byte) ([]byte, map[string]string, error) {
+			return nil, nil, errors.New("decoder error")
+		}
+		cfg := NewConfig(WithLineDecoder(fn))
+		mcr := NewMatcher(tspy, cfg)
+
+		// --- When ---
+		have := mcr.MatchLine(1, []byte(`{}`))
+
+		// --- Then ---
+		assert.True(t, have.IsZero())
+	})
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// CheckNoDuplicateKeys returns a function that takes an [Entry] and checks
+// that no object in the raw log entry, including the nested ones, has the
+// same key more than once. Decoding such entries silently keeps the last
+// value, which hides fields added twice by the logger. Returns nil if there
+// are no duplicate keys. Returns [ErrFormat] listing the paths of the
+// duplicate keys otherwise.
+func CheckNoDuplicateKeys() Checker {
+	return func(ent Entry) error {
+		dups := duplicateKeys(ent.raw)
+		if len(dups) == 0 {
+			return nil
+		}
+		return notice.New("[log entry] expected no duplicate keys").
+			Append("keys", "%s", strings.Join(dups, ", ")).
+			Wrap(ErrFormat)
+	}
+}
+
+// AssertNoDuplicateKeys asserts that no object in the log entry has the same
+// key more than once, see [CheckNoDuplicateKeys]. Returns true if there are
+// no duplicate keys. If not, it marks the test as failed, logs an error
+// message with the paths of the duplicate keys, and returns false.
+func (ent Entry) AssertNoDuplicateKeys() bool {
+	ent.t.Helper()
+	if err := CheckNoDuplicateKeys()(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// duplicateKeys returns the paths, in the [HasPath] format, of the keys which
+// appear more than once in the same object of the raw log entry. Returns nil
+// if there are none or the raw log entry is not valid JSON.
+func duplicateKeys(raw string) []string {
+	var dups []string
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+
+	var walk func(pth string) bool
+	walk = func(pth string) bool {
+		tok, err := dec.Token()
+		if err != nil {
+			return false
+		}
+		dlm, ok := tok.(json.Delim)
+		if !ok {
+			return true
+		}
+		switch dlm {
+		case '{':
+			seen := make(map[string]bool)
+			for dec.More() {
+				if tok, err = dec.Token(); err != nil {
+					return false
+				}
+				key, _ := tok.(string)
+				sub := key
+				if pth != "" {
+					sub = pth + "." + key
+				}
+				if seen[key] && !slices.Contains(dups, sub) {
+					dups = append(dups, sub)
+				}
+				seen[key] = true
+				if !walk(sub) {
+					return false
+				}
+			}
+
+		case '[':
+			for i := 0; dec.More(); i++ {
+				if !walk(pth + "[" + strconv.Itoa(i) + "]") {
+					return false
+				}
+			}
+		}
+		_, err = dec.Token() // Closing delimiter.
+		return err == nil
+	}
+
+	if !walk("") {
+		return nil
+	}
+	return dups
+}
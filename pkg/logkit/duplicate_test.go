@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_CheckNoDuplicateKeys(t *testing.T) {
+	t.Run("no duplicate keys", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"A": 1, "B": {"A": 2}}`}
+
+		// --- When ---
+		err := CheckNoDuplicateKeys()(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - duplicate keys", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"A": 1, "B": {"C": 2, "C": 3}, "A": 4}`}
+
+		// --- When ---
+		err := CheckNoDuplicateKeys()(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected no duplicate keys:\n" +
+			"  keys: B.C, A"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrFormat, err)
+	})
+}
+
+func Test_Entry_AssertNoDuplicateKeys(t *testing.T) {
+	t.Run("no duplicate keys", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info", "A": 1}`).Entry(0)
+
+		// --- When ---
+		have := ent.AssertNoDuplicateKeys()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - duplicate keys", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected no duplicate keys:\n" +
+			"  keys: level"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		lin := `{"level": "info", "level": "error"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertNoDuplicateKeys()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_duplicateKeys(t *testing.T) {
+	tests := []struct {
+		testN string
+
+		raw  string
+		want []string
+	}{
+		{"no keys", `{}`, nil},
+		{"no duplicates", `{"A": 1, "B": [{"A": 2}]}`, nil},
+		{"top level", `{"A": 1, "A": 2}`, []string{"A"}},
+		{"reported once", `{"A": 1, "A": 2, "A": 3}`, []string{"A"}},
+		{"nested", `{"A": {"B": 1, "B": 2}}`, []string{"A.B"}},
+		{"in array", `{"A": [1, {"B": 1, "B": 2}]}`, []string{"A[1].B"}},
+		{"invalid JSON", `{"A": 1, "A": `, nil},
+		{"not JSON", `abc`, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := duplicateKeys(tc.raw)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -4,6 +4,15 @@
 package logkit
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +21,13 @@ import (
 	"github.com/ctx42/testing/pkg/tester"
 )
 
+// Stats represents statistics of the log entries collection.
+type Stats struct {
+	Total  int            // Number of log entries.
+	Levels map[string]int // Number of log entries per log level.
+	Fields map[string]int // Number of log entries per field name.
+}
+
 // Entries represents collection of log entries.
 type Entries struct {
 	cfg *Config  // Log configuration.
@@ -33,6 +49,88 @@ func (ets Entries) MetaAll() []map[string]any {
 	return etsMaps
 }
 
+// ByService returns log entries with the [Config.ServiceField] field equal to
+// the given name.
+func (ets Entries) ByService(name string) Entries {
+	return ets.filter(CheckService(name))
+}
+
+// ByComponent returns log entries with the [Config.ComponentField] field equal
+// to the given name.
+func (ets Entries) ByComponent(name string) Entries {
+	return ets.filter(CheckComponent(name))
+}
+
+// Select returns the log entries reduced to the selected top-level fields,
+// so summaries and comparisons of raw log entries ignore the noisy fields.
+// Fields missing in an entry are skipped. The reduced entries are re-encoded
+// with the fields sorted by their names and keep their indexes. Numbers are
+// re-encoded exactly as they were logged.
+//
+// Example usage:
+//
+//	tst.Entries().Select("level", "message").AssertRaw(
+//	    `{"level":"info","message":"started"}`,
+//	)
+func (ets Entries) Select(fields ...string) Entries {
+	res := make([]Entry, 0, len(ets.ets))
+	for _, ent := range ets.ets {
+		exact := exactEntry(ent).m
+		m := make(map[string]any, len(fields))
+		sel := make(map[string]any, len(fields))
+		for _, field := range fields {
+			if val, ok := ent.m[field]; ok {
+				m[field], sel[field] = val, exact[field]
+			}
+		}
+		data, _ := json.Marshal(sel) // Decoded JSON always marshals.
+		ent.m, ent.raw, ent.exact = m, string(data), &exactFields{}
+		res = append(res, ent)
+	}
+	return Entries{cfg: ets.cfg, ets: res, t: ets.t}
+}
+
+// EntriesAs decodes each raw log entry into type T the way [json.Unmarshal]
+// does. It allows table-style assertions against strongly typed log records
+// when the log entries have a fixed schema. Returns the decoded log entries
+// in the order they were logged. If any of the log entries cannot be decoded,
+// it returns nil and error having [ErrType] in its chain.
+//
+// Example usage:
+//
+//	type Record struct {
+//		Level   string `json:"level"`
+//		Message string `json:"message"`
+//	}
+//	recs, err := logkit.EntriesAs[Record](tst.Entries())
+func EntriesAs[T any](ets Entries) ([]T, error) {
+	out := make([]T, 0, len(ets.ets))
+	for _, ent := range ets.ets {
+		var val T
+		if err := json.Unmarshal([]byte(ent.raw), &val); err != nil {
+			mHeader := "[log entry] expected log entry to decode as the type"
+			return nil, notice.New(mHeader).
+				Append("index", "%d", ent.idx).
+				Append("type", "%T", val).
+				Append("error", "%s", err).
+				Wrap(ErrType)
+		}
+		out = append(out, val)
+	}
+	return out, nil
+}
+
+// filter returns log entries passing all the provided checks.
+func (ets Entries) filter(checks ...Checker) Entries {
+	res := make([]Entry, 0)
+	for _, ent := range ets.ets {
+		if runChecks(ent, checks...) {
+			res = append(res, ent)
+		}
+	}
+	return Entries{cfg: ets.cfg, ets: res, t: ets.t}
+}
+
 // Entry returns the nth log entry. If the index is out of range, the test is
 // marked as failed, the method returns false but continues execution.
 func (ets Entries) Entry(n int) Entry {
@@ -46,9 +144,9 @@ func (ets Entries) Entry(n int) Entry {
 	return Entry{}
 }
 
-// AssertRaw asserts that the raw log entries match the provided string.
-// Returns true if they match. If not, it marks the test as failed, logs an
-// error message, and returns false.
+// AssertRaw asserts that the raw log entries match the provided string. The
+// [Config.RawIgnoreFields] fields are ignored. Returns true if they match. If
+// not, it marks the test as failed, logs an error message, and returns false.
 func (ets Entries) AssertRaw(want ...string) bool {
 	ets.t.Helper()
 
@@ -57,9 +155,8 @@ func (ets Entries) AssertRaw(want ...string) bool {
 		if hEnt.IsZero() {
 			return false
 		}
-		if e := check.JSON(wEnt, hEnt.raw); e != nil {
-			e = notice.From(e, "log entry").Prepend("index", "%d", i)
-			ets.t.Error(e)
+		if e := rawEqual(wEnt, hEnt); e != nil {
+			ets.t.Error(notice.From(e).Prepend("index", "%d", i))
 		}
 	}
 
@@ -80,6 +177,99 @@ func (ets Entries) AssertRaw(want ...string) bool {
 	return false
 }
 
+// AssertRawSubset asserts that the log entries are supersets of the provided
+// JSON objects, see [Entry.AssertRawSubset]. It works like
+// [Entries.AssertRaw] but ignores fields not present in the "want" strings.
+// Returns true if they match. If not, it marks the test as failed, logs an
+// error message, and returns false.
+func (ets Entries) AssertRawSubset(want ...string) bool {
+	ets.t.Helper()
+
+	var failed bool
+	for i, wEnt := range want {
+		hEnt := ets.Entry(i)
+		if hEnt.IsZero() {
+			return false
+		}
+		if e := rawSubset(wEnt, hEnt); e != nil {
+			ets.t.Error(notice.From(e).Prepend("index", "%d", i))
+			failed = true
+		}
+	}
+	if failed {
+		return false
+	}
+
+	hCnt := len(ets.ets)
+	wCnt := len(want)
+	if hCnt == wCnt {
+		return true
+	}
+	msg := notice.New("[log entry] expected N log entries").
+		Want("%d", wCnt).
+		Have("%d", hCnt).
+		Append("have logs", "%s", ets.print())
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertExactly asserts that the number of log entries equals the number of
+// provided checkers and that the checker at index i passes for the log entry
+// at index i. It is a structured equivalent of [Entries.AssertRaw]. Returns
+// true if all checkers pass. If not, it marks the test as failed, logs an
+// error message for each failing index, and returns false.
+func (ets Entries) AssertExactly(checks ...Checker) bool {
+	ets.t.Helper()
+
+	var failed bool
+	if hCnt, wCnt := len(ets.ets), len(checks); hCnt != wCnt {
+		msg := notice.New("[log entry] expected N log entries").
+			Want("%d", wCnt).
+			Have("%d", hCnt).
+			Append("have logs", "%s", ets.print())
+		ets.t.Error(msg)
+		failed = true
+	}
+
+	for i, chk := range checks {
+		if i >= len(ets.ets) {
+			break
+		}
+		if err := chk(ets.ets[i]); err != nil {
+			ets.t.Error(notice.From(err).Prepend("index", "%d", i))
+			failed = true
+		}
+	}
+	return !failed
+}
+
+// AssertEmpty asserts that there are no log entries in the collection.
+// Returns true if the collection is empty. If not, it marks the test as
+// failed, logs an error message with the logged entries, and returns false.
+func (ets Entries) AssertEmpty() bool {
+	ets.t.Helper()
+	if len(ets.ets) == 0 {
+		return true
+	}
+	msg := notice.New("[log entry] expected no log entries").
+		Append("have", "%d", len(ets.ets)).
+		Append("have logs", "%s", ets.print())
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertNotEmpty asserts that there is at least one log entry in the
+// collection. Returns true if the collection is not empty. If it is, it marks
+// the test as failed, logs an error message, and returns false.
+func (ets Entries) AssertNotEmpty() bool {
+	ets.t.Helper()
+	if len(ets.ets) > 0 {
+		return true
+	}
+	ets.t.Error(notice.New("[log entry] expected at least one log entry"))
+	return false
+}
+
 // AssertLen asserts that the number of log entries equals the provided length.
 // Returns true if the count matches. If not, it marks the test as failed, logs
 // an error message, and returns false.
@@ -96,6 +286,444 @@ func (ets Entries) AssertLen(want int) bool {
 	return false
 }
 
+// Stats returns statistics of the log entries in the collection. Entries
+// without a valid level, see [HasLevel], are not counted in [Stats.Levels].
+func (ets Entries) Stats() Stats {
+	sts := Stats{
+		Total:  len(ets.ets),
+		Levels: make(map[string]int),
+		Fields: make(map[string]int),
+	}
+	for _, ent := range ets.ets {
+		if lvl, err := HasLevel(ent); err == nil {
+			sts.Levels[lvl]++
+		}
+		for field := range ent.m {
+			sts.Fields[field]++
+		}
+	}
+	return sts
+}
+
+// AssertLevelCount asserts that the number of log entries with the given
+// level equals the provided count. Returns true if the count matches. If not,
+// it marks the test as failed, logs an error message, and returns false.
+func (ets Entries) AssertLevelCount(level string, want int) bool {
+	ets.t.Helper()
+	have := ets.Stats().Levels[level]
+	if have == want {
+		return true
+	}
+	msg := notice.New("[log entry] expected N log entries with the level").
+		Append("level", "%s", level).
+		Want("%d", want).
+		Have("%d", have)
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertContiguous asserts that the numeric field in the consecutive log
+// entries forms a contiguous, gap-free sequence where each value is one
+// greater than the previous one. Returns true if the sequence has no gaps. If
+// any entry is missing the field, the field is not a number, or a gap is
+// found, it marks the test as failed, logs an error message, and returns
+// false.
+func (ets Entries) AssertContiguous(field string) bool {
+	ets.t.Helper()
+	var prev float64
+	for i, ent := range ets.ets {
+		have, err := HasNum(ent, field)
+		if err != nil {
+			ets.t.Error(notice.From(err).Prepend("index", "%d", i))
+			return false
+		}
+		if i > 0 && have != prev+1 {
+			msg := notice.New("[log entry] expected contiguous sequence").
+				Append("index", "%d", i).
+				Append("field", "%s", field).
+				Want("%s", strconv.FormatFloat(prev+1, 'f', -1, 64)).
+				Have("%s", strconv.FormatFloat(have, 'f', -1, 64))
+			ets.t.Error(msg)
+			return false
+		}
+		prev = have
+	}
+	return true
+}
+
+// AssertSchemaCompliant asserts that every log entry has all the
+// [Config.RequiredFields] fields. Returns true if no field is missing. If
+// not, it marks the test as failed, logs an error message listing the missing
+// fields of every non-compliant entry, and returns false.
+func (ets Entries) AssertSchemaCompliant() bool {
+	ets.t.Helper()
+	required := ets.cfg.requiredFields()
+	mHeader := "[log entry] expected log entries to have required fields"
+	msg := notice.New(mHeader).
+		Append("required", "%s", strings.Join(required, ", "))
+	var failed bool
+	for _, ent := range ets.ets {
+		var missing []string
+		for _, field := range required {
+			if _, err := hasKey(ent, field); err != nil {
+				missing = append(missing, field)
+			}
+		}
+		if len(missing) > 0 {
+			name := fmt.Sprintf("entry %d", ent.idx)
+			msg = msg.Append(name, "%s", strings.Join(missing, ", "))
+			failed = true
+		}
+	}
+	if failed {
+		ets.t.Error(msg)
+	}
+	return !failed
+}
+
+// AssertSameValue asserts that all the log entries having the field share
+// one value of it, like a request trace ID. The entries without the field are
+// ignored. Returns true if the values are the same. If not, it marks the test
+// as failed, logs an error message listing the entries with values different
+// from the value in the first entry having the field, and returns false.
+func (ets Entries) AssertSameValue(field string) bool {
+	ets.t.Helper()
+	mHeader := "[log entry] expected log entries to have the same field value"
+	return ets.sameValue(mHeader, field, ets.ets, false)
+}
+
+// AssertPropagated asserts that all the log entries passing the scope check
+// have the field, and share one value of it. It's used to prove the value,
+// like a request trace ID, is attached to every log entry produced in the
+// scope. Returns true if the value is propagated. If not, it marks the test
+// as failed, logs an error message listing the entries without the field or
+// with values different from the value in the first entry having the field,
+// and returns false. It also fails when no log entry passes the scope check.
+//
+// Example usage:
+//
+//	tst.Entries().AssertPropagated("trace_id", logkit.CheckStr("req", "r1"))
+func (ets Entries) AssertPropagated(field string, scope Checker) bool {
+	ets.t.Helper()
+	mHeader := "[log entry] expected field value to be propagated"
+	scoped := ets.filter(scope).ets
+	if len(scoped) == 0 {
+		msg := notice.New(mHeader).
+			Append("field", "%s", field).
+			Append("entries", "%s", "no entries in scope")
+		ets.t.Error(msg)
+		return false
+	}
+	return ets.sameValue(mHeader, field, scoped, true)
+}
+
+// sameValue asserts that the log entries share one value of the field. When
+// required is true, the entries without the field are reported as well.
+func (ets Entries) sameValue(
+	header, field string,
+	entries []Entry,
+	required bool,
+) bool {
+
+	ets.t.Helper()
+	var want any
+	var found bool
+	for _, ent := range entries {
+		if have, err := hasKey(ent, field); err == nil {
+			want, found = have, true
+			break
+		}
+	}
+
+	msg := notice.New(header).Append("field", "%s", field)
+	if found {
+		msg = msg.Append("want", "%s", fieldString(want))
+	}
+	var failed bool
+	for _, ent := range entries {
+		name := fmt.Sprintf("entry %d", ent.idx)
+		have, err := hasKey(ent, field)
+		switch {
+		case err != nil && required:
+			msg = msg.Append(name, "%s", "missing")
+		case err == nil && check.Equal(want, have) != nil:
+			msg = msg.Append(name, "%s", fieldString(have))
+		default:
+			continue
+		}
+		failed = true
+	}
+	if failed {
+		ets.t.Error(msg)
+	}
+	return !failed
+}
+
+// AssertElapsedWithin asserts that the time elapsed between the first log
+// entry passing the first check and the first later log entry passing the
+// second check is at most the given duration. The times are read from the
+// [Config.TimeField] field, see [HasTime]. Returns true if the elapsed time
+// is within the limit. If any of the entries is not found, its time cannot be
+// read, or the elapsed time exceeds the limit, it marks the test as failed,
+// logs an error message, and returns false.
+//
+// Example usage:
+//
+//	tst.Entries().AssertElapsedWithin(
+//		logkit.CheckMsg("signal received"),
+//		logkit.CheckMsg("shutdown completed"),
+//		5*time.Second,
+//	)
+func (ets Entries) AssertElapsedWithin(
+	first, second Checker,
+	limit time.Duration,
+) bool {
+
+	ets.t.Helper()
+	matches := func(chk Checker) func(Entry) bool {
+		return func(ent Entry) bool { return runChecks(ent, chk) }
+	}
+	i := slices.IndexFunc(ets.ets, matches(first))
+	if i < 0 {
+		msg := notice.New("[log entry] no matching log entry found").
+			Append("entry", "%s", "first").
+			Append("entries", "%d", len(ets.ets))
+		ets.t.Error(msg)
+		return false
+	}
+	j := slices.IndexFunc(ets.ets[i+1:], matches(second))
+	if j < 0 {
+		msg := notice.New("[log entry] no matching log entry found").
+			Append("entry", "%s", "second").
+			Append("after", "%d", ets.ets[i].idx)
+		ets.t.Error(msg)
+		return false
+	}
+	beg, end := ets.ets[i], ets.ets[i+1+j]
+
+	var times [2]time.Time
+	for k, ent := range []Entry{beg, end} {
+		have, err := HasTime(ent, ets.cfg.TimeField)
+		if err != nil {
+			ets.t.Error(notice.From(err).Prepend("index", "%d", ent.idx))
+			return false
+		}
+		times[k] = have
+	}
+	elapsed := times[1].Sub(times[0])
+	if elapsed <= limit {
+		return true
+	}
+	msg := notice.New("[log entry] expected log entries within the duration").
+		Append("first", "%d", beg.idx).
+		Append("second", "%d", end.idx).
+		Want("<= %s", limit).
+		Have("%s", elapsed)
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertMaxRate asserts that no more than n log entries passing all the
+// provided checks fall into any time window of the given duration. The times
+// are read from the [Config.TimeField] field, see [HasTime], and the window
+// slides from one matching entry to another. It's used to test log sampling
+// and throttling implementations. Returns true if the rate is never exceeded.
+// If any of the matching entries has no valid time, or the rate is exceeded,
+// it marks the test as failed, logs an error message, and returns false.
+//
+// Example usage:
+//
+//	tst.Entries().AssertMaxRate(
+//		[]logkit.Checker{logkit.CheckMsg("cache miss")},
+//		10,
+//		time.Second,
+//	)
+func (ets Entries) AssertMaxRate(
+	checks []Checker,
+	n int,
+	per time.Duration,
+) bool {
+
+	ets.t.Helper()
+	type stamp struct {
+		idx int
+		tim time.Time
+	}
+	var stamps []stamp
+	for _, ent := range ets.filter(checks...).ets {
+		have, err := HasTime(ent, ets.cfg.TimeField)
+		if err != nil {
+			ets.t.Error(notice.From(err).Prepend("index", "%d", ent.idx))
+			return false
+		}
+		stamps = append(stamps, stamp{idx: ent.idx, tim: have})
+	}
+	slices.SortStableFunc(stamps, func(a, b stamp) int {
+		return a.tim.Compare(b.tim)
+	})
+
+	for i, beg := range stamps {
+		cnt := 0
+		for _, stm := range stamps[i:] {
+			if stm.tim.Sub(beg.tim) >= per {
+				break
+			}
+			cnt++
+		}
+		if cnt > n {
+			msg := notice.New("[log entry] expected log entries rate limit").
+				Append("window", "%s", per).
+				Append("start", "%d", beg.idx).
+				Want("<= %d", n).
+				Have("%d", cnt)
+			ets.t.Error(msg)
+			return false
+		}
+	}
+	return true
+}
+
+// SampleRatio returns the ratio of the log entries passing the check to all
+// the log entries in the collection. Returns zero for an empty collection.
+func (ets Entries) SampleRatio(chk Checker) float64 {
+	if len(ets.ets) == 0 {
+		return 0
+	}
+	return float64(ets.count(chk)) / float64(len(ets.ets))
+}
+
+// AssertSampleRatioBetween asserts that the ratio of the log entries passing
+// the check to all the log entries, see [Entries.SampleRatio], is within the
+// inclusive range. It's used to verify probabilistic log sampling. Returns
+// true if the ratio is within the range. If not, it marks the test as failed,
+// logs an error message, and returns false.
+//
+// Example usage:
+//
+//	tst.Entries().AssertSampleRatioBetween(logkit.CheckMsg("req"), 0.05, 0.15)
+func (ets Entries) AssertSampleRatioBetween(
+	chk Checker,
+	low, high float64,
+) bool {
+
+	ets.t.Helper()
+	have := ets.SampleRatio(chk)
+	if have >= low && have <= high {
+		return true
+	}
+	format := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	msg := notice.New("[log entry] expected sample ratio within range").
+		Append("matched", "%d", ets.count(chk)).
+		Append("total", "%d", len(ets.ets)).
+		Want("%s - %s", format(low), format(high)).
+		Have("%s", format(have))
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertCountNear asserts that the number of log entries is within the given
+// tolerance, expressed in percents, from the baseline count. It is useful to
+// detect log volume regressions, see [ReadBaseline] and [WriteBaseline].
+// Returns true if the count is within the tolerance. If not, it marks the test
+// as failed, logs an error message, and returns false.
+func (ets Entries) AssertCountNear(baseline int, tolerancePct float64) bool {
+	ets.t.Helper()
+	have := len(ets.ets)
+	diff := math.Abs(float64(have - baseline))
+	if diff <= float64(baseline)*tolerancePct/100 {
+		return true
+	}
+	tol := strconv.FormatFloat(tolerancePct, 'f', -1, 64)
+	msg := notice.New("[log entry] expected N log entries within tolerance").
+		Want("%d ±%s%%", baseline, tol).
+		Have("%d", have)
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertCount asserts that exactly n log entries in the collection pass all
+// the provided checks. Returns true if the count matches. If not, it marks the
+// test as failed, logs an error message, and returns false.
+func (ets Entries) AssertCount(n int, checks ...Checker) bool {
+	ets.t.Helper()
+	have := ets.count(checks...)
+	if have == n {
+		return true
+	}
+	msg := notice.New("[log entry] expected N matching log entries").
+		Want("%d", n).
+		Have("%d", have)
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertAtLeast asserts that at least n log entries in the collection pass
+// all the provided checks. Returns true if they do. If not, it marks the test
+// as failed, logs an error message, and returns false.
+func (ets Entries) AssertAtLeast(n int, checks ...Checker) bool {
+	ets.t.Helper()
+	have := ets.count(checks...)
+	if have >= n {
+		return true
+	}
+	msg := notice.New("[log entry] expected at least N matching log entries").
+		Want("%d", n).
+		Have("%d", have)
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertAtMost asserts that at most n log entries in the collection pass all
+// the provided checks. Returns true if they do. If not, it marks the test as
+// failed, logs an error message, and returns false.
+func (ets Entries) AssertAtMost(n int, checks ...Checker) bool {
+	ets.t.Helper()
+	have := ets.count(checks...)
+	if have <= n {
+		return true
+	}
+	msg := notice.New("[log entry] expected at most N matching log entries").
+		Want("%d", n).
+		Have("%d", have)
+	ets.t.Error(msg)
+	return false
+}
+
+// AssertAny asserts that at least one log entry in the collection passes all
+// the provided checks. Returns true if found. If no entry passes the checks,
+// it marks the test as failed, logs an error message, and returns false.
+func (ets Entries) AssertAny(checks ...Checker) bool {
+	ets.t.Helper()
+	return ets.exp(checks...)
+}
+
+// Check returns the first log entry in the collection passing all the
+// provided checks and nil error. Unlike [Entries.AssertAny], it never marks
+// the test as failed. If no entry passes the checks, it returns zero value
+// [Entry], see [ZeroEntry], and an error with [ErrNotFound] in its chain.
+func (ets Entries) Check(checks ...Checker) (Entry, error) {
+	for _, ent := range ets.ets {
+		if runChecks(ent, checks...) {
+			return ent, nil
+		}
+	}
+	msg := notice.New("[log entry] no matching log entry found").
+		Append("entries", "%d", len(ets.ets))
+	msg = ets.closest(msg, checks...)
+	return ZeroEntry(ets.t, ets.cfg), msg.Wrap(ErrNotFound)
+}
+
+// AssertNone asserts that no log entry in the collection passes all the
+// provided checks. Returns true if none passes. If any entry passes the
+// checks, it marks the test as failed, logs an error message, and returns
+// false.
+func (ets Entries) AssertNone(checks ...Checker) bool {
+	ets.t.Helper()
+	return ets.notExp(CheckAllOf(checks...))
+}
+
 // AssertMsg asserts that at least one log entry in the collection has the
 // field [Config.MessageField] with the specified value and type. Returns true
 // if found and matches. If no entry has the field with the value and type, it
@@ -194,6 +822,25 @@ func (ets Entries) AssertContain(field, want string) bool {
 	})
 }
 
+// AssertMatch asserts that at least one log entry in the collection has the
+// specified string field matching the regular expression pattern. Returns
+// true if found. If no entry has the matching field, it marks the test as
+// failed, logs an error message, and returns false.
+func (ets Entries) AssertMatch(field, pattern string) bool {
+	ets.t.Helper()
+	return ets.exp(CheckMatch(field, pattern))
+}
+
+// AssertFieldIn asserts that at least one log entry in the collection has
+// the specified field with a value equal to one of the given values, see
+// [CheckOneOf]. Returns true if found. If no entry has the field with one of
+// the values, it marks the test as failed, logs an error message, and returns
+// false.
+func (ets Entries) AssertFieldIn(field string, want ...any) bool {
+	ets.t.Helper()
+	return ets.exp(CheckOneOf(field, want...))
+}
+
 // AssertStr asserts that at least one log entry in the collection has the
 // specified field with the given string value and type. Returns true if found
 // and matches. If no entry has the field with the value and type, it marks the
@@ -230,6 +877,34 @@ func (ets Entries) AssertNoNumber(field string, want float64) bool {
 	return ets.notExp(func(e Entry) error { return CheckNumber(field, want)(e) })
 }
 
+// AssertNumberNear asserts that at least one log entry in the collection has
+// the specified number field within the delta from the given value, see
+// [CheckNumberNear]. Returns true if found. If no entry has the field within
+// the delta, it marks the test as failed, logs an error message, and returns
+// false.
+func (ets Entries) AssertNumberNear(field string, want, delta float64) bool {
+	ets.t.Helper()
+	return ets.exp(CheckNumberNear(field, want, delta))
+}
+
+// AssertNumberGreater asserts that at least one log entry in the collection
+// has the specified number field greater than the given value. Returns true
+// if found. If no entry has the field greater than the value, it marks the
+// test as failed, logs an error message, and returns false.
+func (ets Entries) AssertNumberGreater(field string, than float64) bool {
+	ets.t.Helper()
+	return ets.exp(CheckNumberGreater(field, than))
+}
+
+// AssertNumberLess asserts that at least one log entry in the collection has
+// the specified number field less than the given value. Returns true if
+// found. If no entry has the field less than the value, it marks the test as
+// failed, logs an error message, and returns false.
+func (ets Entries) AssertNumberLess(field string, than float64) bool {
+	ets.t.Helper()
+	return ets.exp(CheckNumberLess(field, than))
+}
+
 // AssertBool asserts that at least one log entry in the collection has the
 // specified field with the given boolean value and type. Returns true if found
 // and matches. If no entry has the field with the value and type, it marks the
@@ -275,24 +950,90 @@ func (ets Entries) AssertNoDuration(field string, want time.Duration) bool {
 	return ets.notExp(func(e Entry) error { return CheckDuration(field, want)(e) })
 }
 
-// exp expects the passed function fn to return nil at least once.
+// count returns the number of log entries passing all the provided checks.
+func (ets Entries) count(checks ...Checker) int {
+	var cnt int
+	for _, ent := range ets.ets {
+		if runChecks(ent, checks...) {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// exp expects the passed checks to pass for at least one entry.
 //
-// It iterates through the log entries and applies the supplied function fn to
-// each entry, breaking the loop and exiting with true the first time the
-// function returns nil error. If none of the entries passed to the function
-// cause it to return nil, the test is marked as failed, an error message is
-// logged, and the method returns false.
-func (ets Entries) exp(fn Checker) bool {
+// It iterates through the log entries and runs the supplied checks on each
+// entry, breaking the loop and exiting with true the first time all the
+// checks pass. If none of the entries pass the checks, the test is marked as
+// failed, an error message with the closest matching entries is logged, and
+// the method returns false.
+func (ets Entries) exp(checks ...Checker) bool {
 	ets.t.Helper()
 	for idx := range ets.ets {
-		if fn(ets.ets[idx]) == nil {
+		if runChecks(ets.ets[idx], checks...) {
 			return true
 		}
 	}
-	ets.t.Error(notice.New("[log entry] no matching log entry found"))
+	msg := notice.New("[log entry] no matching log entry found")
+	ets.t.Error(ets.closest(msg, checks...))
 	return false
 }
 
+// closest appends to the message the entries which passed the most, but not
+// all, of the checks, with the errors of the failed checks. Entries passing
+// the same number of checks are ranked by the number of checks which failed
+// for a field the entry has, like a field with a wrong value. At most three
+// entries are appended. Entries which passed none of the checks are skipped,
+// unless there is a single check which failed for a field the entry has.
+func (ets Entries) closest(
+	msg *notice.Notice,
+	checks ...Checker,
+) *notice.Notice {
+
+	type candidate struct {
+		ent    Entry
+		passed int
+		near   int
+		ers    []string
+	}
+	var cds []candidate
+	for _, ent := range ets.ets {
+		cd := candidate{ent: ent}
+		for _, chk := range checks {
+			if err := chk(ent); err != nil {
+				if errors.Is(err, ErrValue) || errors.Is(err, ErrType) {
+					cd.near++
+				}
+				cd.ers = append(cd.ers, err.Error())
+				continue
+			}
+			cd.passed++
+		}
+		if cd.passed == len(checks) {
+			continue
+		}
+		if cd.passed > 0 || (len(checks) == 1 && cd.near > 0) {
+			cds = append(cds, cd)
+		}
+	}
+	slices.SortStableFunc(cds, func(a, b candidate) int {
+		if a.passed != b.passed {
+			return b.passed - a.passed
+		}
+		return b.near - a.near
+	})
+	for i, cd := range cds[:min(len(cds), 3)] {
+		msg = msg.Append(
+			fmt.Sprintf("closest %d", i),
+			"index %d, %d of %d checks passed\n%s\n%s",
+			cd.ent.idx, cd.passed, len(checks), cd.ent.redacted(),
+			strings.Join(cd.ers, "\n"),
+		)
+	}
+	return msg
+}
+
 // notExp expects the passed function fn never to return nil error.
 //
 // It iterates through the log entries and applies the supplied function fn
@@ -310,10 +1051,19 @@ func (ets Entries) notExp(fn Checker) bool {
 	return true
 }
 
-// Summary returns all log entries as a formatted string.
-func (ets Entries) Summary() string {
+// Summary returns all log entries as a formatted string. When checks are
+// provided, only the entries passing all of them are rendered.
+func (ets Entries) Summary(checks ...Checker) string {
 	ets.t.Helper()
-	return notice.Indent(0, ' ', ets.summary(0))
+	if len(checks) == 0 {
+		return notice.Indent(0, ' ', ets.summary(0))
+	}
+	ets = ets.filter(checks...)
+	if len(ets.ets) == 0 {
+		return "no matching entries logged so far"
+	}
+	out := notice.Indent(2, ' ', ets.print())
+	return "matching entries logged so far:\n" + out
 }
 
 // summary returns a formatted string with all the entries logged so far.
@@ -332,18 +1082,114 @@ func (ets Entries) summary(indent int) string {
 	return sb.String()
 }
 
-// print returns a string with all the entries logged so far.
+// print returns a string with all the entries logged so far. The values of
+// the [Config.RedactFields] fields are replaced with [RedactedValue].
 func (ets Entries) print() string {
 	ets.t.Helper()
 	sb := strings.Builder{}
 	for _, e := range ets.ets {
-		sb.WriteString(e.raw + "\n")
+		sb.WriteString(e.redacted() + "\n")
+	}
+	return sb.String()
+}
+
+// Markdown returns the selected fields of all log entries rendered as a
+// Markdown table. When no fields are provided, all fields present in any of
+// the entries are rendered in alphabetical order. Fields missing in an entry
+// are rendered as empty cells. The values of the [Config.RedactFields] fields
+// are replaced with [RedactedValue].
+func (ets Entries) Markdown(fields ...string) string {
+	if len(fields) == 0 {
+		fields = slices.Sorted(maps.Keys(ets.Stats().Fields))
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("|")
+	for _, field := range fields {
+		sb.WriteString(" " + mdCell(field) + " |")
+	}
+	sb.WriteString("\n|")
+	for range fields {
+		sb.WriteString(" --- |")
+	}
+	sb.WriteString("\n")
+	for _, ent := range ets.ets {
+		m, _ := ent.redactedMap()
+		sb.WriteString("|")
+		for _, field := range fields {
+			var cell string
+			if val, ok := m[field]; ok {
+				cell = mdCell(fieldString(val))
+			}
+			sb.WriteString(" " + cell + " |")
+		}
+		sb.WriteString("\n")
 	}
 	return sb.String()
 }
 
-// Print prints all log entries to test log.
-func (ets Entries) Print() {
+// WriteJSONL writes all log entries to the writer in the JSON Lines format,
+// one entry per line. The values of the [Config.RedactFields] fields are
+// replaced with [RedactedValue].
+func (ets Entries) WriteJSONL(w io.Writer) error {
+	for _, ent := range ets.ets {
+		if _, err := io.WriteString(w, ent.redacted()+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes the selected fields of all log entries to the writer in
+// the CSV format with the header row. When no fields are provided, all fields
+// present in any of the entries are written in alphabetical order. Fields
+// missing in an entry are written as empty values. The values of the
+// [Config.RedactFields] fields are replaced with [RedactedValue].
+func (ets Entries) WriteCSV(w io.Writer, fields ...string) error {
+	if len(fields) == 0 {
+		fields = slices.Sorted(maps.Keys(ets.Stats().Fields))
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(fields); err != nil {
+		return err
+	}
+	for _, ent := range ets.ets {
+		row := make([]string, len(fields))
+		for i, field := range fields {
+			val, ok := ent.m[field]
+			if !ok {
+				continue
+			}
+			if ets.cfg != nil && slices.Contains(ets.cfg.RedactFields, field) {
+				row[i] = RedactedValue
+				continue
+			}
+			row[i] = fieldString(val)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// mdCell escapes characters which cannot be used in a Markdown table cell.
+func mdCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// Print prints all log entries to test log. When checks are provided, only
+// the entries passing all of them are printed.
+func (ets Entries) Print(checks ...Checker) {
+	ets.t.Helper()
+	ets.t.Log(ets.Summary(checks...))
+}
+
+// PrintLevel prints log entries with the given level to test log.
+func (ets Entries) PrintLevel(level string) {
 	ets.t.Helper()
-	ets.t.Log(ets.Summary())
+	ets.Print(CheckLevel(level))
 }
@@ -4,11 +4,13 @@
 package logkit
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
 	"github.com/ctx42/testing/pkg/tester"
 )
 
@@ -75,6 +77,198 @@ func Test_Entries_MetaAll(t *testing.T) {
 	assert.Equal(t, want, have)
 }
 
+func Test_Entries_ByService(t *testing.T) {
+	// --- Given ---
+	const lin0 = `{"level": "info", "service": "api", "message": "msg0"}`
+	const lin1 = `{"level": "info", "service": "db", "message": "msg1"}`
+	const lin2 = `{"level": "info", "service": "api", "message": "msg2"}`
+
+	tspy := tester.New(t, 0)
+	tspy.Close()
+
+	ets := MustEntries(tspy, lin0, lin1, lin2)
+
+	// --- When ---
+	have := ets.ByService("api")
+
+	// --- Then ---
+	assert.Len(t, 2, have.Get())
+	assert.Equal(t, lin0, have.Get()[0].String())
+	assert.Equal(t, lin2, have.Get()[1].String())
+	assert.Same(t, ets.cfg, have.cfg)
+	assert.Same(t, tspy, have.t)
+}
+
+func Test_Entries_ByComponent(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		const lin0 = `{"level": "info", "component": "auth", "message": "msg0"}`
+		const lin1 = `{"level": "info", "component": "cache", "message": "msg1"}`
+		const lin2 = `{"level": "info", "message": "msg2"}`
+
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.ByComponent("cache")
+
+		// --- Then ---
+		assert.Len(t, 1, have.Get())
+		assert.Equal(t, lin1, have.Get()[0].String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info", "message": "msg0"}`)
+
+		// --- When ---
+		have := ets.ByComponent("cache")
+
+		// --- Then ---
+		assert.Empty(t, have.Get())
+		assert.NotNil(t, have.Get())
+	})
+}
+
+func Test_Entries_Select(t *testing.T) {
+	t.Run("selected fields", func(t *testing.T) {
+		// --- Given ---
+		const lin0 = `{"time": "t0", "level": "info", "message": "msg0"}`
+		const lin1 = `{"time": "t1", "level": "error", "pid": 1}`
+
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.Select("message", "level")
+
+		// --- Then ---
+		assert.Len(t, 2, have.Get())
+		ent := have.Get()[0]
+		assert.Equal(t, `{"level":"info","message":"msg0"}`, ent.String())
+		assert.Equal(t, 0, ent.Index())
+		assert.Same(t, tspy, ent.t)
+		ent = have.Get()[1]
+		assert.Equal(t, `{"level":"error"}`, ent.String())
+		want := map[string]any{"level": "error"}
+		assert.Equal(t, want, ent.m)
+		assert.Equal(t, 1, ent.Index())
+		assert.Equal(t, lin1, ets.Get()[1].String())
+	})
+
+	t.Run("numbers keep precision", func(t *testing.T) {
+		// --- Given ---
+		const lin = `{"id": 9007199254740993, "num": 1.50, "pid": 1}`
+
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin)
+
+		// --- When ---
+		have := ets.Select("id", "num")
+
+		// --- Then ---
+		ent := have.Get()[0]
+		want := `{"id":9007199254740993,"num":1.50}`
+		assert.Equal(t, want, ent.String())
+		assert.Equal(t, 1.5, ent.m["num"])
+	})
+
+	t.Run("no fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info"}`)
+
+		// --- When ---
+		have := ets.Select()
+
+		// --- Then ---
+		assert.Equal(t, `{}`, have.Get()[0].String())
+	})
+
+	t.Run("assert raw", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"time": "t0", "level": "info"}`)
+
+		// --- When ---
+		have := ets.Select("level").AssertRaw(`{"level": "info"}`)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+}
+
+func Test_EntriesAs(t *testing.T) {
+	type record struct {
+		Level   string `json:"level"`
+		Message string `json:"message"`
+		ID      int64  `json:"id"`
+	}
+
+	t.Run("decode", func(t *testing.T) {
+		// --- Given ---
+		ets := MustEntries(
+			nil,
+			`{"level": "info", "message": "msg0", "id": 9007199254740993}`,
+			`{"level": "error", "message": "msg1", "extra": true}`,
+		)
+
+		// --- When ---
+		have, err := EntriesAs[record](ets)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := []record{
+			{Level: "info", Message: "msg0", ID: 9007199254740993},
+			{Level: "error", Message: "msg1"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		ets := MustEntries(nil)
+
+		// --- When ---
+		have, err := EntriesAs[record](ets)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []record{}, have)
+	})
+
+	t.Run("error - not decodable", func(t *testing.T) {
+		// --- Given ---
+		ets := MustEntries(nil, `{"id": 1}`, `{"id": "abc"}`)
+
+		// --- When ---
+		have, err := EntriesAs[record](ets)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to decode as the type:\n" +
+			"  index: 1\n" +
+			"   type: logkit.record\n" +
+			"  error: json: cannot unmarshal string into Go struct field "
+		assert.ErrorContain(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
+	})
+}
+
 func Test_Entries_Entry(t *testing.T) {
 	const lin0 = `{"level": "error", "number": 0.0,   "message": "msg0"}`
 	const lin1 = `{"level": "info",  "bool_t": true,  "message": "msg1"}`
@@ -144,138 +338,1539 @@ func Test_AssertRaw(t *testing.T) {
 		const lin1 = `{"level": "info", "str": "msg1"}`
 		const lin2 = `{"level": "info", "str": "msg2"}`
 
-		ets := MustEntries(tspy, lin0, lin1, lin2)
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertRaw(lin0, lin1, lin2)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("entries do not match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		wMsg := "" +
+			"[log entry] expected JSON strings to be equal:\n" +
+			"    index: 2\n" +
+			"     want: {\"level\":\"info\",\"str\":\"msg3\"}\n" +
+			"     have: {\"level\":\"info\",\"str\":\"msg2\"}\n" +
+			"  changed: str (want: \"msg3\", have: \"msg2\")"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectError()
+		tspy.Close()
+
+		const lin0 = `{"level": "info", "str": "msg0"}`
+		const lin1 = `{"level": "info", "str": "msg1"}`
+		const lin2 = `{"level": "info", "str": "msg2"}`
+		const lin3 = `{"level": "info", "str": "msg3"}`
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertRaw(lin0, lin1, lin3)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("ignored fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		const lin0 = `{"level": "info", "time": "2000-01-02T03:04:05Z", "str": "msg0"}`
+		const lin1 = `{"level": "info", "time": "2000-01-02T03:04:06Z", "str": "msg1"}`
+
+		ets := MustEntries(tspy, lin0, lin1)
+		ets.cfg.RawIgnoreFields = []string{"time"}
+
+		// --- When ---
+		have := ets.AssertRaw(
+			`{"level": "info", "str": "msg0"}`,
+			`{"level": "info", "str": "msg1"}`,
+		)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("have has more lines than want", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		wMsg := "" +
+			"[log entry] expected N log entries:\n" +
+			"       want: 1\n" +
+			"       have: 2\n" +
+			"  have logs:\n" +
+			"             {\"level\": \"info\", \"str\": \"msg0\"}\n" +
+			"             {\"level\": \"info\", \"str\": \"msg1\"}\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectError()
+		tspy.Close()
+
+		const lin0 = `{"level": "info", "str": "msg0"}`
+		const lin1 = `{"level": "info", "str": "msg1"}`
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertRaw(lin0)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("want has more lines than have", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		wMsg := "" +
+			"[log entry] expected log entry to exist:\n" +
+			"  index: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectError()
+		tspy.Close()
+
+		const lin0 = `{"level": "info", "str": "msg0"}`
+		const lin1 = `{"level": "info", "str": "msg1"}`
+
+		ets := MustEntries(tspy, lin0)
+
+		// --- When ---
+		have := ets.AssertRaw(lin0, lin1)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertRawSubset(t *testing.T) {
+	const lin0 = `{"level": "info", "time": "2000-01-02T03:04:05Z", "str": "msg0"}`
+	const lin1 = `{"level": "info", "time": "2000-01-02T03:04:06Z", "str": "msg1"}`
+
+	t.Run("entries match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertRawSubset(
+			`{"level": "info", "str": "msg0"}`,
+			`{"level": "info", "str": "msg1"}`,
+		)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - entries do not match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  trail: map[\"str\"]\n" +
+			"  index: 1\n" +
+			"   want: \"msg2\"\n" +
+			"   have: \"msg1\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertRawSubset(
+			`{"level": "info", "str": "msg0"}`,
+			`{"level": "info", "str": "msg2"}`,
+		)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - have has more lines than want", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N log entries:\n" +
+			"       want: 1\n" +
+			"       have: 2\n" +
+			"  have logs:\n" +
+			"             " + lin0 + "\n" +
+			"             " + lin1 + "\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertRawSubset(`{"str": "msg0"}`)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - want has more lines than have", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entry to exist:\n" +
+			"  index: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0)
+
+		// --- When ---
+		have := ets.AssertRawSubset(`{"str": "msg0"}`, `{"str": "msg1"}`)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertExactly(t *testing.T) {
+	const lin0 = `{"level": "error", "number": 0.0,   "message": "msg0"}`
+	const lin1 = `{"level": "info",  "bool_t": true,  "message": "msg1"}`
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertExactly(
+			CheckMsg("msg0"),
+			CheckBool("bool_t", true),
+		)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("no checkers and no entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.AssertExactly()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - checker does not match entry", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  index: 1\n" +
+			"  field: message\n" +
+			"   want: \"msg0\"\n" +
+			"   have: \"msg1\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertExactly(CheckMsg("msg0"), CheckMsg("msg0"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - more entries than checkers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N log entries:\n" +
+			"       want: 1\n" +
+			"       have: 2\n" +
+			"  have logs:\n" +
+			"             " + lin0 + "\n" +
+			"             " + lin1 + "\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertExactly(CheckMsg("msg0"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - fewer entries than checkers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected N log entries")
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0)
+
+		// --- When ---
+		have := ets.AssertExactly(CheckMsg("msg0"), CheckMsg("msg1"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertEmpty(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.AssertEmpty()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not empty", func(t *testing.T) {
+		// --- Given ---
+		const lin0 = `{"level": "info", "str": "msg0"}`
+		const lin1 = `{"level": "info", "str": "msg1"}`
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected no log entries:\n" +
+			"       have: 2\n" +
+			"  have logs:\n" +
+			"             " + lin0 + "\n" +
+			"             " + lin1 + "\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertEmpty()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertNotEmpty(t *testing.T) {
+	t.Run("not empty", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info", "str": "msg0"}`)
+
+		// --- When ---
+		have := ets.AssertNotEmpty()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - empty", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("[log entry] expected at least one log entry")
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.AssertNotEmpty()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertLen(t *testing.T) {
+	const lin0 = `{"level": "error", "number": 0.0,   "message": "msg0"}`
+	const lin1 = `{"level": "info",  "bool_t": true,  "message": "msg1"}`
+	const lin2 = `{"level": "info",  "bool_f": false, "message": "msg2"}`
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertLen(3)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("zero length", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.AssertLen(0)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - wrong number of entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N log entries:\n" +
+			"  want: 10\n" +
+			"  have: 3"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertLen(10)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_Stats(t *testing.T) {
+	t.Run("with entries", func(t *testing.T) {
+		// --- Given ---
+		const lin0 = `{"level": "error", "number": 0.0,   "message": "msg0"}`
+		const lin1 = `{"level": "info",  "bool_t": true,  "message": "msg1"}`
+		const lin2 = `{"level": "info",  "bool_f": false, "message": "msg2"}`
+		const lin3 = `{"number": 1.0, "message": "msg3"}`
+
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2, lin3)
+
+		// --- When ---
+		have := ets.Stats()
+
+		// --- Then ---
+		assert.Equal(t, 4, have.Total)
+		assert.Equal(t, map[string]int{"error": 1, "info": 2}, have.Levels)
+		wFields := map[string]int{
+			"level":   3,
+			"number":  2,
+			"bool_t":  1,
+			"bool_f":  1,
+			"message": 4,
+		}
+		assert.Equal(t, wFields, have.Fields)
+	})
+
+	t.Run("without entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.Stats()
+
+		// --- Then ---
+		assert.Equal(t, 0, have.Total)
+		assert.Empty(t, have.Levels)
+		assert.Empty(t, have.Fields)
+	})
+}
+
+func Test_Entries_AssertLevelCount(t *testing.T) {
+	const lin0 = `{"level": "error", "number": 0.0,   "message": "msg0"}`
+	const lin1 = `{"level": "info",  "bool_t": true,  "message": "msg1"}`
+	const lin2 = `{"level": "info",  "bool_f": false, "message": "msg2"}`
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertLevelCount("info", 2)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("zero count for not logged level", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertLevelCount("warn", 0)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - wrong number of entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N log entries with the level:\n" +
+			"  level: error\n" +
+			"   want: 3\n" +
+			"   have: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertLevelCount("error", 3)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertContiguous(t *testing.T) {
+	t.Run("contiguous sequence", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "offset": 5}`,
+			`{"level": "info", "offset": 6}`,
+			`{"level": "info", "offset": 7}`,
+		)
+
+		// --- When ---
+		have := ets.AssertContiguous("offset")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("without entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.AssertContiguous("offset")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - gap in sequence", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected contiguous sequence:\n" +
+			"  index: 2\n" +
+			"  field: offset\n" +
+			"   want: 2\n" +
+			"   have: 3"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "offset": 0}`,
+			`{"level": "info", "offset": 1}`,
+			`{"level": "info", "offset": 3}`,
+		)
+
+		// --- When ---
+		have := ets.AssertContiguous("offset")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - missing field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  index: 1")
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "offset": 0}`,
+			`{"level": "info"}`,
+		)
+
+		// --- When ---
+		have := ets.AssertContiguous("offset")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertSchemaCompliant(t *testing.T) {
+	t.Run("compliant", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"time": "2000-01-02T03:04:05Z", "level": "info", "message": "A"}`,
+			`{"time": "2000-01-02T03:04:06Z", "level": "info", "message": "B"}`,
+		)
+
+		// --- When ---
+		have := ets.AssertSchemaCompliant()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("required fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info", "service": {"name": "A"}}`)
+		ets.cfg = NewConfig(WithRequiredFields("level", "service.name"))
+
+		// --- When ---
+		have := ets.AssertSchemaCompliant()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - missing fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entries to have required fields:\n" +
+			"  required: time, level, message, service\n" +
+			"   entry 0: time, service\n" +
+			"   entry 2: message, service"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "message": "A"}`,
+			`{"time": "T", "level": "info", "message": "B", "service": "S"}`,
+			`{"time": "T", "level": "info"}`,
+		)
+		ets.cfg = NewConfig(
+			WithRequiredFields("time", "level", "message", "service"),
+		)
+
+		// --- When ---
+		have := ets.AssertSchemaCompliant()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+func Test_Entries_AssertSameValue(t *testing.T) {
+	t.Run("same values", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "trace_id": "t1"}`,
+			`{"level": "info"}`,
+			`{"level": "info", "trace_id": "t1"}`,
+		)
+
+		// --- When ---
+		have := ets.AssertSameValue("trace_id")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("no field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info"}`)
+
+		// --- When ---
+		have := ets.AssertSameValue("trace_id")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("nested field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"trace": {"id": "t1"}}`,
+			`{"trace.id": "t1"}`,
+		)
+
+		// --- When ---
+		have := ets.AssertSameValue("trace.id")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - different values", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entries to have the same field value:\n" +
+			"    field: trace_id\n" +
+			"     want: t1\n" +
+			"  entry 2: t2\n" +
+			"  entry 3: 3"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"level": "info"}`,
+			`{"level": "info", "trace_id": "t1"}`,
+			`{"level": "info", "trace_id": "t2"}`,
+			`{"level": "info", "trace_id": 3}`,
+		)
+
+		// --- When ---
+		have := ets.AssertSameValue("trace_id")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertPropagated(t *testing.T) {
+	t.Run("propagated", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"req": "r1", "trace_id": "t1"}`,
+			`{"req": "r2"}`,
+			`{"req": "r1", "trace_id": "t1"}`,
+		)
+
+		// --- When ---
+		have := ets.AssertPropagated("trace_id", CheckStr("req", "r1"))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - missing and different values", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected field value to be propagated:\n" +
+			"    field: trace_id\n" +
+			"     want: t1\n" +
+			"  entry 0: missing\n" +
+			"  entry 3: t2"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"req": "r1"}`,
+			`{"req": "r1", "trace_id": "t1"}`,
+			`{"req": "r2", "trace_id": "t3"}`,
+			`{"req": "r1", "trace_id": "t2"}`,
+		)
+
+		// --- When ---
+		have := ets.AssertPropagated("trace_id", CheckStr("req", "r1"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - field missing in all entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected field value to be propagated:\n" +
+			"    field: trace_id\n" +
+			"  entry 0: missing"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"req": "r1"}`)
+
+		// --- When ---
+		have := ets.AssertPropagated("trace_id", CheckStr("req", "r1"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - no entries in scope", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected field value to be propagated:\n" +
+			"    field: trace_id\n" +
+			"  entries: no entries in scope"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"req": "r2", "trace_id": "t1"}`)
+
+		// --- When ---
+		have := ets.AssertPropagated("trace_id", CheckStr("req", "r1"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertElapsedWithin(t *testing.T) {
+	const lin0 = `{"time": "2025-01-01T00:00:00Z", "message": "signal"}`
+	const lin1 = `{"time": "2025-01-01T00:00:02Z", "message": "done"}`
+	const lin2 = `{"time": "2025-01-01T00:00:09Z", "message": "signal"}`
+	const lin3 = `{"time": "2025-01-01T00:00:15Z", "message": "done"}`
+
+	t.Run("within", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertElapsedWithin(
+			CheckMsg("signal"),
+			CheckMsg("done"),
+			2*time.Second,
+		)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("second entry must follow the first", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin1, lin2, lin3)
+
+		// --- When ---
+		have := ets.AssertElapsedWithin(
+			CheckMsg("signal"),
+			CheckMsg("done"),
+			6*time.Second,
+		)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - elapsed exceeds limit", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entries within the duration:\n" +
+			"   first: 0\n" +
+			"  second: 2\n" +
+			"    want: <= 5s\n" +
+			"    have: 15s"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin2, lin3)
+
+		// --- When ---
+		have := ets.AssertElapsedWithin(
+			CheckMsg("signal"),
+			CheckMsg("done"),
+			5*time.Second,
+		)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - first entry not found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"    entry: first\n" +
+			"  entries: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin1)
+
+		// --- When ---
+		have := ets.AssertElapsedWithin(
+			CheckMsg("signal"),
+			CheckMsg("done"),
+			time.Second,
+		)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - second entry not found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  entry: second\n" +
+			"  after: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertElapsedWithin(
+			CheckMsg("signal"),
+			CheckMsg("done"),
+			time.Second,
+		)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - missing time", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  index: 1\n")
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, `{"message": "done"}`)
+
+		// --- When ---
+		have := ets.AssertElapsedWithin(
+			CheckMsg("signal"),
+			CheckMsg("done"),
+			time.Second,
+		)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertMaxRate(t *testing.T) {
+	const lin0 = `{"time": "2025-01-01T00:00:00Z", "message": "miss"}`
+	const lin1 = `{"time": "2025-01-01T00:00:01Z", "message": "hit"}`
+	const lin2 = `{"time": "2025-01-01T00:00:01Z", "message": "miss"}`
+	const lin3 = `{"time": "2025-01-01T00:00:02Z", "message": "miss"}`
+	const lin4 = `{"time": "2025-01-01T00:00:02Z", "message": "miss"}`
+
+	t.Run("within rate", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2, lin3)
+
+		// --- When ---
+		have := ets.AssertMaxRate([]Checker{CheckMsg("miss")}, 1, time.Second)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("no matching entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin2)
+
+		// --- When ---
+		have := ets.AssertMaxRate([]Checker{CheckMsg("hit")}, 0, time.Second)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - rate exceeded", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entries rate limit:\n" +
+			"  window: 2s\n" +
+			"   start: 2\n" +
+			"    want: <= 2\n" +
+			"    have: 3"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2, lin3, lin4)
+
+		// --- When ---
+		chks := []Checker{CheckMsg("miss")}
+		have := ets.AssertMaxRate(chks, 2, 2*time.Second)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - unordered entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entries rate limit:\n" +
+			"  window: 1s\n" +
+			"   start: 0\n" +
+			"    want: <= 1\n" +
+			"    have: 2"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin3, lin0, lin2, lin4)
+
+		// --- When ---
+		have := ets.AssertMaxRate([]Checker{CheckMsg("miss")}, 1, time.Second)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - missing time", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("  index: 1\n")
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, `{"message": "miss"}`)
+
+		// --- When ---
+		have := ets.AssertMaxRate([]Checker{CheckMsg("miss")}, 5, time.Second)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_SampleRatio(t *testing.T) {
+	t.Run("ratio", func(t *testing.T) {
+		// --- Given ---
+		ets := MustEntries(nil, `{"A": 1}`, `{"A": 2}`, `{"B": 1}`, `{"A": 1}`)
+
+		// --- When ---
+		have := ets.SampleRatio(CheckNumber("A", 1))
+
+		// --- Then ---
+		assert.Equal(t, 0.5, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		ets := MustEntries(nil)
+
+		// --- When ---
+		have := ets.SampleRatio(CheckNumber("A", 1))
+
+		// --- Then ---
+		assert.Equal(t, 0.0, have)
+	})
+}
+
+func Test_Entries_AssertSampleRatioBetween(t *testing.T) {
+	const lin0 = `{"A": 1}`
+	const lin1 = `{"A": 2}`
+
+	t.Run("within range", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin1, lin1)
+
+		// --- When ---
+		have := ets.AssertSampleRatioBetween(CheckNumber("A", 1), 0.2, 0.25)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - outside range", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected sample ratio within range:\n" +
+			"  matched: 1\n" +
+			"    total: 2\n" +
+			"     want: 0.05 - 0.15\n" +
+			"     have: 0.5"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertSampleRatioBetween(CheckNumber("A", 1), 0.05, 0.15)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertCountNear(t *testing.T) {
+	const lin0 = `{"level": "error", "number": 0.0,   "message": "msg0"}`
+	const lin1 = `{"level": "info",  "bool_t": true,  "message": "msg1"}`
+	const lin2 = `{"level": "info",  "bool_f": false, "message": "msg2"}`
+
+	t.Run("equal to baseline", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertCountNear(3, 0)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("within tolerance", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertCountNear(4, 25)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - above tolerance", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N log entries within tolerance:\n" +
+			"  want: 2 ±10%\n" +
+			"  have: 3"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertCountNear(2, 10)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - below tolerance", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N log entries within tolerance:\n" +
+			"  want: 6 ±49.5%\n" +
+			"  have: 3"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertCountNear(6, 49.5)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertCount(t *testing.T) {
+	const lin0 = `{"level": "warn", "attempt": 1, "message": "retry"}`
+	const lin1 = `{"level": "warn", "attempt": 2, "message": "retry"}`
+	const lin2 = `{"level": "info", "attempt": 3, "message": "done"}`
+
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertCount(2, CheckWarn(), CheckMsg("retry"))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("no checks count all entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertCount(3)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - wrong count", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N matching log entries:\n" +
+			"  want: 3\n" +
+			"  have: 2"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertCount(3, CheckWarn())
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertAtLeast(t *testing.T) {
+	const lin0 = `{"level": "warn", "attempt": 1, "message": "retry"}`
+	const lin1 = `{"level": "warn", "attempt": 2, "message": "retry"}`
+	const lin2 = `{"level": "info", "attempt": 3, "message": "done"}`
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertAtLeast(2, CheckWarn())
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("more", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertAtLeast(1, CheckWarn())
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - less", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected at least N matching log entries:\n" +
+			"  want: 3\n" +
+			"  have: 2"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertAtLeast(3, CheckWarn())
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertAtMost(t *testing.T) {
+	const lin0 = `{"level": "warn", "attempt": 1, "message": "retry"}`
+	const lin1 = `{"level": "warn", "attempt": 2, "message": "retry"}`
+	const lin2 = `{"level": "info", "attempt": 3, "message": "done"}`
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertAtMost(2, CheckWarn())
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("less", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertAtMost(3, CheckWarn())
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - more", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected at most N matching log entries:\n" +
+			"  want: 1\n" +
+			"  have: 2"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertAtMost(1, CheckWarn())
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertAny(t *testing.T) {
+	const lin0 = `{"level": "warn", "attempt": 1, "message": "retry"}`
+	const lin1 = `{"level": "info", "attempt": 2, "message": "done"}`
+
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
 
 		// --- When ---
-		have := ets.AssertRaw(lin0, lin1, lin2)
+		have := ets.AssertAny(CheckInfo(), CheckNumber("attempt", 2))
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("entries do not match", func(t *testing.T) {
+	t.Run("error - not found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected JSON strings to be equal:\n" +
-			"  index: 2\n" +
-			"   want: {\"level\":\"info\",\"str\":\"msg3\"}\n" +
-			"   have: {\"level\":\"info\",\"str\":\"msg2\"}"
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 1 of 2 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: level\n" +
+			"                want: \"info\"\n" +
+			"                have: \"warn\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 1 of 2 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             error checking log entry:\n" +
+			"               field: attempt\n" +
+			"                want: 1\n" +
+			"                have: 2"
 		tspy.ExpectLogEqual(wMsg)
-		tspy.ExpectError()
 		tspy.Close()
 
-		const lin0 = `{"level": "info", "str": "msg0"}`
-		const lin1 = `{"level": "info", "str": "msg1"}`
-		const lin2 = `{"level": "info", "str": "msg2"}`
-		const lin3 = `{"level": "info", "str": "msg3"}`
-
-		ets := MustEntries(tspy, lin0, lin1, lin2)
+		ets := MustEntries(tspy, lin0, lin1)
 
 		// --- When ---
-		have := ets.AssertRaw(lin0, lin1, lin3)
+		have := ets.AssertAny(CheckInfo(), CheckNumber("attempt", 1))
 
 		// --- Then ---
 		assert.False(t, have)
 	})
-
-	t.Run("have has more lines than want", func(t *testing.T) {
+	t.Run("error - closest entries ranked", func(t *testing.T) {
 		// --- Given ---
+		const lin = `{"level": "info", "user": "bob", "message": "saved"}`
+
 		tspy := tester.New(t)
+		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected N log entries:\n" +
-			"       want: 1\n" +
-			"       have: 2\n" +
-			"  have logs:\n" +
-			"             {\"level\": \"info\", \"str\": \"msg0\"}\n" +
-			"             {\"level\": \"info\", \"str\": \"msg1\"}\n"
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 1, 2 of 3 checks passed\n" +
+			"             " + lin + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: level\n" +
+			"                want: \"error\"\n" +
+			"                have: \"info\""
 		tspy.ExpectLogEqual(wMsg)
-		tspy.ExpectError()
 		tspy.Close()
 
-		const lin0 = `{"level": "info", "str": "msg0"}`
-		const lin1 = `{"level": "info", "str": "msg1"}`
-
-		ets := MustEntries(tspy, lin0, lin1)
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "user": "alice", "message": "started"}`,
+			lin,
+			`{"level": "debug", "message": "other"}`,
+		)
 
 		// --- When ---
-		have := ets.AssertRaw(lin0)
+		have := ets.AssertAny(
+			CheckError(),
+			CheckStr("user", "bob"),
+			CheckMsg("saved"),
+		)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
+}
 
-	t.Run("want has more lines than have", func(t *testing.T) {
+func Test_Entries_Check(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
 		// --- Given ---
-		tspy := tester.New(t)
-		wMsg := "" +
-			"[log entry] expected log entry to exist:\n" +
-			"  index: 1"
-		tspy.ExpectLogEqual(wMsg)
-		tspy.ExpectError()
+		tspy := tester.New(t, 0)
 		tspy.Close()
 
-		const lin0 = `{"level": "info", "str": "msg0"}`
-		const lin1 = `{"level": "info", "str": "msg1"}`
-
-		ets := MustEntries(tspy, lin0)
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "message": "msg0"}`,
+			`{"level": "error", "message": "msg1"}`,
+		)
 
 		// --- When ---
-		have := ets.AssertRaw(lin0, lin1)
+		have, err := ets.Check(CheckError())
 
 		// --- Then ---
-		assert.False(t, have)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, have.Index())
 	})
-}
-
-func Test_Entries_AssertLen(t *testing.T) {
-	const lin0 = `{"level": "error", "number": 0.0,   "message": "msg0"}`
-	const lin1 = `{"level": "info",  "bool_t": true,  "message": "msg1"}`
-	const lin2 = `{"level": "info",  "bool_f": false, "message": "msg2"}`
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("error - not found", func(t *testing.T) {
 		// --- Given ---
-		tspy := tester.New(t)
+		tspy := tester.New(t, 0)
 		tspy.Close()
 
-		ets := MustEntries(tspy, lin0, lin1, lin2)
+		const lin0 = `{"level": "info", "message": "msg0"}`
+		ets := MustEntries(tspy, lin0)
 
 		// --- When ---
-		have := ets.AssertLen(3)
+		have, err := ets.Check(CheckError())
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.ErrorIs(t, ErrNotFound, err)
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"    entries: 1\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: level\n" +
+			"                want: \"error\"\n" +
+			"                have: \"info\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.True(t, have.IsZero())
 	})
+}
 
-	t.Run("zero length", func(t *testing.T) {
+func Test_Entries_AssertNone(t *testing.T) {
+	const lin0 = `{"level": "warn", "attempt": 1, "message": "retry"}`
+	const lin1 = `{"level": "info", "attempt": 2, "message": "done"}`
+
+	t.Run("not found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ets := MustEntries(tspy)
+		ets := MustEntries(tspy, lin0, lin1)
 
 		// --- When ---
-		have := ets.AssertLen(0)
+		have := ets.AssertNone(CheckInfo(), CheckNumber("attempt", 1))
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("error - wrong number of entries", func(t *testing.T) {
+	t.Run("error - found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		wMsg := "" +
-			"[log entry] expected N log entries:\n" +
-			"  want: 10\n" +
-			"  have: 3"
-		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectLogEqual("[log entry] matching log entry found")
 		tspy.Close()
 
-		ets := MustEntries(tspy, lin0, lin1, lin2)
+		ets := MustEntries(tspy, lin0, lin1)
 
 		// --- When ---
-		have := ets.AssertLen(10)
+		have := ets.AssertNone(CheckWarn(), CheckNumber("attempt", 1))
 
 		// --- Then ---
 		assert.False(t, have)
@@ -305,7 +1900,30 @@ func Test_Entries_AssertMsg(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: message\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg0\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: message\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg1\"\n" +
+			"  closest 2:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: message\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg2\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1, lin2)
@@ -377,7 +1995,30 @@ func Test_Entries_AssertMsgContain(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: message\n" +
+			"                  string: \"msg0 abc\"\n" +
+			"               substring: \"xyz\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: message\n" +
+			"                  string: \"msg1 abc\"\n" +
+			"               substring: \"xyz\"\n" +
+			"  closest 2:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: message\n" +
+			"                  string: \"msg2 abc\"\n" +
+			"               substring: \"xyz\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1, lin2)
@@ -449,7 +2090,30 @@ func Test_Entries_AssertError(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: error\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg0\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: error\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg1\"\n" +
+			"  closest 2:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: error\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg2\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1, lin2)
@@ -485,7 +2149,30 @@ func Test_Entries_AssertErrorContain(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: error\n" +
+			"                  string: \"msg0 abc\"\n" +
+			"               substring: \"xyz\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: error\n" +
+			"                  string: \"msg1 abc\"\n" +
+			"               substring: \"xyz\"\n" +
+			"  closest 2:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: error\n" +
+			"                  string: \"msg2 abc\"\n" +
+			"               substring: \"xyz\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1, lin2)
@@ -557,7 +2244,30 @@ func Test_Entries_AssertErr(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: error\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg0\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: error\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg1\"\n" +
+			"  closest 2:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: error\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"msg2\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1, lin2)
@@ -629,7 +2339,30 @@ func Test_Entries_AssertContain(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: str\n" +
+			"                  string: \"abc def ghi\"\n" +
+			"               substring: \"xxx\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: str\n" +
+			"                  string: \"jkl mno pqr\"\n" +
+			"               substring: \"xxx\"\n" +
+			"  closest 2:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             [log entry] expected string to contain substring:\n" +
+			"                   field: str\n" +
+			"                  string: \"stu vwx yz\"\n" +
+			"               substring: \"xxx\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1, lin2)
@@ -642,6 +2375,101 @@ func Test_Entries_AssertContain(t *testing.T) {
 	})
 }
 
+func Test_Entries_AssertMatch(t *testing.T) {
+	const lin0 = `{"level": "debug", "str": "processed 123 items in 4.5ms"}`
+	const lin1 = `{"level": "debug", "str": "done"}`
+
+	t.Run("field and value found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertMatch("str", `^processed \d+ items in [\d.]+ms$`)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected regexp to match:\n" +
+			"                field: str\n" +
+			"               regexp: ^failed\n" +
+			"                 have: \"processed 123 items in 4.5ms\"\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected regexp to match:\n" +
+			"                field: str\n" +
+			"               regexp: ^failed\n" +
+			"                 have: \"done\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertMatch("str", `^failed`)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertFieldIn(t *testing.T) {
+	const lin0 = `{"level": "info", "message": "msg0"}`
+	const lin1 = `{"level": "warn", "status": "gave_up", "message": "msg1"}`
+
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertFieldIn("status", "retrying", "gave_up")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected log entry field to be one of:\n" +
+			"               field: status\n" +
+			"                want: [\"retrying\",\"done\"]\n" +
+			"                have: gave_up"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertFieldIn("status", "retrying", "done")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
 func Test_Entries_AssertStr(t *testing.T) {
 	const lin0 = `{"level": "info",  "bool_f": false, "message": "msg0"}`
 	const lin1 = `{"level": "debug", "number": 3.0,   "message": "msg1"}`
@@ -665,7 +2493,16 @@ func Test_Entries_AssertStr(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             [log entry] expected values to be equal:\n" +
+			"               field: str\n" +
+			"                want: \"xyz\"\n" +
+			"                have: \"abc\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1, lin2)
@@ -751,49 +2588,224 @@ func Test_Entries_AssertNumber(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             error checking log entry:\n" +
+			"               field: number\n" +
+			"                want: 5\n" +
+			"                have: 3.0\n" +
+			"  closest 1:\n" +
+			"             index 2, 0 of 1 checks passed\n" +
+			"             " + lin2 + "\n" +
+			"             error checking log entry:\n" +
+			"               field: number\n" +
+			"                want: 5\n" +
+			"                have: 4.0"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertNumber("number", 5)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertNoNumber(t *testing.T) {
+	const lin0 = `{"level": "info",  "bool_f": false, "message": "msg0"}`
+	const lin1 = `{"level": "debug", "number": 3.0,   "message": "msg1"}`
+	const lin2 = `{"level": "debug", "number": 4.0,   "message": "msg2"}`
+
+	t.Run("field name exists with different value", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertNoNumber("number", 5)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - field name exists with the value", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("[log entry] matching log entry found")
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.AssertNoNumber("number", 4)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertNumberNear(t *testing.T) {
+	const lin0 = `{"level": "info", "num": 2, "message": "msg0"}`
+	const lin1 = `{"level": "info", "num": 42, "message": "msg1"}`
+
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertNumberNear("num", 40, 5)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected numbers to be within " +
+			"the given delta:\n" +
+			"                    field: num\n" +
+			"                     want: 40\n" +
+			"                     have: 2\n" +
+			"               want delta: 1\n" +
+			"               have delta: 38\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected numbers to be within " +
+			"the given delta:\n" +
+			"                    field: num\n" +
+			"                     want: 40\n" +
+			"                     have: 42\n" +
+			"               want delta: 1\n" +
+			"               have delta: 2"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertNumberNear("num", 40, 1)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entries_AssertNumberGreater(t *testing.T) {
+	const lin0 = `{"level": "info", "num": 2, "message": "msg0"}`
+	const lin1 = `{"level": "info", "num": 42, "message": "msg1"}`
+
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.AssertNumberGreater("num", 40)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected value to be greater:\n" +
+			"                      field: num\n" +
+			"               greater than: 50\n" +
+			"                       have: 2\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected value to be greater:\n" +
+			"                      field: num\n" +
+			"               greater than: 50\n" +
+			"                       have: 42"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ets := MustEntries(tspy, lin0, lin1, lin2)
+		ets := MustEntries(tspy, lin0, lin1)
 
 		// --- When ---
-		have := ets.AssertNumber("number", 5)
+		have := ets.AssertNumberGreater("num", 50)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entries_AssertNoNumber(t *testing.T) {
-	const lin0 = `{"level": "info",  "bool_f": false, "message": "msg0"}`
-	const lin1 = `{"level": "debug", "number": 3.0,   "message": "msg1"}`
-	const lin2 = `{"level": "debug", "number": 4.0,   "message": "msg2"}`
+func Test_Entries_AssertNumberLess(t *testing.T) {
+	const lin0 = `{"level": "info", "num": 2, "message": "msg0"}`
+	const lin1 = `{"level": "info", "num": 42, "message": "msg1"}`
 
-	t.Run("field name exists with different value", func(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ets := MustEntries(tspy, lin0, lin1, lin2)
+		ets := MustEntries(tspy, lin0, lin1)
 
 		// --- When ---
-		have := ets.AssertNoNumber("number", 5)
+		have := ets.AssertNumberLess("num", 5)
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("error - field name exists with the value", func(t *testing.T) {
+	t.Run("error - not found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected value to be smaller:\n" +
+			"                      field: num\n" +
+			"               smaller than: 1\n" +
+			"                       have: 2\n" +
+			"  closest 1:\n" +
+			"             index 1, 0 of 1 checks passed\n" +
+			"             " + lin1 + "\n" +
+			"             [log entry] expected value to be smaller:\n" +
+			"                      field: num\n" +
+			"               smaller than: 1\n" +
+			"                       have: 42"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ets := MustEntries(tspy, lin0, lin1, lin2)
+		ets := MustEntries(tspy, lin0, lin1)
 
 		// --- When ---
-		have := ets.AssertNoNumber("number", 4)
+		have := ets.AssertNumberLess("num", 1)
 
 		// --- Then ---
 		assert.False(t, have)
@@ -874,13 +2886,24 @@ func Test_Entries_AssertTime(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected equal dates:\n" +
+			"               field: tim\n" +
+			"                want: 2001-01-02T03:04:05Z\n" +
+			"                have: 2000-01-02T03:04:05Z\n" +
+			"                diff: 8784h0m0s"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1)
+		want := time.Date(2001, 1, 2, 3, 4, 5, 0, time.UTC)
 
 		// --- When ---
-		have := ets.AssertTime("tim", time.Now())
+		have := ets.AssertTime("tim", want)
 
 		// --- Then ---
 		assert.False(t, have)
@@ -944,7 +2967,16 @@ func Test_Entries_AssertDuration(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		tspy.ExpectLogEqual("[log entry] no matching log entry found")
+		wMsg := "" +
+			"[log entry] no matching log entry found:\n" +
+			"  closest 0:\n" +
+			"             index 0, 0 of 1 checks passed\n" +
+			"             " + lin0 + "\n" +
+			"             [log entry] expected equal time durations:\n" +
+			"               field: dur\n" +
+			"                want: 3600000 (1h0m0s)\n" +
+			"                have: 1000 (1s)"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ets := MustEntries(tspy, lin0, lin1)
@@ -1162,6 +3194,42 @@ func Test_Summary(t *testing.T) {
 			"  " + lin2 + "\n"
 		assert.Equal(t, want, have)
 	})
+
+	t.Run("with checks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		const lin0 = `{"level": "info", "str": "msg0"}`
+		const lin1 = `{"level": "error", "str": "msg1"}`
+		const lin2 = `{"level": "error", "str": "msg2"}`
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.Summary(CheckError())
+
+		// --- Then ---
+		want := "" +
+			"matching entries logged so far:\n" +
+			"  " + lin1 + "\n" +
+			"  " + lin2 + "\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("with checks no matching entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info", "str": "msg0"}`)
+
+		// --- When ---
+		have := ets.Summary(CheckError())
+
+		// --- Then ---
+		assert.Equal(t, "no matching entries logged so far", have)
+	})
 }
 
 func Test_summary(t *testing.T) {
@@ -1239,6 +3307,296 @@ func Test_print(t *testing.T) {
 			lin2 + "\n"
 		assert.Equal(t, want, have)
 	})
+
+	t.Run("redacted fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		const lin0 = `{"level": "info", "token": "secret", "email": "a@b.c"}`
+		const lin1 = `{"level": "info", "str": "msg1"}`
+
+		ets := MustEntries(tspy, lin0, lin1)
+		ets.cfg.RedactFields = []string{"token", "email"}
+
+		// --- When ---
+		have := ets.print()
+
+		// --- Then ---
+		want := "" +
+			`{"email":"[REDACTED]","level":"info","token":"[REDACTED]"}` + "\n" +
+			lin1 + "\n"
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_Entries_Markdown(t *testing.T) {
+	const lin0 = `{"level": "info", "message": "msg0", "num": 1, "map": {"A": 1}}`
+	const lin1 = `{"level": "warn", "message": "a | b"}`
+
+	t.Run("selected fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.Markdown("level", "message", "num")
+
+		// --- Then ---
+		want := "" +
+			"| level | message | num |\n" +
+			"| --- | --- | --- |\n" +
+			"| info | msg0 | 1 |\n" +
+			"| warn | a \\| b |  |\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("redacted fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info", "token": "secret"}`)
+		ets.cfg.RedactFields = []string{"token"}
+
+		// --- When ---
+		have := ets.Markdown("level", "token")
+
+		// --- Then ---
+		want := "" +
+			"| level | token |\n" +
+			"| --- | --- |\n" +
+			"| info | [REDACTED] |\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		have := ets.Markdown()
+
+		// --- Then ---
+		want := "" +
+			"| level | map | message | num |\n" +
+			"| --- | --- | --- | --- |\n" +
+			"| info | {\"A\":1} | msg0 | 1 |\n" +
+			"| warn |  | a \\| b |  |\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("without entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.Markdown("level")
+
+		// --- Then ---
+		assert.Equal(t, "| level |\n| --- |\n", have)
+	})
+}
+
+func Test_Entries_WriteJSONL(t *testing.T) {
+	const lin0 = `{"level": "info", "message": "msg0"}`
+	const lin1 = `{"level": "warn", "message": "msg1", "password": "secret"}`
+
+	t.Run("write", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := ets.WriteJSONL(buf)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, lin0+"\n"+lin1+"\n", buf.String())
+	})
+
+	t.Run("redacted fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+		ets.cfg.RedactFields = []string{"password"}
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := ets.WriteJSONL(buf)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := lin0 + "\n" +
+			`{"level":"warn","message":"msg1","password":"[REDACTED]"}` + "\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("without entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := ets.WriteJSONL(buf)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "", buf.String())
+	})
+
+	t.Run("error - writing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+		buf := &bytes.Buffer{}
+		ew := iokit.ErrWriter(buf, len(lin0)+1)
+
+		// --- When ---
+		err := ets.WriteJSONL(ew)
+
+		// --- Then ---
+		assert.ErrorIs(t, iokit.ErrWrite, err)
+		assert.Equal(t, lin0+"\n", buf.String())
+	})
+}
+
+func Test_Entries_WriteCSV(t *testing.T) {
+	const lin0 = `{"level": "info", "message": "msg0", "num": 1, "map": {"A": 1}}`
+	const lin1 = `{"level": "warn", "message": "a, b", "password": "secret"}`
+
+	t.Run("selected fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := ets.WriteCSV(buf, "level", "message", "num")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := "" +
+			"level,message,num\n" +
+			"info,msg0,1\n" +
+			"warn,\"a, b\",\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := ets.WriteCSV(buf)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := "" +
+			"level,map,message,num,password\n" +
+			"info,\"{\"\"A\"\":1}\",msg0,1,\n" +
+			"warn,,\"a, b\",,secret\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("redacted fields", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+		ets.cfg.RedactFields = []string{"password"}
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := ets.WriteCSV(buf, "level", "password")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := "" +
+			"level,password\n" +
+			"info,\n" +
+			"warn,[REDACTED]\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("without entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+		buf := &bytes.Buffer{}
+
+		// --- When ---
+		err := ets.WriteCSV(buf, "level")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "level\n", buf.String())
+	})
+
+	t.Run("error - writing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+		ew := iokit.ErrWriter(&bytes.Buffer{}, 0)
+
+		// --- When ---
+		err := ets.WriteCSV(ew, "level")
+
+		// --- Then ---
+		assert.ErrorIs(t, iokit.ErrWrite, err)
+	})
+}
+
+func Test_mdCell(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have string
+		want string
+	}{
+		{"plain", "abc", "abc"},
+		{"pipe", "a|b", "a\\|b"},
+		{"new line", "a\nb", "a<br>b"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := mdCell(tc.have)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
 }
 
 func Test_Print(t *testing.T) {
@@ -1274,4 +3632,40 @@ func Test_Print(t *testing.T) {
 		// --- When ---
 		ets.Print()
 	})
+
+	t.Run("with checks", func(t *testing.T) {
+		// --- Given ---
+		const lin0 = `{"level": "info", "str": "msg0"}`
+		const lin1 = `{"level": "info", "str": "msg1"}`
+
+		tspy := tester.New(t)
+		wMsg := "" +
+			"matching entries logged so far:\n" +
+			"  " + lin1 + "\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1)
+
+		// --- When ---
+		ets.Print(CheckStr("str", "msg1"))
+	})
+}
+
+func Test_PrintLevel(t *testing.T) {
+	// --- Given ---
+	const lin0 = `{"level": "info", "str": "msg0"}`
+	const lin1 = `{"level": "error", "str": "msg1"}`
+
+	tspy := tester.New(t)
+	wMsg := "" +
+		"matching entries logged so far:\n" +
+		"  " + lin1 + "\n"
+	tspy.ExpectLogEqual(wMsg)
+	tspy.Close()
+
+	ets := MustEntries(tspy, lin0, lin1)
+
+	// --- When ---
+	ets.PrintLevel("error")
 }
@@ -4,8 +4,14 @@
 package logkit
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ctx42/testing/pkg/check"
@@ -24,6 +30,7 @@ const (
 	TypTime        FieldType = "time"
 	TypDur         FieldType = "duration"
 	TypMap         FieldType = "map"
+	TypNull        FieldType = "null"
 	TypUnsupported FieldType = "unsupported"
 )
 
@@ -34,6 +41,20 @@ type Entry struct {
 	m   map[string]any // JSON decoded log entry.
 	idx int            // Log the message index in the [Entries] collection.
 	t   tester.T       // Test manager.
+
+	// Log line metadata set by [Config.LineDecoder].
+	meta map[string]string
+
+	// Log entry fields with numbers as [json.Number], decoded on first use.
+	exact *exactFields
+}
+
+// exactFields lazily decodes the raw log entry with numbers represented as
+// [json.Number]. It is shared by the copies of the [Entry], so the raw log
+// entry is decoded at most once.
+type exactFields struct {
+	once sync.Once
+	m    map[string]any
 }
 
 // ZeroEntry returns a new [Entry] with only the test manager and config set.
@@ -47,6 +68,37 @@ func ZeroEntry(t tester.T, cfg *Config) Entry {
 	return Entry{cfg: cfg, t: t}
 }
 
+// decodeEntry decodes the log line using the [Config.LineDecoder] and returns
+// the [Entry] with the log entry fields and line metadata. Lines which are not
+// JSON objects after decoding are returned as entries with the line in the
+// [Config.MessageField] field.
+func decodeEntry(cfg *Config, line []byte) (Entry, error) {
+	raw, meta, err := cfg.LineDecoder(bytes.TrimSpace(line))
+	if err != nil {
+		return Entry{}, err
+	}
+	return lineEntry(cfg, raw, meta), nil
+}
+
+// lineEntry returns the [Entry] for the decoded log line and its metadata.
+// Lines which are not JSON objects are returned as entries with the line in
+// the [Config.MessageField] field.
+func lineEntry(cfg *Config, raw []byte, meta map[string]string) Entry {
+	raw = bytes.TrimSpace(raw)
+	m := make(map[string]any)
+	if !bytes.HasPrefix(raw, []byte("{")) || json.Unmarshal(raw, &m) != nil {
+		m = map[string]any{cfg.MessageField: string(raw)}
+		raw, _ = json.Marshal(m) // Map with a string always marshals.
+	}
+	return Entry{
+		cfg:   cfg,
+		raw:   string(raw),
+		m:     m,
+		meta:  meta,
+		exact: &exactFields{},
+	}
+}
+
 // IsZero reports whether the raw string is empty. Returns true if the string
 // is empty, and false otherwise.
 func (ent Entry) IsZero() bool {
@@ -63,30 +115,273 @@ func (ent Entry) String() string {
 	return ent.raw
 }
 
+// redacted returns the log entry as it was written to the writer. When the
+// entry has any of the [Config.RedactFields] fields, the entry is re-encoded
+// with their values replaced by [RedactedValue].
+func (ent Entry) redacted() string {
+	m, ok := ent.redactedMap()
+	if !ok {
+		return ent.raw
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return ent.raw
+	}
+	return string(data)
+}
+
+// redactedMap returns the log entry fields with the values of the
+// [Config.RedactFields] fields replaced by [RedactedValue]. Returns the
+// fields as they are and false when the entry has none of the fields.
+func (ent Entry) redactedMap() (map[string]any, bool) {
+	if ent.cfg == nil || len(ent.cfg.RedactFields) == 0 {
+		return ent.m, false
+	}
+	var m map[string]any
+	for _, field := range ent.cfg.RedactFields {
+		if _, ok := ent.m[field]; !ok {
+			continue
+		}
+		if m == nil {
+			m = maps.Clone(ent.m)
+		}
+		m[field] = RedactedValue
+	}
+	if m == nil {
+		return ent.m, false
+	}
+	return m, true
+}
+
+// fieldString returns the string representation of the JSON decoded field
+// value. Strings are returned as they are, other values are JSON encoded.
+func fieldString(val any) string {
+	if str, ok := val.(string); ok {
+		return str
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprintf("%v", val)
+	}
+	return string(data)
+}
+
 // Bytes return the log entry as it was written to the writer.
 func (ent Entry) Bytes() []byte {
 	return []byte(ent.raw)
 }
 
+// Meta returns the log line metadata value set by the [Config.LineDecoder],
+// like the [MetaStream] of the container log line. Returns an empty string if
+// the metadata value is not set.
+func (ent Entry) Meta(key string) string {
+	return ent.meta[key]
+}
+
 // MetaAll returns JSON decoded log entry as a map.
 func (ent Entry) MetaAll() map[string]any {
 	return maps.Clone(ent.m)
 }
 
-// AssertRaw asserts if the raw log entry matches the provided string. If the
-// log entry is not equal, the test is marked as failed, an error message is
-// logged, and the method returns false.
+// AsChecks returns equality checkers for the selected fields of the log entry.
+// The checkers can be used to find other entries having the same values of
+// these fields. If any of the fields doesn't exist, the test is marked as
+// failed, an error message is logged, and a checker for the field is not
+// returned.
+func (ent Entry) AsChecks(fields ...string) []Checker {
+	ent.t.Helper()
+	checks := make([]Checker, 0, len(fields))
+	for _, field := range fields {
+		if !ent.AssertExist(field) {
+			continue
+		}
+		switch val := ent.m[field].(type) {
+		case string:
+			checks = append(checks, CheckStr(field, val))
+		case float64:
+			checks = append(checks, CheckNumber(field, val))
+		case bool:
+			checks = append(checks, CheckBool(field, val))
+		case map[string]any:
+			checks = append(checks, CheckMap(field, val))
+		default:
+			checks = append(checks, checkEqual(field, val))
+		}
+	}
+	return checks
+}
+
+// Check runs all the provided checks on the log entry and returns their
+// errors joined, or nil if all the checks pass. Unlike the assertion methods,
+// it never marks the test as failed, so it can be used to build helpers with
+// custom failure handling or retries.
+//
+// Example usage:
+//
+//	err := ent.Check(logkit.CheckInfo(), logkit.CheckStr("user", "bob"))
+//	if errors.Is(err, logkit.ErrMissing) {
+//	    // Handle missing fields.
+//	}
+func (ent Entry) Check(checks ...Checker) error {
+	ers := make([]error, 0, len(checks))
+	for _, chk := range checks {
+		ers = append(ers, chk(ent))
+	}
+	return errors.Join(ers...)
+}
+
+// AssertRaw asserts if the raw log entry matches the provided string. The
+// [Config.RawIgnoreFields] fields are ignored. If the log entry is not equal,
+// the test is marked as failed, an error message is logged, and the method
+// returns false.
 func (ent Entry) AssertRaw(want string) bool {
 	ent.t.Helper()
-	if err := check.JSON(want, ent.raw); err != nil {
-		err = notice.From(err, "log entry")
+	if err := rawEqual(want, ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// rawEqual checks that the JSON object in the "want" string is equal to the
+// raw log entry. The [Config.RawIgnoreFields] fields are removed from both
+// before the comparison.
+func rawEqual(want string, ent Entry) error {
+	have := ent.raw
+	if ent.cfg != nil && len(ent.cfg.RawIgnoreFields) > 0 {
+		w, wErr := removeFields(want, ent.cfg.RawIgnoreFields...)
+		h, hErr := removeFields(have, ent.cfg.RawIgnoreFields...)
+		if wErr == nil && hErr == nil {
+			want, have = w, h
+		}
+	}
+	if err := check.JSON(want, have); err != nil {
+		msg := notice.From(err, "log entry")
+		var wm, hm map[string]any
+		if json.Unmarshal([]byte(want), &wm) != nil ||
+			json.Unmarshal([]byte(have), &hm) != nil {
+			return msg
+		}
+		dif := &rawDiff{}
+		dif.compare("", wm, hm)
+		if len(dif.missing) > 0 {
+			msg = msg.Append("missing", "%s", strings.Join(dif.missing, ", "))
+		}
+		if len(dif.extra) > 0 {
+			msg = msg.Append("extra", "%s", strings.Join(dif.extra, ", "))
+		}
+		if len(dif.changed) > 0 {
+			msg = msg.Append("changed", "%s", strings.Join(dif.changed, ", "))
+		}
+		return msg
+	}
+	return nil
+}
+
+// rawDiff represents a field-by-field difference of two JSON objects.
+type rawDiff struct {
+	missing []string // Paths of the fields missing in the "have" object.
+	extra   []string // Paths of the fields not present in the "want" object.
+	changed []string // Paths of the fields with different values.
+}
+
+// compare compares the JSON objects field by field, nested objects are
+// compared recursively. The changed fields are recorded with both values.
+func (dif *rawDiff) compare(pth string, want, have map[string]any) {
+	join := func(key string) string {
+		if pth == "" {
+			return key
+		}
+		return pth + "." + key
+	}
+	for _, key := range slices.Sorted(maps.Keys(want)) {
+		hVal, ok := have[key]
+		if !ok {
+			dif.missing = append(dif.missing, join(key))
+			continue
+		}
+		wMap, wOK := want[key].(map[string]any)
+		hMap, hOK := hVal.(map[string]any)
+		if wOK && hOK {
+			dif.compare(join(key), wMap, hMap)
+			continue
+		}
+		if check.Equal(want[key], hVal) != nil {
+			w, _ := json.Marshal(want[key]) // Decoded JSON always marshals.
+			h, _ := json.Marshal(hVal)
+			row := fmt.Sprintf("%s (want: %s, have: %s)", join(key), w, h)
+			dif.changed = append(dif.changed, row)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(have)) {
+		if _, ok := want[key]; !ok {
+			dif.extra = append(dif.extra, join(key))
+		}
+	}
+}
+
+// removeFields removes the fields from the JSON object and returns the
+// re-encoded object.
+func removeFields(data string, fields ...string) (string, error) {
+	var m map[string]any
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return "", err
+	}
+	for _, field := range fields {
+		delete(m, field)
+	}
+	out, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// AssertRawSubset asserts that all the fields of the JSON object in the
+// provided string are present in the log entry with equal values. Fields
+// which are in the log entry but not in the "want" are ignored. Nested objects
+// are compared in full. If the log entry is not a superset of "want", the test
+// is marked as failed, an error message is logged, and the method returns
+// false.
+func (ent Entry) AssertRawSubset(want string) bool {
+	ent.t.Helper()
+	if err := rawSubset(want, ent); err != nil {
 		ent.t.Error(err)
 		return false
+	}
+	return true
+}
 
+// AssertSubset asserts that all the keys of the "want" map are present in the
+// log entry with equal values, see [CheckSubset]. Unlike
+// [Entry.AssertRawSubset], nested maps are compared recursively, and only
+// their provided keys are checked. If the log entry is not a superset of
+// "want", the test is marked as failed, an error message is logged, and the
+// method returns false.
+func (ent Entry) AssertSubset(want map[string]any) bool {
+	ent.t.Helper()
+	if err := CheckSubset(want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
 	}
 	return true
 }
 
+// rawSubset checks that all the fields of the JSON object in the "want" string
+// are present in the log entry with equal values.
+func rawSubset(want string, ent Entry) error {
+	var wm map[string]any
+	if err := json.Unmarshal([]byte(want), &wm); err != nil {
+		return notice.New("[log entry] expected valid JSON object").
+			Append("error", "%s", err).
+			Append("want", "%s", want)
+	}
+	if err := check.MapSubset(wm, ent.m); err != nil {
+		return notice.From(err, "log entry")
+	}
+	return nil
+}
+
 // AssertExist asserts log entry has the given field name. If it doesn't, the
 // test is marked as failed, an error message is logged, and the method returns
 // false.
@@ -113,6 +408,40 @@ func (ent Entry) AssertNotExist(field string) bool {
 	return false
 }
 
+// AssertNull asserts that the log entry contains the field with an explicit
+// JSON null value. Returns true if it does. Otherwise, it marks the test as
+// failed, logs an error message, and returns false.
+func (ent Entry) AssertNull(field string) bool {
+	ent.t.Helper()
+	if !ent.AssertExist(field) {
+		return false
+	}
+	if val := ent.m[field]; val != nil {
+		const format = "expected log entry field to be null:\n" +
+			"  field: %s\n" +
+			"   have: %#v"
+		ent.t.Errorf(format, field, val)
+		return false
+	}
+	return true
+}
+
+// AssertNotNull asserts that the log entry contains the field with a value
+// other than JSON null. Returns true if it does. Otherwise, it marks the test
+// as failed, logs an error message, and returns false.
+func (ent Entry) AssertNotNull(field string) bool {
+	ent.t.Helper()
+	if !ent.AssertExist(field) {
+		return false
+	}
+	if ent.m[field] == nil {
+		const format = "expected log entry field not to be null:\n  field: %s"
+		ent.t.Errorf(format, field)
+		return false
+	}
+	return true
+}
+
 // AssertFieldCount asserts if the log entry has exactly the specified number
 // of fields. It returns true if the field count matches, otherwise it marks
 // the test as failed, logs an error message, and returns false.
@@ -129,6 +458,26 @@ func (ent Entry) AssertFieldCount(want int) bool {
 	return false
 }
 
+// Fields returns the names of the log entry top-level fields sorted
+// alphabetically.
+func (ent Entry) Fields() []string {
+	return slices.Sorted(maps.Keys(ent.m))
+}
+
+// AssertFieldsExactly asserts that the log entry has exactly the specified
+// top-level fields, see [CheckFieldsExactly]. Unlike the value assertions, it
+// catches fields added to or removed from the log statement. Returns true if
+// the field sets are equal. If not, it marks the test as failed, logs an
+// error message listing the missing and extra fields, and returns false.
+func (ent Entry) AssertFieldsExactly(fields ...string) bool {
+	ent.t.Helper()
+	if err := CheckFieldsExactly(fields...)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
 // AssertFieldType asserts if the log entry contains a field with the specified
 // name and type. It returns true if the field exists and matches the expected
 // type, otherwise it marks the test as failed and returns false.
@@ -156,6 +505,8 @@ func (ent Entry) AssertFieldType(field string, want FieldType) bool {
 		have = TypDur
 	case map[string]any:
 		have = TypMap
+	case nil:
+		have = TypNull
 	default:
 		have = TypUnsupported
 	}
@@ -171,13 +522,14 @@ func (ent Entry) AssertFieldType(field string, want FieldType) bool {
 	return false
 }
 
-// Level retrieves the log level from the field named [Config.LevelField].
-// Returns the level as a string and nil error if the field is valid.
-// If missing, returns an empty string and [ErrMissing]. For invalid type or
-// value, returns empty string and [ErrType] or [ErrValue], respectively.
+// Level retrieves the log level from the field named [Config.LevelField], see
+// [HasLevel]. Returns the level as a string and nil error if the field is
+// valid. If missing, returns an empty string and [ErrMissing]. For invalid
+// type or value, returns empty string and [ErrType] or [ErrFormat], or
+// [ErrValue], respectively.
 func (ent Entry) Level() (string, error) {
 	ent.t.Helper()
-	val, err := HasStr(ent, ent.cfg.LevelField)
+	val, err := HasLevel(ent)
 	if err != nil {
 		return "", err
 	}
@@ -187,6 +539,42 @@ func (ent Entry) Level() (string, error) {
 	return val, nil
 }
 
+// Service retrieves the service name from the field named
+// [Config.ServiceField]. Returns the name and nil error if the field exists
+// and is a string. If the field is missing or not a string, it returns an
+// empty string and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) Service() (string, error) {
+	ent.t.Helper()
+	return HasStr(ent, ent.cfg.ServiceField)
+}
+
+// Component retrieves the component name from the field named
+// [Config.ComponentField]. Returns the name and nil error if the field exists
+// and is a string. If the field is missing or not a string, it returns an
+// empty string and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) Component() (string, error) {
+	ent.t.Helper()
+	return HasStr(ent, ent.cfg.ComponentField)
+}
+
+// Caller retrieves the caller from the field named [Config.CallerField], see
+// [HasCaller]. Returns the caller and nil error if the field exists and is a
+// caller. If the field is missing or not a caller, it returns zero value
+// caller and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) Caller() (Caller, error) {
+	ent.t.Helper()
+	return HasCaller(ent, ent.cfg.CallerField)
+}
+
+// Stack retrieves the stack trace frames from the field named
+// [Config.StackField], see [HasStack]. Returns the frames and nil error if
+// the field exists and is a stack trace. If the field is missing or not a
+// stack trace, it returns nil and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) Stack() ([]Caller, error) {
+	ent.t.Helper()
+	return HasStack(ent, ent.cfg.StackField)
+}
+
 // AssertLevel asserts that the log entry's [Config.LevelField] matches the
 // requested level. Returns true if the field exists and matches. If the field
 // is missing or the value doesn't match, it marks the test as failed, logs an
@@ -236,6 +624,48 @@ func (ent Entry) AssertErr(want error) bool {
 	return ent.AssertError(want.Error())
 }
 
+// AssertCallerFile asserts that the log entry's [Config.CallerField] file path
+// is equal to or ends with the expected path, see [CheckCallerFile]. Returns
+// true if the field exists and matches. If the field is missing or the value
+// doesn't match, it marks the test as failed, logs an error message, and
+// returns false.
+func (ent Entry) AssertCallerFile(want string) bool {
+	ent.t.Helper()
+	if err := CheckCallerFile(want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertCallerContains asserts that the log entry's [Config.CallerField]
+// contains the expected value, see [CheckCallerContains]. Returns true if the
+// field exists and contains the value. If the field is missing or the value
+// doesn't contain it, it marks the test as failed, logs an error message, and
+// returns false.
+func (ent Entry) AssertCallerContains(want string) bool {
+	ent.t.Helper()
+	if err := CheckCallerContains(want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertStackContains asserts that the log entry's [Config.StackField] has a
+// frame containing the expected value, see [CheckStackContains]. Returns true
+// if the field exists and has such frame. If the field is missing or has no
+// such frame, it marks the test as failed, logs an error message, and returns
+// false.
+func (ent Entry) AssertStackContains(want string) bool {
+	ent.t.Helper()
+	if err := CheckStackContains(want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
 // Str retrieves the string value of a field in the log entry. Returns the
 // string and nil error if the field exists and is a string. If the field is
 // missing or not a string, it returns an empty string and [ErrMissing] or
@@ -271,6 +701,58 @@ func (ent Entry) AssertContain(field, want string) bool {
 	return true
 }
 
+// AssertMatch asserts that the log entry's string field matches the regular
+// expression pattern. Returns true if the field exists and matches. If the
+// field is missing or the value doesn't match, marks the test as failed, logs
+// an error message, and returns false.
+func (ent Entry) AssertMatch(field, pattern string) bool {
+	ent.t.Helper()
+	if err := CheckMatch(field, pattern)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertUUID asserts that the log entry's string field is a valid UUID,
+// see [CheckUUID]. Returns true if the field exists and is valid. If the field
+// is missing or the value is invalid, marks the test as failed, logs an error
+// message, and returns false.
+func (ent Entry) AssertUUID(field string) bool {
+	ent.t.Helper()
+	if err := CheckUUID(field)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertIP asserts that the log entry's string field is a valid IP address,
+// see [CheckIP]. Returns true if the field exists and is valid. If the field
+// is missing or the value is invalid, marks the test as failed, logs an error
+// message, and returns false.
+func (ent Entry) AssertIP(field string) bool {
+	ent.t.Helper()
+	if err := CheckIP(field)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertURL asserts that the log entry's string field is a valid absolute URL,
+// see [CheckURL]. Returns true if the field exists and is valid. If the field
+// is missing or the value is invalid, marks the test as failed, logs an error
+// message, and returns false.
+func (ent Entry) AssertURL(field string) bool {
+	ent.t.Helper()
+	if err := CheckURL(field)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
 // Number retrieves the float64 value of a field in the log entry. Returns the
 // value and nil error if the field exists and is a float64. If the field is
 // missing or not a float64, returns 0 and [ErrMissing] or [ErrType],
@@ -293,6 +775,121 @@ func (ent Entry) AssertNumber(field string, want float64) bool {
 	return true
 }
 
+// Int retrieves the int value of a field in the log entry, see [HasInt].
+// Returns the value and nil error if the field exists and is an int. If the
+// field is missing or not an int, returns 0 and [ErrMissing] or [ErrType],
+// respectively.
+func (ent Entry) Int(field string) (int, error) {
+	ent.t.Helper()
+	return HasInt(ent, field)
+}
+
+// AssertInt asserts that the log entry's int field matches the expected
+// value. Returns true if the field exists and matches. If the field is missing
+// or the value doesn't match, it marks the test as failed, logs an error
+// message, and returns false.
+func (ent Entry) AssertInt(field string, want int) bool {
+	ent.t.Helper()
+	if err := CheckInt(field, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// Int64 retrieves the int64 value of a field in the log entry, see [HasInt64].
+// Returns the value and nil error if the field exists and is an int64. If the
+// field is missing or not an int64, returns 0 and [ErrMissing] or [ErrType],
+// respectively.
+func (ent Entry) Int64(field string) (int64, error) {
+	ent.t.Helper()
+	return HasInt64(ent, field)
+}
+
+// AssertInt64 asserts that the log entry's int64 field matches the expected
+// value. Returns true if the field exists and matches. If the field is missing
+// or the value doesn't match, it marks the test as failed, logs an error
+// message, and returns false.
+func (ent Entry) AssertInt64(field string, want int64) bool {
+	ent.t.Helper()
+	if err := CheckInt64(field, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// Uint64 retrieves the uint64 value of a field in the log entry, see
+// [HasUint64]. Returns the value and nil error if the field exists and is a
+// uint64. If the field is missing or not a uint64, returns 0 and [ErrMissing]
+// or [ErrType], respectively.
+func (ent Entry) Uint64(field string) (uint64, error) {
+	ent.t.Helper()
+	return HasUint64(ent, field)
+}
+
+// AssertUint64 asserts that the log entry's uint64 field matches the expected
+// value. Returns true if the field exists and matches. If the field is missing
+// or the value doesn't match, it marks the test as failed, logs an error
+// message, and returns false.
+func (ent Entry) AssertUint64(field string, want uint64) bool {
+	ent.t.Helper()
+	if err := CheckUint64(field, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// JSONNumber retrieves the number value of a field in the log entry exactly
+// as it was logged, see [HasJSONNumber]. Returns the value and nil error if
+// the field exists and is a number. If the field is missing or not a number,
+// returns an empty number and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) JSONNumber(field string) (json.Number, error) {
+	ent.t.Helper()
+	return HasJSONNumber(ent, field)
+}
+
+// AssertNumberNear asserts that the log entry's number field is within the
+// delta from the expected value, see [CheckNumberNear]. Returns true if the
+// field exists and is within the delta. If the field is missing or the value
+// isn't within the delta, it marks the test as failed, logs an error message,
+// and returns false.
+func (ent Entry) AssertNumberNear(field string, want, delta float64) bool {
+	ent.t.Helper()
+	if err := CheckNumberNear(field, want, delta)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertNumberGreater asserts that the log entry's number field is greater
+// than the given value. Returns true if the field exists and is greater. If
+// the field is missing or the value isn't greater, it marks the test as
+// failed, logs an error message, and returns false.
+func (ent Entry) AssertNumberGreater(field string, than float64) bool {
+	ent.t.Helper()
+	if err := CheckNumberGreater(field, than)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertNumberLess asserts that the log entry's number field is less than the
+// given value. Returns true if the field exists and is less. If the field is
+// missing or the value isn't less, it marks the test as failed, logs an error
+// message, and returns false.
+func (ent Entry) AssertNumberLess(field string, than float64) bool {
+	ent.t.Helper()
+	if err := CheckNumberLess(field, than)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
 // Bool retrieves the boolean value of a field in the log entry. Returns the
 // value and nil error if the field exists and is a boolean. If the field is
 // missing or not a boolean, it returns false and [ErrMissing] or [ErrType],
@@ -338,6 +935,32 @@ func (ent Entry) AssertTime(key string, want time.Time) bool {
 	return true
 }
 
+// AssertTimeAfter asserts that the log entry's time field is after the given
+// mark. Returns true if the field exists and is after the mark. If the field
+// is missing or not after the mark, it marks the test as failed, logs an
+// error message, and returns false.
+func (ent Entry) AssertTimeAfter(field string, mark time.Time) bool {
+	ent.t.Helper()
+	if err := CheckTimeAfter(field, mark)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertTimeBefore asserts that the log entry's time field is before the
+// given mark. Returns true if the field exists and is before the mark. If the
+// field is missing or not before the mark, it marks the test as failed, logs
+// an error message, and returns false.
+func (ent Entry) AssertTimeBefore(field string, mark time.Time) bool {
+	ent.t.Helper()
+	if err := CheckTimeBefore(field, mark)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
 // AssertWithin asserts that the log entry's time field is within the given
 // duration from the expected value. Returns true if the field exists and is
 // within the duration. If the field is missing or not within the duration, it
@@ -368,10 +991,11 @@ func (ent Entry) AssertLoggedWithin(want time.Time, diff string) bool {
 	return ent.AssertWithin(ent.cfg.TimeField, want, diff)
 }
 
-// Duration retrieves the [time.Duration] value of a field in the log entry.
-// Returns the duration and nil error if the field exists and is an integer.
-// If the field is missing or not an integer, returns 0 and [ErrMissing] or
-// [ErrType], respectively.
+// Duration retrieves the [time.Duration] value of a field in the log entry,
+// see [HasDur]. Returns the duration and nil error if the field exists and is
+// a duration. If the field is missing, has an invalid type, or invalid
+// format, returns 0 and [ErrMissing], [ErrType], or [ErrFormat],
+// respectively.
 func (ent Entry) Duration(field string) (time.Duration, error) {
 	ent.t.Helper()
 	return HasDur(ent, field)
@@ -390,6 +1014,24 @@ func (ent Entry) AssertDuration(field string, want time.Duration) bool {
 	return true
 }
 
+// AssertDurationWithin asserts that the log entry's duration field is within
+// the delta from the expected value, see [CheckDurationWithin]. Returns true
+// if the field exists and is within the delta. If the field is missing or not
+// within the delta, it marks the test as failed, logs an error message, and
+// returns false.
+func (ent Entry) AssertDurationWithin(
+	field string,
+	want, delta time.Duration,
+) bool {
+
+	ent.t.Helper()
+	if err := CheckDurationWithin(field, want, delta)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
 // Map retrieves the log entry key as a map[string]any. Returns the map and
 // nil error if the field exists and is valid. If the field is missing or not a
 // map, returns nil and [ErrMissing] or [ErrType], respectively.
@@ -410,3 +1052,78 @@ func (ent Entry) AssertMap(field string, want map[string]any) bool {
 	}
 	return true
 }
+
+// Get retrieves the value at the specified path, see [HasPath]. Returns the
+// value and nil error if the path exists. If the path is missing or cannot be
+// followed, returns nil and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) Get(path string) (any, error) {
+	ent.t.Helper()
+	return HasPath(ent, path)
+}
+
+// AssertPath asserts that the value at the specified path, see [HasPath],
+// matches the provided "want" value. Returns true if the path exists and
+// matches. If the path is missing or the value doesn't match, it marks the
+// test as failed, logs an error message, and returns false.
+func (ent Entry) AssertPath(path string, want any) bool {
+	ent.t.Helper()
+	if err := CheckPath(path, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// Slice retrieves the array value of a field in the log entry. Returns the
+// slice and nil error if the field exists and is an array. If the field is
+// missing or not an array, returns nil and [ErrMissing] or [ErrType],
+// respectively.
+func (ent Entry) Slice(field string) ([]any, error) {
+	ent.t.Helper()
+	return HasSlice(ent, field)
+}
+
+// StrSlice retrieves the array of strings value of a field in the log entry.
+// Returns the slice and nil error if the field exists and is an array of
+// strings. If the field is missing or not an array of strings, returns nil
+// and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) StrSlice(field string) ([]string, error) {
+	ent.t.Helper()
+	return HasStrSlice(ent, field)
+}
+
+// NumSlice retrieves the array of numbers value of a field in the log entry.
+// Returns the slice and nil error if the field exists and is an array of
+// numbers. If the field is missing or not an array of numbers, returns nil
+// and [ErrMissing] or [ErrType], respectively.
+func (ent Entry) NumSlice(field string) ([]float64, error) {
+	ent.t.Helper()
+	return HasNumSlice(ent, field)
+}
+
+// AssertSliceLen asserts that the log entry's array field has the expected
+// length. Returns true if the field exists and has the expected length. If
+// the field is missing, not an array, or the length doesn't match, it marks
+// the test as failed, logs an error message, and returns false.
+func (ent Entry) AssertSliceLen(field string, want int) bool {
+	ent.t.Helper()
+	if err := CheckSliceLen(field, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertSliceContains asserts that the log entry's array field contains an
+// element equal to the "want" value. Returns true if the field exists and
+// contains the value. If the field is missing, not an array, or doesn't
+// contain the value, it marks the test as failed, logs an error message, and
+// returns false.
+func (ent Entry) AssertSliceContains(field string, want any) bool {
+	ent.t.Helper()
+	if err := CheckSliceContains(field, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
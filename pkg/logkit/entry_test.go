@@ -4,6 +4,7 @@
 package logkit
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -98,6 +99,90 @@ func Test_Entry_String(t *testing.T) {
 	assert.Equal(t, `{"level": "error", "A": 1}`, have)
 }
 
+func Test_Entry_redacted(t *testing.T) {
+	t.Run("no redacted fields configured", func(t *testing.T) {
+		// --- Given ---
+		tst := New(t)
+		MustWriteLine(tst, `{"level": "error", "token": "secret"}`)
+		ent := tst.LastEntry()
+
+		// --- When ---
+		have := ent.redacted()
+
+		// --- Then ---
+		assert.Equal(t, `{"level": "error", "token": "secret"}`, have)
+	})
+
+	t.Run("entry without redacted fields", func(t *testing.T) {
+		// --- Given ---
+		cfg := DefaultConfig()
+		cfg.RedactFields = []string{"token"}
+		tst := New(t, WithConfig(cfg))
+		MustWriteLine(tst, `{"level": "error", "A": 1}`)
+		ent := tst.LastEntry()
+
+		// --- When ---
+		have := ent.redacted()
+
+		// --- Then ---
+		assert.Equal(t, `{"level": "error", "A": 1}`, have)
+	})
+
+	t.Run("entry with redacted fields", func(t *testing.T) {
+		// --- Given ---
+		cfg := DefaultConfig()
+		cfg.RedactFields = []string{"token", "email"}
+		tst := New(t, WithConfig(cfg))
+		MustWriteLine(tst, `{"level": "error", "token": "secret", "A": 1}`)
+		ent := tst.LastEntry()
+
+		// --- When ---
+		have := ent.redacted()
+
+		// --- Then ---
+		want := `{"A":1,"level":"error","token":"[REDACTED]"}`
+		assert.Equal(t, want, have)
+		assert.Equal(t, "secret", ent.MetaAll()["token"])
+	})
+
+	t.Run("zero entry", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{}
+
+		// --- When ---
+		have := ent.redacted()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_fieldString(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		have any
+		want string
+	}{
+		{"string", "abc", "abc"},
+		{"number", 1.5, "1.5"},
+		{"bool", true, "true"},
+		{"nil", nil, "null"},
+		{"slice", []any{1.0, "a"}, `[1,"a"]`},
+		{"map", map[string]any{"A": "a"}, `{"A":"a"}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := fieldString(tc.have)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
 func Test_Entry_Bytes(t *testing.T) {
 	// --- Given ---
 	tst := New(t)
@@ -143,67 +228,63 @@ func Test_Entry_MetaAll(t *testing.T) {
 	assert.Equal(t, want, have)
 }
 
-func Test_Entry_AssertRaw(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_Entry_AsChecks(t *testing.T) {
+	const lin = `{"level": "info", "str": "abc", "num": 1, "bool": true, ` +
+		`"map": {"A": "a"}, "arr": [1, 2], "null": null}`
+
+	t.Run("checks match the entry", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			raw: `{"A": 1}`,
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertRaw(`{"A": 1}`)
+		have := ent.AsChecks("str", "num", "bool", "map", "arr", "null")
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.Len(t, 6, have)
+		for _, chk := range have {
+			assert.NoError(t, chk(ent))
+		}
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("checks find similar entries", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
-		wMsg := "" +
-			"[log entry] expected JSON strings to be equal:\n" +
-			"  want: {\"A\":2}\n" +
-			"  have: {\"A\":1}"
-		tspy.ExpectLogEqual(wMsg)
-		tspy.ExpectError()
 		tspy.Close()
 
-		ent := &Entry{
-			raw: `{"A": 1}`,
-			t:   tspy,
-		}
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "job": "abc", "status": "started"}`,
+			`{"level": "info", "job": "def", "status": "done"}`,
+			`{"level": "info", "job": "abc", "status": "done"}`,
+		)
+		checks := ets.Entry(0).AsChecks("job")
+		checks = append(checks, CheckStr("status", "done"))
 
 		// --- When ---
-		have := ent.AssertRaw(`{"A": 2}`)
+		have := ets.AssertCount(1, checks...)
 
 		// --- Then ---
-		assert.False(t, have)
+		assert.True(t, have)
 	})
-}
 
-func Test_Entry_AssertExist(t *testing.T) {
-	t.Run("found", func(t *testing.T) {
+	t.Run("no fields", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"str": "abc"},
-			t: tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertExist("str")
+		have := ent.AsChecks()
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.Len(t, 0, have)
 	})
 
-	t.Run("not found", func(t *testing.T) {
+	t.Run("error - field does not exist", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
@@ -211,71 +292,79 @@ func Test_Entry_AssertExist(t *testing.T) {
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			m: make(map[string]any),
-			t: tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertExist("missing")
+		have := ent.AsChecks("str", "missing")
 
 		// --- Then ---
-		assert.False(t, have)
+		assert.Len(t, 1, have)
 	})
 }
 
-func Test_Entry_AssertNotExist(t *testing.T) {
-	t.Run("found", func(t *testing.T) {
+func Test_Entry_Check(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
-		tspy.ExpectError()
-		wMsg := "expected log entry field not to be present:\n  field: str"
-		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"str": "abc"},
-			t: tspy,
-		}
+		ent := MustEntries(tspy, `{"level": "info", "user": "bob"}`).Entry(0)
 
 		// --- When ---
-		have := ent.AssertNotExist("str")
+		err := ent.Check(CheckInfo(), CheckStr("user", "bob"))
 
 		// --- Then ---
-		assert.False(t, have)
+		assert.NoError(t, err)
 	})
 
-	t.Run("not found", func(t *testing.T) {
+	t.Run("no checks", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			m: make(map[string]any),
-			t: tspy,
-		}
+		ent := MustEntries(tspy, `{"level": "info"}`).Entry(0)
 
 		// --- When ---
-		have := ent.AssertNotExist("missing")
+		err := ent.Check()
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - failing checks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info", "user": "bob"}`).Entry(0)
+
+		// --- When ---
+		err := ent.Check(
+			CheckError(),
+			CheckStr("user", "bob"),
+			CheckStr("count", "3"),
+		)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.ErrorContain(t, "field: count", err)
 	})
 }
 
-func Test_Entry_AssertFieldCount(t *testing.T) {
+func Test_Entry_AssertRaw(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
 		ent := &Entry{
-			m: map[string]any{"str": "abc", "number": 42.0},
-			t: tspy,
+			raw: `{"A": 1}`,
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertFieldCount(2)
+		have := ent.AssertRaw(`{"A": 1}`)
 
 		// --- Then ---
 		assert.True(t, have)
@@ -284,549 +373,1765 @@ func Test_Entry_AssertFieldCount(t *testing.T) {
 	t.Run("not equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
-		tspy.ExpectError()
 		wMsg := "" +
-			"expected log entry to have N fields:\n" +
-			"  want: 3\n" +
-			"  have: 2"
+			"[log entry] expected JSON strings to be equal:\n" +
+			"     want: {\"A\":2}\n" +
+			"     have: {\"A\":1}\n" +
+			"  changed: A (want: 2, have: 1)"
 		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectError()
 		tspy.Close()
 
 		ent := &Entry{
-			m: map[string]any{"str": "abc", "number": 42.0},
-			t: tspy,
+			raw: `{"A": 1}`,
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertFieldCount(3)
+		have := ent.AssertRaw(`{"A": 2}`)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
-}
-
-func Test_Entry_AssertFieldType_tabular(t *testing.T) {
-	tspy := tester.New(t)
-	tspy.Close()
 
-	ent := &Entry{
-		idx: 0,
-		m: map[string]any{
-			"bool":        true,
-			"string":      "val",
-			"integer":     42,
-			"float64":     42.42,
-			"number":      42.42,
-			"time":        time.Now(),
-			"dur":         time.Second,
-			"map":         map[string]any{"k": "v"},
-			"unsupported": struct{}{},
-		},
-		t: tspy,
-	}
+	t.Run("field diff", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		wMsg := "" +
+			"[log entry] expected JSON strings to be equal:\n" +
+			"     want: {\"A\":1,\"B\":{\"C\":\"x\",\"D\":[1]},\"E\":true}\n" +
+			"     have: {\"A\":1,\"B\":{\"C\":\"y\",\"D\":[1],\"F\":2}}\n" +
+			"  missing: E\n" +
+			"    extra: B.F\n" +
+			"  changed: B.C (want: \"x\", have: \"y\")"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectError()
+		tspy.Close()
 
-	tt := []struct {
-		testN string
-		want  FieldType
-	}{
-		{"bool", TypBool},
-		{"string", TypString},
-		{"integer", TypNumber},
-		{"float64", TypNumber},
-		{"number", TypNumber},
-		{"time", TypTime},
-		{"dur", TypDur},
-		{"map", TypMap},
-		{"unsupported", TypUnsupported},
-	}
+		ent := &Entry{
+			raw: `{"A": 1, "B": {"C": "y", "D": [1], "F": 2}}`,
+			t:   tspy,
+		}
 
-	for _, tc := range tt {
-		tc := tc
-		t.Run(tc.testN, func(t *testing.T) {
-			// --- When ---
-			have := ent.AssertFieldType(tc.testN, tc.want)
+		// --- When ---
+		have := ent.AssertRaw(`{"A": 1, "B": {"C": "x", "D": [1]}, "E": true}`)
 
-			// --- Then ---
-			assert.True(t, have)
-		})
-	}
-}
+		// --- Then ---
+		assert.False(t, have)
+	})
 
-func Test_Entry_AssertFieldType(t *testing.T) {
-	t.Run("error - not existing field", func(t *testing.T) {
+	t.Run("ignored fields", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
-		tspy.ExpectError()
-		wMsg := "expected log entry field to be present:\n  field: missing"
-		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
+		cfg := DefaultConfig()
+		cfg.RawIgnoreFields = []string{"time", "pid"}
 		ent := &Entry{
-			m: make(map[string]any),
-			t: tspy,
+			cfg: cfg,
+			raw: `{"A": 1, "time": "2000-01-02T03:04:05Z", "pid": 123}`,
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertFieldType("missing", TypString)
+		have := ent.AssertRaw(`{"A": 1, "time": "2001-01-01T00:00:00Z"}`)
 
 		// --- Then ---
-		assert.False(t, have)
+		assert.True(t, have)
 	})
 
-	t.Run("error - wrong type", func(t *testing.T) {
+	t.Run("ignored fields not equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
-		tspy.ExpectError()
 		wMsg := "" +
-			"expected log entry field type:\n" +
-			"  want: number\n" +
-			"  have: string"
+			"[log entry] expected JSON strings to be equal:\n" +
+			"     want: {\"A\":2}\n" +
+			"     have: {\"A\":1}\n" +
+			"  changed: A (want: 2, have: 1)"
 		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectError()
 		tspy.Close()
 
+		cfg := DefaultConfig()
+		cfg.RawIgnoreFields = []string{"time"}
 		ent := &Entry{
-			m: map[string]any{"str": "abc"},
-			t: tspy,
+			cfg: cfg,
+			raw: `{"A": 1, "time": "2000-01-02T03:04:05Z"}`,
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertFieldType("str", TypNumber)
+		have := ent.AssertRaw(`{"A": 2}`)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 
-	t.Run("error - unsupported type", func(t *testing.T) {
+	t.Run("ignored fields invalid JSON", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectLogContain("[log entry] did not expect the unmarshalling error")
 		tspy.ExpectError()
-		wMsg := "expected log entry field type:\n" +
-			"  want: number\n" +
-			"  have: struct {}"
-		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"struct": struct{}{}},
-			t: tspy,
-		}
+		cfg := DefaultConfig()
+		cfg.RawIgnoreFields = []string{"time"}
+		ent := &Entry{cfg: cfg, raw: `{"A": 1}`, t: tspy}
 
 		// --- When ---
-		have := ent.AssertFieldType("struct", TypNumber)
+		have := ent.AssertRaw(`{!!!}`)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_Level_tabular(t *testing.T) {
-	tt := []struct {
-		testN string
+func Test_removeFields(t *testing.T) {
+	t.Run("remove", func(t *testing.T) {
+		// --- When ---
+		have, err := removeFields(`{"A": 1, "B": 2, "C": 3}`, "A", "C")
 
-		m       map[string]any
-		wantVal string
-		wantErr error
-	}{
-		{
-			"ok",
-			map[string]any{"level": "info"},
-			"info",
-			nil,
-		},
-		{
-			"missing",
-			map[string]any{},
-			"",
-			ErrMissing,
-		},
-		{
-			"wrong type",
-			map[string]any{"level": 42.0},
-			"",
-			ErrType,
-		},
-		{
-			"empty",
-			map[string]any{"level": ""},
-			"",
-			ErrValue,
-		},
-	}
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"B":2}`, have)
+	})
 
-	for _, tc := range tt {
-		t.Run(tc.testN, func(t *testing.T) {
-			// --- Given ---
-			tspy := tester.New(t)
-			tspy.Close()
+	t.Run("field does not exist", func(t *testing.T) {
+		// --- When ---
+		have, err := removeFields(`{"A": 1}`, "B")
 
-			ent := &Entry{
-				cfg: DefaultConfig(),
-				m:   tc.m,
-				t:   tspy,
-			}
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"A":1}`, have)
+	})
 
-			// --- When ---
-			have, err := ent.Level()
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- When ---
+		have, err := removeFields(`{!!!}`, "A")
 
-			// --- Then ---
-			assert.ErrorIs(t, tc.wantErr, err)
-			assert.Equal(t, tc.wantVal, have)
-		})
-	}
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Equal(t, "", have)
+	})
 }
 
-func Test_Entry_AssertLevel(t *testing.T) {
+func Test_Entry_AssertRawSubset(t *testing.T) {
+	const lin = `{"level": "info", "str": "abc", "num": 1, "map": {"A": 1}}`
+
+	t.Run("subset", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertRawSubset(`{"str": "abc", "map": {"A": 1}}`)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"level": "info"},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertLevel("info")
+		have := ent.AssertRawSubset(lin)
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("error - value not equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
 			"[log entry] expected values to be equal:\n" +
-			"  field: %s\n" +
-			"   want: \"error\"\n" +
-			"   have: \"info\""
-		tspy.ExpectLogEqual(wMsg, "level")
+			"  trail: map[\"str\"]\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"level": "info"},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertLevel("error")
+		have := ent.AssertRawSubset(`{"level": "info", "str": "xyz"}`)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
-}
 
-func Test_Entry_AssertMsg(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+	t.Run("error - missing field", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected the map to have keys:\n" +
+			"  keys: \"missing\""
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"message": "abc"},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertMsg("abc")
+		have := ent.AssertRawSubset(`{"missing": 1}`)
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.False(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("error - invalid JSON", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
-			"  field: %s\n" +
-			"   want: \"xyz\"\n" +
-			"   have: \"abc\""
-		tspy.ExpectLogEqual(wMsg, "message")
+			"[log entry] expected valid JSON object:\n" +
+			"  error: invalid character '!' looking for beginning of object key string\n" +
+			"   want: {!!!}"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"message": "abc"},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertMsg("xyz")
+		have := ent.AssertRawSubset(`{!!!}`)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_AssertMsgErr(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_Entry_AssertSubset(t *testing.T) {
+	const lin = `{"level": "info", "str": "abc", "map": {"A": 1, "B": 2}}`
+
+	t.Run("subset", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"message": "abc"},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertMsgErr(errors.New("abc"))
+		have := ent.AssertSubset(map[string]any{
+			"str": "abc",
+			"map": map[string]any{"B": 2},
+		})
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("error - value not equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
 			"[log entry] expected values to be equal:\n" +
-			"  field: message\n" +
-			"   want: \"xyz\"\n" +
-			"   have: \"abc\""
+			"  field: map.B\n" +
+			"   want: 3\n" +
+			"   have: 2"
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"message": "abc"},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, lin).Entry(0)
 
 		// --- When ---
-		have := ent.AssertMsgErr(errors.New("xyz"))
+		have := ent.AssertSubset(map[string]any{"map": map[string]any{"B": 3}})
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_AssertError(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_Entry_AssertExist(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"error": "abc"},
-			t:   tspy,
+			m: map[string]any{"str": "abc"},
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertError("abc")
+		have := ent.AssertExist("str")
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
-			"  field: %s\n" +
-			"   want: \"xyz\"\n" +
-			"   have: \"abc\""
-		tspy.ExpectLogEqual(wMsg, "error")
+		wMsg := "expected log entry field to be present:\n  field: missing"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"error": "abc"},
-			t:   tspy,
+			m: make(map[string]any),
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertError("xyz")
+		have := ent.AssertExist("missing")
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_AssertErr(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_Entry_AssertNotExist(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected log entry field not to be present:\n  field: str"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"error": "abc"},
-			t:   tspy,
+			m: map[string]any{"str": "abc"},
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertErr(errors.New("abc"))
+		have := ent.AssertNotExist("str")
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.False(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
-		tspy.ExpectError()
-		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
-			"  field: %s\n" +
-			"   want: \"xyz\"\n" +
-			"   have: \"abc\""
-		tspy.ExpectLogEqual(wMsg, "error")
 		tspy.Close()
 
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"error": "abc"},
-			t:   tspy,
+			m: make(map[string]any),
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertErr(errors.New("xyz"))
+		have := ent.AssertNotExist("missing")
 
 		// --- Then ---
-		assert.False(t, have)
+		assert.True(t, have)
 	})
 }
 
-func Test_Entry_Str_tabular(t *testing.T) {
-	tt := []struct {
-		field   string
-		wantVal string
-		wantErr error
-	}{
-		{"str", "abc", nil},
-		{"number", "", ErrType},
-		{"missing", "", ErrMissing},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.field, func(t *testing.T) {
-			// --- Given ---
-			tspy := tester.New(t)
-			tspy.Close()
-
-			ent := &Entry{
-				m: map[string]any{"str": "abc", "number": 42.0},
-				t: tspy,
-			}
-
-			// --- When ---
-			have, err := ent.Str(tc.field)
-
-			// --- Then ---
-			assert.ErrorIs(t, tc.wantErr, err)
-			assert.Equal(t, tc.wantVal, have)
-		})
-	}
-}
-
-func Test_Entry_AssertStr(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_Entry_AssertNull(t *testing.T) {
+	t.Run("null", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"str": "abc"},
-			t: tspy,
-		}
+		ent := &Entry{m: map[string]any{"null": nil}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertStr("str", "abc")
+		have := ent.AssertNull("null")
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("not null", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
+			"expected log entry field to be null:\n" +
 			"  field: str\n" +
-			"   want: \"xyz\"\n" +
 			"   have: \"abc\""
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"str": "abc"},
-			t: tspy,
-		}
+		ent := &Entry{m: map[string]any{"str": "abc"}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertStr("str", "xyz")
+		have := ent.AssertNull("str")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected log entry field to be present:\n  field: null"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{m: make(map[string]any), t: tspy}
+
+		// --- When ---
+		have := ent.AssertNull("null")
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_AssertContain(t *testing.T) {
-	t.Run("contains", func(t *testing.T) {
+func Test_Entry_AssertNotNull(t *testing.T) {
+	t.Run("not null", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"str": "abc def ghi"},
-			t: tspy,
-		}
+		ent := &Entry{m: map[string]any{"str": "abc"}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertContain("str", "def")
+		have := ent.AssertNotNull("str")
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("does not contain", func(t *testing.T) {
+	t.Run("null", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		wMsg := "" +
-			"[log entry] expected string to contain substring:\n" +
-			"      field: str\n" +
-			"     string: \"abc def ghi\"\n" +
-			"  substring: \"xyz\""
+		wMsg := "expected log entry field not to be null:\n  field: null"
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"str": "abc def ghi"},
-			t: tspy,
-		}
+		ent := &Entry{m: map[string]any{"null": nil}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertContain("str", "xyz")
+		have := ent.AssertNotNull("null")
 
 		// --- Then ---
 		assert.False(t, have)
 	})
-}
 
-func Test_Entry_Number_tabular(t *testing.T) {
-	tt := []struct {
-		field   string
-		wantVal float64
-		wantErr error
+	t.Run("missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected log entry field to be present:\n  field: null"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{m: make(map[string]any), t: tspy}
+
+		// --- When ---
+		have := ent.AssertNotNull("null")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertFieldCount(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "abc", "number": 42.0},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertFieldCount(2)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"expected log entry to have N fields:\n" +
+			"  want: 3\n" +
+			"  have: 2"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "abc", "number": 42.0},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertFieldCount(3)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_Fields(t *testing.T) {
+	t.Run("fields", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"level": "info", "A": 1.0, "B": "b"}}
+
+		// --- When ---
+		have := ent.Fields()
+
+		// --- Then ---
+		assert.Equal(t, []string{"A", "B", "level"}, have)
+	})
+
+	t.Run("no fields", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{}
+
+		// --- When ---
+		have := ent.Fields()
+
+		// --- Then ---
+		assert.Len(t, 0, have)
+	})
+}
+
+func Test_Entry_AssertFieldsExactly(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level": "info", "message": "msg0", "user": "bob"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertFieldsExactly("level", "message", "user")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - extra field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entry to have exactly the fields:\n" +
+			"   want: level, message\n" +
+			"   have: level, message, user\n" +
+			"  extra: user"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		lin := `{"level": "info", "message": "msg0", "user": "bob"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertFieldsExactly("level", "message")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertFieldType_tabular(t *testing.T) {
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := &Entry{
+		idx: 0,
+		m: map[string]any{
+			"bool":        true,
+			"string":      "val",
+			"integer":     42,
+			"float64":     42.42,
+			"number":      42.42,
+			"time":        time.Now(),
+			"dur":         time.Second,
+			"map":         map[string]any{"k": "v"},
+			"null":        nil,
+			"unsupported": struct{}{},
+		},
+		t: tspy,
+	}
+
+	tt := []struct {
+		testN string
+		want  FieldType
+	}{
+		{"bool", TypBool},
+		{"string", TypString},
+		{"integer", TypNumber},
+		{"float64", TypNumber},
+		{"number", TypNumber},
+		{"time", TypTime},
+		{"dur", TypDur},
+		{"map", TypMap},
+		{"null", TypNull},
+		{"unsupported", TypUnsupported},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ent.AssertFieldType(tc.testN, tc.want)
+
+			// --- Then ---
+			assert.True(t, have)
+		})
+	}
+}
+
+func Test_Entry_AssertFieldType(t *testing.T) {
+	t.Run("error - not existing field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected log entry field to be present:\n  field: missing"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: make(map[string]any),
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertFieldType("missing", TypString)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - wrong type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"expected log entry field type:\n" +
+			"  want: number\n" +
+			"  have: string"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "abc"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertFieldType("str", TypNumber)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - unsupported type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "expected log entry field type:\n" +
+			"  want: number\n" +
+			"  have: struct {}"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"struct": struct{}{}},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertFieldType("struct", TypNumber)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_Level_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		m       map[string]any
+		wantVal string
+		wantErr error
+	}{
+		{
+			"ok",
+			map[string]any{"level": "info"},
+			"info",
+			nil,
+		},
+		{
+			"missing",
+			map[string]any{},
+			"",
+			ErrMissing,
+		},
+		{
+			"wrong type",
+			map[string]any{"level": 42.0},
+			"",
+			ErrType,
+		},
+		{
+			"empty",
+			map[string]any{"level": ""},
+			"",
+			ErrValue,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			tspy := tester.New(t)
+			tspy.Close()
+
+			ent := &Entry{
+				cfg: DefaultConfig(),
+				m:   tc.m,
+				t:   tspy,
+			}
+
+			// --- When ---
+			have, err := ent.Level()
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantVal, have)
+		})
+	}
+}
+
+func Test_Entry_Service(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info", "service": "abc"}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Service()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info"}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Service()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("error - wrong type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info", "service": 1}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Service()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_Entry_Component(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info", "component": "abc"}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Component()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info"}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Component()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("error - wrong type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info", "component": 1}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Component()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_Entry_Caller(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level": "info", "caller": "pkg/file.go:42"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have, err := ent.Caller()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, Caller{File: "pkg/file.go", Line: 42}, have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info"}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Caller()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Zero(t, have)
+	})
+}
+
+func Test_Entry_Stack(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level": "error", ` +
+			`"stack": [{"func": "run", "line": "42", "source": "main.go"}]}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have, err := ent.Stack()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []Caller{{File: "main.go", Line: 42, Func: "run"}}, have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "error"}`).Entry(0)
+
+		// --- When ---
+		have, err := ent.Stack()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Entry_AssertLevel(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"level": "info"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertLevel("info")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: %s\n" +
+			"   want: \"error\"\n" +
+			"   have: \"info\""
+		tspy.ExpectLogEqual(wMsg, "level")
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"level": "info"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertLevel("error")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertMsg(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"message": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertMsg("abc")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: %s\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
+		tspy.ExpectLogEqual(wMsg, "message")
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"message": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertMsg("xyz")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertMsgErr(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"message": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertMsgErr(errors.New("abc"))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: message\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"message": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertMsgErr(errors.New("xyz"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertError(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"error": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertError("abc")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: %s\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
+		tspy.ExpectLogEqual(wMsg, "error")
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"error": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertError("xyz")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertErr(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"error": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertErr(errors.New("abc"))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: %s\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
+		tspy.ExpectLogEqual(wMsg, "error")
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"error": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertErr(errors.New("xyz"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertCallerFile(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level": "error", "caller": "/src/app/db/conn.go:42"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertCallerFile("db/conn.go")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - no match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entry caller file:\n" +
+			"  field: caller\n" +
+			"   want: api/conn.go\n" +
+			"   have: /src/app/db/conn.go:42"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		lin := `{"level": "error", "caller": "/src/app/db/conn.go:42"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertCallerFile("api/conn.go")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertCallerContains(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level": "error", "caller": "/src/app/db/conn.go:42"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertCallerContains("/db/")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - does not contain", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entry caller to contain:\n" +
+			"  field: caller\n" +
+			"   want: /api/\n" +
+			"   have: /src/app/db/conn.go:42"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		lin := `{"level": "error", "caller": "/src/app/db/conn.go:42"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertCallerContains("/api/")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertStackContains(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level": "error", ` +
+			`"stack": [{"func": "run", "line": "42", "source": "main.go"}]}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertStackContains("main.go:42")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - does not contain", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entry stack trace to contain frame:\n" +
+			"   field: stack\n" +
+			"    want: other.go\n" +
+			"  frames: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		lin := `{"level": "error", ` +
+			`"stack": [{"func": "run", "line": "42", "source": "main.go"}]}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertStackContains("other.go")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_Str_tabular(t *testing.T) {
+	tt := []struct {
+		field   string
+		wantVal string
+		wantErr error
+	}{
+		{"str", "abc", nil},
+		{"number", "", ErrType},
+		{"missing", "", ErrMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.field, func(t *testing.T) {
+			// --- Given ---
+			tspy := tester.New(t)
+			tspy.Close()
+
+			ent := &Entry{
+				m: map[string]any{"str": "abc", "number": 42.0},
+				t: tspy,
+			}
+
+			// --- When ---
+			have, err := ent.Str(tc.field)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantVal, have)
+		})
+	}
+}
+
+func Test_Entry_AssertStr(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "abc"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertStr("str", "abc")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: str\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "abc"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertStr("str", "xyz")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertContain(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "abc def ghi"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertContain("str", "def")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("does not contain", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected string to contain substring:\n" +
+			"      field: str\n" +
+			"     string: \"abc def ghi\"\n" +
+			"  substring: \"xyz\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "abc def ghi"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertContain("str", "xyz")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertMatch(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "processed 123 items in 4.5ms"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertMatch("str", `^processed \d+ items`)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected regexp to match:\n" +
+			"   field: str\n" +
+			"  regexp: ^failed\n" +
+			"    have: \"processed 123 items in 4.5ms\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"str": "processed 123 items in 4.5ms"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertMatch("str", `^failed`)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertUUID(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"val": "f47ac10b-58cc-4372-a567-0e02b2c3d479"}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertUUID("val")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected log entry field to be a valid UUID")
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"val": "abc"}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertUUID("val")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertIP(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"val": "10.0.0.1"}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertIP("val")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected log entry field to be a valid IP address")
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"val": "abc"}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertIP("val")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertURL(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"val": "https://example.com"}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertURL("val")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected log entry field to be a valid URL")
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"val": "abc"}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertURL("val")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_Number_tabular(t *testing.T) {
+	tt := []struct {
+		field   string
+		wantVal float64
+		wantErr error
+	}{
+		{"number", 42.0, nil},
+		{"str", 0.0, ErrType},
+		{"missing", 0.0, ErrMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.field, func(t *testing.T) {
+			// --- Given ---
+			tspy := tester.New(t)
+			tspy.Close()
+
+			ent := &Entry{
+				m: map[string]any{"number": 42.0, "str": "abc"},
+				t: tspy,
+			}
+
+			// --- When ---
+			have, err := ent.Number(tc.field)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantVal, have)
+		})
+	}
+}
+
+func Test_Entry_AssertNumber(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"number": 42.0},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertNumber("number", 42.0)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "error checking log entry:\n" +
+			"  field: number\n" +
+			"   want: 44.1\n" +
+			"   have: 42"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"number": 42.0},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertNumber("number", 44.1)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertNumberNear(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"num": 42.0}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertNumberNear("num", 40, 5)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected numbers to be within the given delta")
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"num": 42.0}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertNumberNear("num", 40, 1)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertNumberGreater(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"num": 42.0}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertNumberGreater("num", 40)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected value to be greater")
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"num": 42.0}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertNumberGreater("num", 50)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertNumberLess(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"num": 42.0}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertNumberLess("num", 50)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected value to be smaller")
+		tspy.Close()
+
+		ent := &Entry{m: map[string]any{"num": 42.0}, t: tspy}
+
+		// --- When ---
+		have := ent.AssertNumberLess("num", 40)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_Bool_tabular(t *testing.T) {
+	tt := []struct {
+		field   string
+		wantVal bool
+		wantErr error
+	}{
+		{"bool_t", true, nil},
+		{"bool_f", false, nil},
+		{"number", false, ErrType},
+		{"missing", false, ErrMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.field, func(t *testing.T) {
+			// --- Given ---
+			tspy := tester.New(t)
+			tspy.Close()
+
+			ent := &Entry{
+				m: map[string]any{
+					"bool_t": true,
+					"bool_f": false,
+					"number": 42.0,
+				},
+				t: tspy,
+			}
+
+			// --- When ---
+			val, err := ent.Bool(tc.field)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantVal, val)
+		})
+	}
+}
+
+func Test_Entry_AssertBool(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"bool_t": true, "bool_f": false},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertBool("bool_t", true)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  field: bool_t\n" +
+			"   want: false\n" +
+			"   have: true"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{"bool_t": true, "bool_f": false},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertBool("bool_t", false)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_Time_tabular(t *testing.T) {
+	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	entTimS := entTim.Format(time.RFC3339)
+
+	tt := []struct {
+		field   string
+		wantVal time.Time
+		wantErr error
 	}{
-		{"number", 42.0, nil},
-		{"str", 0.0, ErrType},
-		{"missing", 0.0, ErrMissing},
+		{"time", entTim, nil},
+		{"format", time.Time{}, ErrFormat},
+		{"number", time.Time{}, ErrType},
+		{"missing", time.Time{}, ErrMissing},
 	}
 
 	for _, tc := range tt {
@@ -835,73 +2140,377 @@ func Test_Entry_Number_tabular(t *testing.T) {
 			tspy := tester.New(t)
 			tspy.Close()
 
-			ent := &Entry{
-				m: map[string]any{"number": 42.0, "str": "abc"},
-				t: tspy,
-			}
+			ent := &Entry{
+				cfg: DefaultConfig(),
+				m: map[string]any{
+					"time":   entTimS,
+					"format": "2000-01-01",
+					"number": 42.0,
+				},
+				t: tspy,
+			}
+
+			// --- When ---
+			have, err := ent.Time(tc.field)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantVal, have)
+		})
+	}
+}
+
+func Test_Entry_AssertTime(t *testing.T) {
+	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	entTimS := entTim.Format(time.RFC3339)
+
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertTime("time", entTim)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected equal dates:\n" +
+			"  field: time\n" +
+			"   want: 2000-01-02T03:04:06Z\n" +
+			"   have: 2000-01-02T03:04:05Z\n" +
+			"   diff: 1s"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertTime("time", entTim.Add(time.Second))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertTimeAfter(t *testing.T) {
+	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	lin := `{"time": "2000-01-02T03:04:05Z"}`
+
+	t.Run("pass", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertTimeAfter("time", entTim.Add(-time.Second))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected date to be after mark")
+		tspy.Close()
+
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertTimeAfter("time", entTim.Add(time.Second))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertTimeBefore(t *testing.T) {
+	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+	lin := `{"time": "2000-01-02T03:04:05Z"}`
+
+	t.Run("pass", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertTimeBefore("time", entTim.Add(time.Second))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected date to be before mark")
+		tspy.Close()
+
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.AssertTimeBefore("time", entTim.Add(-time.Second))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_AssertWithin(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertWithin("time", entTim, "1s")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("within", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertWithin("time", entTim.Add(time.Second), "1s")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not within", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected dates to be within:\n" +
+			"         field: time\n" +
+			"          want: 2000-01-02T04:04:05Z\n" +
+			"          have: 2000-01-02T03:04:05Z\n" +
+			"  max diff +/-: 59m59s\n" +
+			"     have diff: 1h0m0s"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertWithin("time", entTim.Add(time.Hour), "59m59s")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected map to have a key:\n" +
+			"  field: time\n" +
+			"   type: string\n" +
+			"    map: map[string]any{}"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := &Entry{
+			m: map[string]any{},
+			t: tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertWithin("time", time.Now(), "1h")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("invalid diff", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] [within] failed to parse duration:\n" +
+			"  field: time\n" +
+			"  value: abc"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
 
-			// --- When ---
-			have, err := ent.Number(tc.field)
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
+		}
 
-			// --- Then ---
-			assert.ErrorIs(t, tc.wantErr, err)
-			assert.Equal(t, tc.wantVal, have)
-		})
-	}
+		// --- When ---
+		have := ent.AssertWithin("time", entTim, "abc")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
 }
 
-func Test_Entry_AssertNumber(t *testing.T) {
+func Test_Entry_AssertLoggedWithin(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+
 		ent := &Entry{
-			m: map[string]any{"number": 42.0},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertNumber("number", 42.0)
+		have := ent.AssertLoggedWithin(entTim, "1s")
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("numeric time", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		cfg := DefaultConfig()
+		cfg.TimeUnit = time.Millisecond
+		cfg.TimeAsNumber = true
+
+		ent := &Entry{
+			cfg: cfg,
+			m:   map[string]any{"time": float64(entTim.UnixMilli() + 10)},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertLoggedWithin(entTim, "1s")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("within", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+
+		ent := &Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have := ent.AssertLoggedWithin(entTim.Add(time.Second), "1s")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not within", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		wMsg := "error checking log entry:\n" +
-			"  field: number\n" +
-			"   want: 44.1\n" +
-			"   have: 42"
+		wMsg := "" +
+			"[log entry] expected dates to be within:\n" +
+			"         field: time\n" +
+			"          want: 2000-01-02T04:04:05Z\n" +
+			"          have: 2000-01-02T03:04:05Z\n" +
+			"  max diff +/-: 59m59s\n" +
+			"     have diff: 1h0m0s"
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+
 		ent := &Entry{
-			m: map[string]any{"number": 42.0},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertNumber("number", 44.1)
+		have := ent.AssertLoggedWithin(entTim.Add(time.Hour), "59m59s")
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_Bool_tabular(t *testing.T) {
+func Test_Entry_Duration_tabular(t *testing.T) {
 	tt := []struct {
 		field   string
-		wantVal bool
+		wantVal time.Duration
 		wantErr error
 	}{
-		{"bool_t", true, nil},
-		{"bool_f", false, nil},
-		{"number", false, ErrType},
-		{"missing", false, ErrMissing},
+		{"dur", time.Second, nil},
+		{"str", 0.0, ErrFormat},
+		{"bool", 0.0, ErrType},
+		{"missing", 0.0, ErrMissing},
 	}
 
 	for _, tc := range tt {
@@ -911,37 +2520,35 @@ func Test_Entry_Bool_tabular(t *testing.T) {
 			tspy.Close()
 
 			ent := &Entry{
-				m: map[string]any{
-					"bool_t": true,
-					"bool_f": false,
-					"number": 42.0,
-				},
-				t: tspy,
+				cfg: DefaultConfig(),
+				m:   map[string]any{"dur": 1000.0, "str": "abc", "bool": true},
+				t:   tspy,
 			}
 
 			// --- When ---
-			val, err := ent.Bool(tc.field)
+			have, err := ent.Duration(tc.field)
 
 			// --- Then ---
 			assert.ErrorIs(t, tc.wantErr, err)
-			assert.Equal(t, tc.wantVal, val)
+			assert.Equal(t, tc.wantVal, have)
 		})
 	}
 }
 
-func Test_Entry_AssertBool(t *testing.T) {
+func Test_Entry_AssertDuration(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
 		ent := &Entry{
-			m: map[string]any{"bool_t": true, "bool_f": false},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": 1000.0},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertBool("bool_t", true)
+		have := ent.AssertDuration("dur", time.Second)
 
 		// --- Then ---
 		assert.True(t, have)
@@ -952,39 +2559,68 @@ func Test_Entry_AssertBool(t *testing.T) {
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
-			"  field: bool_t\n" +
-			"   want: false\n" +
-			"   have: true"
+			"[log entry] expected equal time durations:\n" +
+			"  field: dur\n" +
+			"   want: 1000 (1s)\n" +
+			"   have: 1001 (1.001s)"
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ent := &Entry{
-			m: map[string]any{"bool_t": true, "bool_f": false},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": 1001.0},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertBool("bool_t", false)
+		have := ent.AssertDuration("dur", time.Second)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_Time_tabular(t *testing.T) {
-	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-	entTimS := entTim.Format(time.RFC3339)
+func Test_Entry_AssertDurationWithin(t *testing.T) {
+	t.Run("within delta", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"dur": 1020}`).Entry(0)
+
+		// --- When ---
+		have := ent.AssertDurationWithin("dur", time.Second, 50*time.Millisecond)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not within delta", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected durations to be within the given delta")
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"dur": 1100}`).Entry(0)
+
+		// --- When ---
+		have := ent.AssertDurationWithin("dur", time.Second, 50*time.Millisecond)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
 
+func Test_Entry_Map_tabular(t *testing.T) {
 	tt := []struct {
 		field   string
-		wantVal time.Time
+		wantVal map[string]any
 		wantErr error
 	}{
-		{"time", entTim, nil},
-		{"format", time.Time{}, ErrFormat},
-		{"number", time.Time{}, ErrType},
-		{"missing", time.Time{}, ErrMissing},
+		{"map", map[string]any{"str": "abc"}, nil},
+		{"number", nil, ErrType},
+		{"missing", nil, ErrMissing},
 	}
 
 	for _, tc := range tt {
@@ -994,17 +2630,15 @@ func Test_Entry_Time_tabular(t *testing.T) {
 			tspy.Close()
 
 			ent := &Entry{
-				cfg: DefaultConfig(),
 				m: map[string]any{
-					"time":   entTimS,
-					"format": "2000-01-01",
+					"map":    map[string]any{"str": "abc"},
 					"number": 42.0,
 				},
 				t: tspy,
 			}
 
 			// --- When ---
-			have, err := ent.Time(tc.field)
+			have, err := ent.Map(tc.field)
 
 			// --- Then ---
 			assert.ErrorIs(t, tc.wantErr, err)
@@ -1013,23 +2647,19 @@ func Test_Entry_Time_tabular(t *testing.T) {
 	}
 }
 
-func Test_Entry_AssertTime(t *testing.T) {
-	entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-	entTimS := entTim.Format(time.RFC3339)
-
+func Test_Entry_AssertMap(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
+			m: map[string]any{"map": map[string]any{"str": "abc"}},
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertTime("time", entTim)
+		have := ent.AssertMap("map", map[string]any{"str": "abc"})
 
 		// --- Then ---
 		assert.True(t, have)
@@ -1040,274 +2670,320 @@ func Test_Entry_AssertTime(t *testing.T) {
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected equal dates:\n" +
-			"  field: time\n" +
-			"   want: 2000-01-02T03:04:06Z\n" +
-			"   have: 2000-01-02T03:04:05Z\n" +
-			"   diff: 1s"
+			"[log entry] expected values to be equal:\n" +
+			"  trail: map[\"str\"]\n" +
+			"   want: \"xyz\"\n" +
+			"   have: \"abc\""
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
+			m: map[string]any{"map": map[string]any{"str": "abc"}},
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertTime("time", entTim.Add(time.Second))
+		have := ent.AssertMap("map", map[string]any{"str": "xyz"})
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_AssertWithin(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_Entry_Get(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
+			m: map[string]any{"map": map[string]any{"str": "abc"}},
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertWithin("time", entTim, "1s")
+		have, err := ent.Get("map.str")
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
 	})
 
-	t.Run("within", func(t *testing.T) {
+	t.Run("error - path does not exist", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
-		}
+		ent := &Entry{m: map[string]any{}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertWithin("time", entTim.Add(time.Second), "1s")
+		have, err := ent.Get("map.str")
 
 		// --- Then ---
-		assert.True(t, have)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
 	})
+}
 
-	t.Run("not within", func(t *testing.T) {
+func Test_Entry_AssertPath(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
-		tspy.ExpectError()
-		wMsg := "" +
-			"[log entry] expected dates to be within:\n" +
-			"         field: time\n" +
-			"          want: 2000-01-02T04:04:05Z\n" +
-			"          have: 2000-01-02T03:04:05Z\n" +
-			"  max diff +/-: 59m59s\n" +
-			"     have diff: 1h0m0s"
-		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-
 		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
+			m: map[string]any{"map": map[string]any{"str": "abc"}},
+			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertWithin("time", entTim.Add(time.Hour), "59m59s")
+		have := ent.AssertPath("map.str", "abc")
 
 		// --- Then ---
-		assert.False(t, have)
+		assert.True(t, have)
 	})
 
-	t.Run("missing", func(t *testing.T) {
+	t.Run("not equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected map to have a key:\n" +
-			"  field: time\n" +
-			"   type: string\n" +
-			"    map: map[string]any{}"
+			"[log entry] expected values to be equal:\n" +
+			"  path: map.str\n" +
+			"  want: \"xyz\"\n" +
+			"  have: \"abc\""
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		ent := &Entry{
-			m: map[string]any{},
+			m: map[string]any{"map": map[string]any{"str": "abc"}},
 			t: tspy,
 		}
 
 		// --- When ---
-		have := ent.AssertWithin("time", time.Now(), "1h")
+		have := ent.AssertPath("map.str", "xyz")
 
 		// --- Then ---
 		assert.False(t, have)
 	})
+}
 
-	t.Run("invalid diff", func(t *testing.T) {
+func Test_Entry_Int(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := MustEntries(tspy, `{"int": 123}`).Entry(0)
+
+	// --- When ---
+	have, err := ent.Int("int")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, 123, have)
+}
+
+func Test_Entry_AssertInt(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"int": 123}`).Entry(0)
+
+		// --- When ---
+		have := ent.AssertInt("int", 123)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("not equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] [within] failed to parse duration:\n" +
-			"  field: time\n" +
-			"  value: abc"
+			"[log entry] expected values to be equal:\n" +
+			"  field: int\n" +
+			"   want: 124\n" +
+			"   have: 123"
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, `{"int": 123}`).Entry(0)
 
 		// --- When ---
-		have := ent.AssertWithin("time", entTim, "abc")
+		have := ent.AssertInt("int", 124)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_AssertLoggedWithin(t *testing.T) {
+func Test_Entry_Int64(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := MustEntries(tspy, `{"int": 9007199254740993}`).Entry(0)
+
+	// --- When ---
+	have, err := ent.Int64("int")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), have)
+}
+
+func Test_Entry_AssertInt64(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, `{"int": 9007199254740993}`).Entry(0)
 
 		// --- When ---
-		have := ent.AssertLoggedWithin(entTim, "1s")
+		have := ent.AssertInt64("int", 9007199254740993)
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("within", func(t *testing.T) {
+	t.Run("not equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected values to be equal")
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
+		ent := MustEntries(tspy, `{"int": 9007199254740993}`).Entry(0)
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
-		}
+		// --- When ---
+		have := ent.AssertInt64("int", 9007199254740992)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_Uint64(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := MustEntries(tspy, `{"int": 18446744073709551615}`).Entry(0)
+
+	// --- When ---
+	have, err := ent.Uint64("int")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(18446744073709551615), have)
+}
+
+func Test_Entry_AssertUint64(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"int": 18446744073709551615}`).Entry(0)
 
 		// --- When ---
-		have := ent.AssertLoggedWithin(entTim.Add(time.Second), "1s")
+		have := ent.AssertUint64("int", 18446744073709551615)
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("not within", func(t *testing.T) {
+	t.Run("not a uint64", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		wMsg := "" +
-			"[log entry] expected dates to be within:\n" +
-			"         field: time\n" +
-			"          want: 2000-01-02T04:04:05Z\n" +
-			"          have: 2000-01-02T03:04:05Z\n" +
-			"  max diff +/-: 59m59s\n" +
-			"     have diff: 1h0m0s"
-		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectLogContain("expected log entry field to fit the type")
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS},
-			t:   tspy,
-		}
+		ent := MustEntries(tspy, `{"int": -1}`).Entry(0)
 
 		// --- When ---
-		have := ent.AssertLoggedWithin(entTim.Add(time.Hour), "59m59s")
+		have := ent.AssertUint64("int", 1)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_Duration_tabular(t *testing.T) {
-	tt := []struct {
-		field   string
-		wantVal time.Duration
-		wantErr error
-	}{
-		{"dur", time.Second, nil},
-		{"str", 0.0, ErrType},
-		{"missing", 0.0, ErrMissing},
-	}
+func Test_Entry_JSONNumber(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
 
-	for _, tc := range tt {
-		t.Run(tc.field, func(t *testing.T) {
-			// --- Given ---
-			tspy := tester.New(t)
-			tspy.Close()
+	ent := MustEntries(tspy, `{"num": 9007199254740993}`).Entry(0)
 
-			ent := &Entry{
-				cfg: DefaultConfig(),
-				m:   map[string]any{"dur": 1000.0, "str": "abc"},
-				t:   tspy,
-			}
+	// --- When ---
+	have, err := ent.JSONNumber("num")
 
-			// --- When ---
-			have, err := ent.Duration(tc.field)
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("9007199254740993"), have)
+}
 
-			// --- Then ---
-			assert.ErrorIs(t, tc.wantErr, err)
-			assert.Equal(t, tc.wantVal, have)
-		})
-	}
+func Test_Entry_Slice(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := &Entry{m: map[string]any{"arr": []any{"abc", 1.0}}, t: tspy}
+
+	// --- When ---
+	have, err := ent.Slice("arr")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"abc", 1.0}, have)
 }
 
-func Test_Entry_AssertDuration(t *testing.T) {
+func Test_Entry_StrSlice(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := &Entry{m: map[string]any{"arr": []any{"abc", "def"}}, t: tspy}
+
+	// --- When ---
+	have, err := ent.StrSlice("arr")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"abc", "def"}, have)
+}
+
+func Test_Entry_NumSlice(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := &Entry{m: map[string]any{"arr": []any{1.0, 2.0}}, t: tspy}
+
+	// --- When ---
+	have, err := ent.NumSlice("arr")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2}, have)
+}
+
+func Test_Entry_AssertSliceLen(t *testing.T) {
 	t.Run("equal", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"dur": 1000.0},
-			t:   tspy,
-		}
+		ent := &Entry{m: map[string]any{"arr": []any{"abc"}}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertDuration("dur", time.Second)
+		have := ent.AssertSliceLen("arr", 1)
 
 		// --- Then ---
 		assert.True(t, have)
@@ -1318,99 +2994,49 @@ func Test_Entry_AssertDuration(t *testing.T) {
 		tspy := tester.New(t)
 		tspy.ExpectError()
 		wMsg := "" +
-			"[log entry] expected equal time durations:\n" +
-			"  field: dur\n" +
-			"   want: 1000 (1s)\n" +
-			"   have: 1001 (1.001s)"
+			"[log entry] expected array to have length:\n" +
+			"  field: arr\n" +
+			"   want: 2\n" +
+			"   have: 1"
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		ent := &Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"dur": 1001.0},
-			t:   tspy,
-		}
+		ent := &Entry{m: map[string]any{"arr": []any{"abc"}}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertDuration("dur", time.Second)
+		have := ent.AssertSliceLen("arr", 2)
 
 		// --- Then ---
 		assert.False(t, have)
 	})
 }
 
-func Test_Entry_Map_tabular(t *testing.T) {
-	tt := []struct {
-		field   string
-		wantVal map[string]any
-		wantErr error
-	}{
-		{"map", map[string]any{"str": "abc"}, nil},
-		{"number", nil, ErrType},
-		{"missing", nil, ErrMissing},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.field, func(t *testing.T) {
-			// --- Given ---
-			tspy := tester.New(t)
-			tspy.Close()
-
-			ent := &Entry{
-				m: map[string]any{
-					"map":    map[string]any{"str": "abc"},
-					"number": 42.0,
-				},
-				t: tspy,
-			}
-
-			// --- When ---
-			have, err := ent.Map(tc.field)
-
-			// --- Then ---
-			assert.ErrorIs(t, tc.wantErr, err)
-			assert.Equal(t, tc.wantVal, have)
-		})
-	}
-}
-
-func Test_Entry_AssertMap(t *testing.T) {
-	t.Run("equal", func(t *testing.T) {
+func Test_Entry_AssertSliceContains(t *testing.T) {
+	t.Run("contains", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"map": map[string]any{"str": "abc"}},
-			t: tspy,
-		}
+		ent := &Entry{m: map[string]any{"arr": []any{"abc"}}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertMap("map", map[string]any{"str": "abc"})
+		have := ent.AssertSliceContains("arr", "abc")
 
 		// --- Then ---
 		assert.True(t, have)
 	})
 
-	t.Run("not equal", func(t *testing.T) {
+	t.Run("does not contain", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
-		wMsg := "" +
-			"[log entry] expected values to be equal:\n" +
-			"  trail: map[\"str\"]\n" +
-			"   want: \"xyz\"\n" +
-			"   have: \"abc\""
-		tspy.ExpectLogEqual(wMsg)
+		tspy.ExpectLogContain("[log entry] expected array to contain the value")
 		tspy.Close()
 
-		ent := &Entry{
-			m: map[string]any{"map": map[string]any{"str": "abc"}},
-			t: tspy,
-		}
+		ent := &Entry{m: map[string]any{"arr": []any{"abc"}}, t: tspy}
 
 		// --- When ---
-		have := ent.AssertMap("map", map[string]any{"str": "xyz"})
+		have := ent.AssertSliceContains("arr", "xyz")
 
 		// --- Then ---
 		assert.False(t, have)
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Expect represents a chain of log entry checks which accumulates the
+// failures and reports them together, see [Entry.Expect].
+type Expect struct {
+	ent Entry   // Checked log entry.
+	ers []error // Errors of the failed checks.
+}
+
+// Expect returns a new [Expect] for the log entry. The chained checks are
+// run immediately and their failures are reported together when
+// [Expect.Done] is called.
+//
+// Example usage:
+//
+//	ent.Expect().
+//	    Level("info").
+//	    Msg("saved").
+//	    Str("user", "bob").
+//	    Number("count", 3).
+//	    Done()
+func (ent Entry) Expect() *Expect {
+	return &Expect{ent: ent}
+}
+
+// Check runs the check on the log entry.
+func (exp *Expect) Check(chk Checker) *Expect {
+	if err := chk(exp.ent); err != nil {
+		exp.ers = append(exp.ers, err)
+	}
+	return exp
+}
+
+// Level checks the log entry level, see [CheckLevel].
+func (exp *Expect) Level(want string) *Expect {
+	return exp.Check(CheckLevel(want))
+}
+
+// Msg checks the log entry message, see [CheckMsg].
+func (exp *Expect) Msg(want string) *Expect {
+	return exp.Check(CheckMsg(want))
+}
+
+// Error checks the log entry error message, see [CheckStr].
+func (exp *Expect) Error(want string) *Expect {
+	return exp.Check(CheckStr(exp.ent.cfg.ErrorField, want))
+}
+
+// Str checks the string field, see [CheckStr].
+func (exp *Expect) Str(field, want string) *Expect {
+	return exp.Check(CheckStr(field, want))
+}
+
+// Contain checks the string field contains the value, see [CheckContain].
+func (exp *Expect) Contain(field, want string) *Expect {
+	return exp.Check(CheckContain(field, want))
+}
+
+// Number checks the number field, see [CheckNumber].
+func (exp *Expect) Number(field string, want float64) *Expect {
+	return exp.Check(CheckNumber(field, want))
+}
+
+// Int checks the integer field, see [CheckInt].
+func (exp *Expect) Int(field string, want int) *Expect {
+	return exp.Check(CheckInt(field, want))
+}
+
+// Bool checks the boolean field, see [CheckBool].
+func (exp *Expect) Bool(field string, want bool) *Expect {
+	return exp.Check(CheckBool(field, want))
+}
+
+// Time checks the time field, see [CheckTime].
+func (exp *Expect) Time(field string, want time.Time) *Expect {
+	return exp.Check(CheckTime(field, want))
+}
+
+// Duration checks the duration field, see [CheckDuration].
+func (exp *Expect) Duration(field string, want time.Duration) *Expect {
+	return exp.Check(CheckDuration(field, want))
+}
+
+// Path checks the value at the path, see [CheckPath].
+func (exp *Expect) Path(path string, want any) *Expect {
+	return exp.Check(CheckPath(path, want))
+}
+
+// Absent checks the field is not present, see [CheckAbsent].
+func (exp *Expect) Absent(field string) *Expect {
+	return exp.Check(CheckAbsent(field))
+}
+
+// Err returns the errors of the failed checks joined, or nil if all the
+// checks passed. It never marks the test as failed.
+func (exp *Expect) Err() error {
+	return errors.Join(exp.ers...)
+}
+
+// Done reports the failures of the chained checks. Returns true if all the
+// checks passed. If not, it marks the test as failed, logs an error message
+// with all the failures, and returns false.
+func (exp *Expect) Done() bool {
+	exp.ent.t.Helper()
+	if len(exp.ers) == 0 {
+		return true
+	}
+	exp.ent.t.Error(notice.Join(exp.ers...))
+	return false
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Entry_Expect(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{
+			"time": "2000-01-02T03:04:05Z",
+			"level": "info",
+			"message": "saved",
+			"error": "boom",
+			"user": "bob",
+			"count": 3,
+			"ok": true,
+			"took": 1500,
+			"req": {"id": "A"}
+		}`).Entry(0)
+
+		// --- When ---
+		have := ent.Expect().
+			Level("info").
+			Msg("saved").
+			Error("boom").
+			Str("user", "bob").
+			Contain("user", "o").
+			Number("count", 3).
+			Int("count", 3).
+			Bool("ok", true).
+			Time("time", time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)).
+			Duration("took", 1500*time.Millisecond).
+			Path("req.id", "A").
+			Absent("other").
+			Done()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - failures reported together", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"multiple expectations violated:\n" +
+			"  error: [log entry] expected values to be equal\n" +
+			"  field: level\n" +
+			"   want: \"error\"\n" +
+			"   have: \"info\"\n" +
+			"      ---\n" +
+			"  error: [log entry] expected values to be equal\n" +
+			"  field: user\n" +
+			"   want: \"alice\"\n" +
+			"   have: \"bob\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		lin := `{"level": "info", "message": "saved", "user": "bob"}`
+		ent := MustEntries(tspy, lin).Entry(0)
+
+		// --- When ---
+		have := ent.Expect().
+			Level("error").
+			Msg("saved").
+			Str("user", "alice").
+			Done()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Expect_Err(t *testing.T) {
+	t.Run("no errors", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info"}`).Entry(0)
+
+		// --- When ---
+		err := ent.Expect().Level("info").Err()
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": "info"}`).Entry(0)
+
+		// --- When ---
+		err := ent.Expect().Level("error").Str("user", "bob").Err()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"maps"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// GoldenUpdateEnv is the name of the environment variable which, when set to
+// a true value, makes [Tester.AssertGolden] rewrite golden files.
+const GoldenUpdateEnv = "LOGKIT_UPDATE"
+
+// GoldenMaskValue is the value used for masked fields in golden files.
+const GoldenMaskValue = "[MASKED]"
+
+// GoldenOptions represents options for [Tester.AssertGolden].
+type GoldenOptions struct {
+	mask  []string                 // Names of the masked fields.
+	norms []func(m map[string]any) // Log entry normalizers.
+}
+
+// WithGoldenMask is an option for [Tester.AssertGolden] which replaces the
+// values of the given fields with [GoldenMaskValue] before comparison. It is
+// useful for fields which change between test runs like timestamps.
+func WithGoldenMask(fields ...string) func(*GoldenOptions) {
+	return func(opts *GoldenOptions) {
+		opts.mask = append(opts.mask, fields...)
+	}
+}
+
+// WithGoldenNormalizer is an option for [Tester.AssertGolden] which adds a
+// function normalizing decoded log entries before comparison. Normalizers
+// are called in the order they were added, after masking the fields.
+func WithGoldenNormalizer(fn func(m map[string]any)) func(*GoldenOptions) {
+	return func(opts *GoldenOptions) { opts.norms = append(opts.norms, fn) }
+}
+
+// AssertGolden asserts that the log entries match the golden file at the
+// given path. The golden file contains one normalized JSON log entry per
+// line. Before comparison, the [Config.RedactFields] fields are redacted and
+// the options are applied to the log entries from both the golden file and
+// the [Tester].
+//
+// When the "update" flag is defined and set, or the [GoldenUpdateEnv]
+// environment variable is set to a true value, the golden file is rewritten
+// with the normalized log entries instead. The existing golden file keeps its
+// mode, the new one is created readable by everyone. Note that the "update"
+// flag must be defined by the test package.
+//
+// Returns true if the log entries match. If not, it marks the test as failed,
+// logs an error message, and returns false.
+//
+// Example usage:
+//
+//	tst.AssertGolden("testdata/run.golden", logkit.WithGoldenMask("time"))
+func (tst *Tester) AssertGolden(pth string, opts ...func(*GoldenOptions)) bool {
+	tst.t.Helper()
+
+	ops := &GoldenOptions{}
+	for _, opt := range opts {
+		opt(ops)
+	}
+
+	ets := tst.Entries()
+	have := make([]string, 0, len(ets.ets))
+	for _, ent := range ets.ets {
+		have = append(have, goldenLine(tst.cfg, ops, ent.m))
+	}
+
+	if goldenUpdate() {
+		data := strings.Join(have, "\n")
+		if len(have) > 0 {
+			data += "\n"
+		}
+		if err := os.WriteFile(pth, []byte(data), 0o644); err != nil {
+			tst.t.Error(err)
+			return false
+		}
+		return true
+	}
+
+	want, err := readGolden(tst.cfg, ops, pth)
+	if err != nil {
+		tst.t.Error(err)
+		return false
+	}
+
+	var failed bool
+	for i := 0; i < min(len(want), len(have)); i++ {
+		if err = check.JSON(want[i], have[i]); err != nil {
+			msg := notice.From(err, "log entry").
+				Prepend("index", "%d", i).
+				Prepend("golden", "%s", pth)
+			tst.t.Error(msg)
+			failed = true
+		}
+	}
+	if failed {
+		return false
+	}
+
+	if len(want) != len(have) {
+		msg := notice.New("[log entry] expected N log entries").
+			Append("golden", "%s", pth).
+			Want("%d", len(want)).
+			Have("%d", len(have))
+		tst.t.Error(msg)
+		return false
+	}
+	return true
+}
+
+// readGolden reads the golden file and returns its normalized log entries.
+func readGolden(cfg *Config, ops *GoldenOptions, pth string) ([]string, error) {
+	data, err := os.ReadFile(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	var idx int
+	scn := bufio.NewScanner(bytes.NewReader(data))
+	for scn.Scan() {
+		line := bytes.TrimSpace(scn.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var m map[string]any
+		if err = json.Unmarshal(line, &m); err != nil {
+			msg := notice.New("[log entry] expected golden file to have valid JSON").
+				Append("golden", "%s", pth).
+				Append("index", "%d", idx).
+				Append("error", "%s", err)
+			return nil, msg.Wrap(ErrFormat)
+		}
+		lines = append(lines, goldenLine(cfg, ops, m))
+		idx++
+	}
+	return lines, scn.Err()
+}
+
+// goldenLine returns the normalized log entry as a JSON string. The keys are
+// sorted, so the result does not depend on the original field order.
+func goldenLine(cfg *Config, ops *GoldenOptions, m map[string]any) string {
+	m = maps.Clone(m)
+	for _, field := range cfg.RedactFields {
+		if _, ok := m[field]; ok {
+			m[field] = RedactedValue
+		}
+	}
+	for _, field := range ops.mask {
+		if _, ok := m[field]; ok {
+			m[field] = GoldenMaskValue
+		}
+	}
+	for _, fn := range ops.norms {
+		fn(m)
+	}
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(m) // Decoded JSON objects always encode.
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// goldenUpdate returns true when golden files should be rewritten.
+func goldenUpdate() bool {
+	if f := flag.Lookup("update"); f != nil {
+		if ok, _ := strconv.ParseBool(f.Value.String()); ok {
+			return true
+		}
+	}
+	ok, _ := strconv.ParseBool(os.Getenv(GoldenUpdateEnv))
+	return ok
+}
@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// update defines the flag used by [Tester.AssertGolden].
+var update = flag.Bool("update", false, "update golden files")
+
+func Test_WithGoldenMask(t *testing.T) {
+	// --- Given ---
+	ops := &GoldenOptions{}
+
+	// --- When ---
+	WithGoldenMask("A", "B")(ops)
+	WithGoldenMask("C")(ops)
+
+	// --- Then ---
+	assert.Equal(t, []string{"A", "B", "C"}, ops.mask)
+}
+
+func Test_WithGoldenNormalizer(t *testing.T) {
+	// --- Given ---
+	ops := &GoldenOptions{}
+
+	// --- When ---
+	WithGoldenNormalizer(func(m map[string]any) {})(ops)
+
+	// --- Then ---
+	assert.Len(t, 1, ops.norms)
+}
+
+func Test_Tester_AssertGolden(t *testing.T) {
+	const lin0 = `{"level":"info","message":"msg0","time":"2024-01-02T03:04:05Z"}`
+	const lin1 = `{"level":"warn","message":"msg1","time":"2024-01-02T03:04:06Z"}`
+
+	t.Run("match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"+lin1+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden("testdata/golden.jsonl", WithGoldenMask("time"))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("match with normalizer", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"+lin1+"\n"))
+		norm := func(m map[string]any) { delete(m, "time") }
+
+		// --- When ---
+		have := tst.AssertGolden(
+			"testdata/golden.jsonl",
+			WithGoldenNormalizer(norm),
+		)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected JSON strings to be equal:\n" +
+			"  golden: testdata/golden.jsonl\n" +
+			"   index: 0\n" +
+			"    want: {\"level\":\"info\",\"message\":\"msg0\",\"time\":\"2000-01-02T03:04:05Z\"}\n" +
+			"    have: {\"level\":\"info\",\"message\":\"msg0\",\"time\":\"2024-01-02T03:04:05Z\"}"
+		tspy.ExpectLogContain(wMsg)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"+lin1+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden("testdata/golden.jsonl")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - different number of entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected N log entries:\n" +
+			"  golden: testdata/golden.jsonl\n" +
+			"    want: 2\n" +
+			"    have: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden("testdata/golden.jsonl", WithGoldenMask("time"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - golden file does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "open testdata/not_existing.jsonl: no such file or directory"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden("testdata/not_existing.jsonl")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - golden file invalid JSON", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected golden file to have valid JSON")
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden("testdata/baseline.txt")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("update with environment variable", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(GoldenUpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "run.jsonl")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"+lin1+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden(pth, WithGoldenMask("time"))
+
+		// --- Then ---
+		assert.True(t, have)
+		want := "" +
+			`{"level":"info","message":"msg0","time":"[MASKED]"}` + "\n" +
+			`{"level":"warn","message":"msg1","time":"[MASKED]"}` + "\n"
+		assert.Equal(t, want, string(must.Value(os.ReadFile(pth))))
+		fi := must.Value(os.Stat(pth))
+		assert.Equal(t, os.FileMode(0o044), fi.Mode().Perm()&0o044)
+	})
+
+	t.Run("update keeps existing file mode", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(GoldenUpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "run.jsonl")
+		must.Nil(os.WriteFile(pth, nil, 0o600))
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden(pth)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, lin0+"\n", string(must.Value(os.ReadFile(pth))))
+		fi := must.Value(os.Stat(pth))
+		assert.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+	})
+
+	t.Run("update with flag", func(t *testing.T) {
+		// --- Given ---
+		prev := *update
+		*update = true
+		t.Cleanup(func() { *update = prev })
+		pth := filepath.Join(t.TempDir(), "run.jsonl")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden(pth)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, lin0+"\n", string(must.Value(os.ReadFile(pth))))
+	})
+
+	t.Run("error - update", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(GoldenUpdateEnv, "1")
+		pth := filepath.Join(t.TempDir(), "not_existing", "run.jsonl")
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("no such file or directory")
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+
+		// --- When ---
+		have := tst.AssertGolden(pth)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_goldenLine(t *testing.T) {
+	t.Run("sorted keys", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"B": 2.0, "A": "<a>"}
+
+		// --- When ---
+		have := goldenLine(DefaultConfig(), &GoldenOptions{}, m)
+
+		// --- Then ---
+		assert.Equal(t, `{"A":"<a>","B":2}`, have)
+	})
+
+	t.Run("redacted and masked", func(t *testing.T) {
+		// --- Given ---
+		cfg := DefaultConfig()
+		cfg.RedactFields = []string{"password", "token"}
+		ops := &GoldenOptions{mask: []string{"time", "pid"}}
+		m := map[string]any{"password": "secret", "time": "now", "A": 1.0}
+
+		// --- When ---
+		have := goldenLine(cfg, ops, m)
+
+		// --- Then ---
+		want := `{"A":1,"password":"[REDACTED]","time":"[MASKED]"}`
+		assert.Equal(t, want, have)
+		assert.Equal(t, "secret", m["password"])
+	})
+}
+
+func Test_goldenUpdate(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(GoldenUpdateEnv, "")
+
+		// --- When ---
+		have := goldenUpdate()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		// --- Given ---
+		t.Setenv(GoldenUpdateEnv, "true")
+
+		// --- When ---
+		have := goldenUpdate()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+}
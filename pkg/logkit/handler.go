@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"net/http"
+	"time"
+)
+
+// Handler returns an [http.Handler] exposing the log entries written to the
+// [Tester], so out-of-process test orchestrators can poll them. It serves:
+//
+//   - GET /entries - the log entries as newline-delimited JSON,
+//   - GET /wait - the first log entry, waiting for it to be written.
+//
+// Both endpoints take optional "checks" query parameters, each being a query
+// expression, see [ParseQuery], which all must pass for the log entry to be
+// returned. The /wait endpoint also takes the "timeout" parameter, five
+// seconds by default, and responds with the 408 status code when no matching
+// log entry is written within the timeout. Invalid parameters are responded
+// with the 400 status code. Unlike [Tester.WaitFor], the handler doesn't
+// mark the test as failed when the matching log entry is not written.
+//
+// Example usage:
+//
+//	srv := httptest.NewServer(tst.Handler())
+//	defer srv.Close()
+//	// GET srv.URL + `/wait?checks=level == "error"&timeout=10s`
+func (tst *Tester) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /entries", tst.serveEntries)
+	mux.HandleFunc("GET /wait", tst.serveWait)
+	return mux
+}
+
+// serveEntries responds with the log entries passing the checks.
+func (tst *Tester) serveEntries(w http.ResponseWriter, r *http.Request) {
+	checks, err := httpChecks(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tst.mx.RLock()
+	ets := tst.entries().filter(checks...)
+	tst.mx.RUnlock()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	for _, ent := range ets.ets {
+		_, _ = w.Write([]byte(ent.raw + "\n"))
+	}
+}
+
+// serveWait responds with the first log entry passing the checks, waiting
+// for it to be written.
+func (tst *Tester) serveWait(w http.ResponseWriter, r *http.Request) {
+	checks, err := httpChecks(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	timeout := r.URL.Query().Get("timeout")
+	if timeout == "" {
+		timeout = "5s"
+	}
+	to, err := time.ParseDuration(timeout)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tst.mx.Lock()
+	for _, ent := range tst.entries().Get() {
+		if runChecks(ent, checks...) {
+			tst.mx.Unlock()
+			serveEntry(w, ent)
+			return
+		}
+	}
+	mcr := NewMatcher(tst.t, tst.cfg, checks...)
+	found := mcr.NotifyBuffered(1)
+	tst.watch = append(tst.watch, mcr)
+	tst.mx.Unlock()
+	defer mcr.Discard()
+
+	timer := time.NewTimer(to)
+	defer timer.Stop()
+	select {
+	case ent := <-found:
+		if !ent.IsZero() {
+			serveEntry(w, ent)
+			return
+		}
+	case <-timer.C:
+	case <-r.Context().Done():
+		return
+	}
+	msg := "timeout waiting for log entry reached"
+	http.Error(w, msg, http.StatusRequestTimeout)
+}
+
+// serveEntry responds with the log entry.
+func serveEntry(w http.ResponseWriter, ent Entry) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(ent.raw + "\n"))
+}
+
+// httpChecks returns the checks parsed from the "checks" query parameters of
+// the request, see [ParseQuery].
+func httpChecks(r *http.Request) ([]Checker, error) {
+	var checks []Checker
+	for _, query := range r.URL.Query()["checks"] {
+		chk, err := ParseQuery(query)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, chk)
+	}
+	return checks, nil
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// serve serves the GET request with the query parameters using the handler
+// and returns the response.
+func serve(hnd http.Handler, pth string, params url.Values) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, pth+"?"+params.Encode(), nil)
+	rec := httptest.NewRecorder()
+	hnd.ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+// readBody returns the response body.
+func readBody(res *http.Response) string {
+	defer func() { _ = res.Body.Close() }()
+	return string(must.Value(io.ReadAll(res.Body)))
+}
+
+func Test_Tester_Handler(t *testing.T) {
+	const lin0 = `{"level":"info","message":"msg0"}`
+	const lin1 = `{"level":"error","message":"msg1"}`
+	const lin2 = `{"level":"error","message":"msg2"}`
+
+	t.Run("entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"+lin1+"\n"))
+
+		// --- When ---
+		res := serve(tst.Handler(), "/entries", nil)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+		assert.Equal(t, lin0+"\n"+lin1+"\n", readBody(res))
+	})
+
+	t.Run("entries with checks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"+lin1+"\n"+lin2+"\n"))
+		params := url.Values{
+			"checks": {`level == "error"`, `message != "msg1"`},
+		}
+
+		// --- When ---
+		res := serve(tst.Handler(), "/entries", params)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, lin2+"\n", readBody(res))
+	})
+
+	t.Run("wait for logged entry", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"+lin1+"\n"))
+		params := url.Values{"checks": {`level == "error"`}}
+
+		// --- When ---
+		res := serve(tst.Handler(), "/wait", params)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+		assert.Equal(t, lin1+"\n", readBody(res))
+	})
+
+	t.Run("wait for entry to be written", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+		params := url.Values{
+			"checks":  {`level == "error"`},
+			"timeout": {"1s"},
+		}
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = tst.Write([]byte(lin1 + "\n"))
+		}()
+
+		// --- When ---
+		res := serve(tst.Handler(), "/wait", params)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, lin1+"\n", readBody(res))
+		assert.True(t, tst.watch[0].Discarded())
+		assert.Equal(t, -1, tst.matchIdx)
+	})
+
+	t.Run("error - wait timeout", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := New(tspy, WithString(lin0+"\n"))
+		params := url.Values{
+			"checks":  {`level == "error"`},
+			"timeout": {"10ms"},
+		}
+
+		// --- When ---
+		res := serve(tst.Handler(), "/wait", params)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusRequestTimeout, res.StatusCode)
+		want := "timeout waiting for log entry reached\n"
+		assert.Equal(t, want, readBody(res))
+	})
+
+	t.Run("error - invalid timeout", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		params := url.Values{"timeout": {"abc"}}
+
+		// --- When ---
+		res := serve(tst.Handler(), "/wait", params)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		want := "time: invalid duration \"abc\"\n"
+		assert.Equal(t, want, readBody(res))
+	})
+
+	t.Run("error - invalid checks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		params := url.Values{"checks": {`level ==`}}
+
+		// --- When ---
+		res := serve(tst.Handler(), "/entries", params)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.Contain(t, "reason: unexpected end", readBody(res))
+	})
+
+	t.Run("error - method not allowed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		req := httptest.NewRequest(http.MethodPost, "/entries", nil)
+		rec := httptest.NewRecorder()
+
+		// --- When ---
+		tst.Handler().ServeHTTP(rec, req)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
@@ -4,19 +4,38 @@
 package logkit
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ctx42/testing/pkg/check"
 	"github.com/ctx42/testing/pkg/notice"
 )
 
+// hasKey returns the value of the field with error from [check.HasKey] if
+// the field is missing. When the field name has dots and there is no field
+// with such name, it is used as a path to the nested objects, see [HasPath],
+// so names like "error.message" work with both flat and nested log entries.
+func hasKey(ent Entry, field string) (any, error) {
+	val, err := check.HasKey(field, ent.m)
+	if err != nil && strings.Contains(field, ".") {
+		if nested, pErr := HasPath(ent, field); pErr == nil {
+			return nested, nil
+		}
+	}
+	return val, err
+}
+
 // HasBool checks if the specified boolean field exists in the Entry's map of
 // fields. If the field is missing, it returns false, and the error has
 // [ErrMissing] in its chain. If the field exists but its value is not of
 // type bool, it returns false and error having [ErrType] in its chain.
 // Otherwise, it returns the boolean value of the field and a nil error.
 func HasBool(ent Entry, field string) (bool, error) {
-	val, err := check.HasKey(field, ent.m)
+	val, err := hasKey(ent, field)
 	if err != nil {
 		return false, notice.From(err, "log entry").
 			Prepend("type", "%T", true).
@@ -38,7 +57,7 @@ func HasBool(ent Entry, field string) (bool, error) {
 // type string, it returns an empty string and error having [ErrType] in its
 // chain. Otherwise, it returns the string value of the field and a nil error.
 func HasStr(ent Entry, field string) (string, error) {
-	val, err := check.HasKey(field, ent.m)
+	val, err := hasKey(ent, field)
 	if err != nil {
 		return "", notice.From(err, "log entry").
 			Prepend("type", "%T", "").
@@ -54,16 +73,138 @@ func HasStr(ent Entry, field string) (string, error) {
 	return val.(string), nil // nolint: forcetypeassert
 }
 
+// HasLevel checks if the [Config.LevelField] field exists in the Entry's map
+// of fields and returns its value as a level. When [Config.LevelParser] is not
+// set, it works exactly as [HasStr]. Otherwise, the field value is passed to
+// the parser, and if it returns an error, it returns an empty string and
+// error having [ErrFormat] in its chain. Otherwise, it returns the level and
+// a nil error.
+func HasLevel(ent Entry) (string, error) {
+	field := ent.cfg.LevelField
+	if ent.cfg.LevelParser == nil {
+		return HasStr(ent, field)
+	}
+	val, err := hasKey(ent, field)
+	if err != nil {
+		return "", notice.From(err, "log entry").
+			Prepend("field", "%s", field).
+			Remove("key").
+			Wrap(ErrMissing)
+	}
+	have, err := ent.cfg.LevelParser(val)
+	if err != nil {
+		format := "[log entry] expected log entry field to have a valid level"
+		return "", notice.New(format).
+			Append("field", "%s", field).
+			Append("value", "%v", val).
+			Append("error", "%s", err).
+			Wrap(ErrFormat)
+	}
+	return have, nil
+}
+
+// HasCaller checks if the specified caller field exists in the Entry's map
+// of fields. The value may be a string in the "file:line" format or an object
+// with "file", "line" and "function" (or "func") fields, as logged by
+// `log/slog` and `bunyan`, or with "file" object having "name" and "line"
+// fields, as logged by ECS loggers. If the field is missing, it returns zero
+// value caller and error having [ErrMissing] in its chain. If the field
+// exists but its value is not a string or an object with a string "file"
+// field, it returns zero value caller and error having [ErrType] in its
+// chain. Otherwise, it returns the parsed caller and a nil error.
+func HasCaller(ent Entry, field string) (Caller, error) {
+	val, err := hasKey(ent, field)
+	if err != nil {
+		return Caller{}, notice.From(err, "log entry").
+			Prepend("type", "%T", "").
+			Prepend("field", "%s", field).
+			Remove("key").
+			Wrap(ErrMissing)
+	}
+	switch v := val.(type) {
+	case string:
+		return parseCaller(v), nil
+
+	case map[string]any:
+		loc := v
+		if file, ok := v["file"].(map[string]any); ok {
+			loc = map[string]any{"file": file["name"], "line": file["line"]}
+		}
+		if file, ok := loc["file"].(string); ok {
+			cll := Caller{File: file}
+			if line, ok := loc["line"].(float64); ok {
+				cll.Line = int(line)
+			}
+			if cll.Func, ok = v["function"].(string); !ok {
+				cll.Func, _ = v["func"].(string)
+			}
+			return cll, nil
+		}
+	}
+	mHeader := "[log entry] expected log entry field to be a caller"
+	return Caller{}, notice.New(mHeader).
+		Append("field", "%s", field).
+		Append("type", "%T", val).
+		Wrap(ErrType)
+}
+
+// HasStack checks if the specified stack trace field exists in the Entry's
+// map of fields and returns its frames, the innermost first. The value may be
+// a string in the [runtime/debug.Stack] format, as logged by `zap`, or an
+// array of frame objects, as logged by `zerolog` with the pkgerrors stack
+// marshaller. If the field is missing, it returns nil and error having
+// [ErrMissing] in its chain. If the field exists but its value is not a
+// stack trace, it returns nil and error having [ErrType] in its chain.
+// Otherwise, it returns the stack frames and a nil error.
+func HasStack(ent Entry, field string) ([]Caller, error) {
+	val, err := hasKey(ent, field)
+	if err != nil {
+		return nil, notice.From(err, "log entry").
+			Prepend("type", "%T", "").
+			Prepend("field", "%s", field).
+			Remove("key").
+			Wrap(ErrMissing)
+	}
+	switch v := val.(type) {
+	case string:
+		return parseStack(v), nil
+
+	case []any:
+		frames := make([]Caller, 0, len(v))
+		for i, elm := range v {
+			cll, ok := parseFrame(elm)
+			if !ok {
+				mHeader := "[log entry] expected stack trace frame"
+				return nil, notice.New(mHeader).
+					Append("field", "%s", field).
+					Append("index", "%d", i).
+					Append("type", "%T", elm).
+					Wrap(ErrType)
+			}
+			frames = append(frames, cll)
+		}
+		return frames, nil
+	}
+	mHeader := "[log entry] expected log entry field to be a stack trace"
+	return nil, notice.New(mHeader).
+		Append("field", "%s", field).
+		Append("type", "%T", val).
+		Wrap(ErrType)
+}
+
 // HasTime checks if the specified string field exists in the Entry's map of
 // fields. If the field is missing, it returns zero value time and error having
-// [ErrMissing] in its chain. If the field exists but its value is not of
-// type string, it returns zero value time and error having [ErrType] in its
-// chain. If the field exists but its value is not time formatted according to
+// [ErrMissing] in its chain. When [Config.TimeUnit] or [Config.TimeAsNumber]
+// is set and the field value is a number, it returns the time that many units
+// since the Unix epoch. If the field exists but its value is not of type
+// string, or is not a number when [Config.TimeAsNumber] is set, it returns
+// zero value time and error having [ErrType] in its chain. If the field
+// exists but its value is not time formatted according to
 // [Config.TimeFormat], it returns zero value time and error having
-// [ErrFormat] in its chain. Otherwise, it returns the string value of the
-// field and a nil error.
+// [ErrFormat] in its chain. Otherwise, it returns the parsed time and a nil
+// error.
 func HasTime(ent Entry, field string) (time.Time, error) {
-	val, err := check.HasKey(field, ent.m)
+	val, err := hasKey(ent, field)
 	if err != nil {
 		return time.Time{}, notice.From(err, "log entry").
 			Prepend("type", "%T", "").
@@ -71,6 +212,18 @@ func HasTime(ent Entry, field string) (time.Time, error) {
 			Remove("key").
 			Wrap(ErrMissing)
 	}
+	unit := ent.cfg.TimeUnit
+	if ent.cfg.TimeAsNumber && unit == 0 {
+		unit = time.Second
+	}
+	if num, ok := val.(float64); ok && unit > 0 {
+		return epochTime(num, unit), nil
+	}
+	if ent.cfg.TimeAsNumber {
+		return time.Time{}, notice.From(check.SameType(0.0, val), "log entry").
+			Prepend("field", "%s", field).
+			Wrap(ErrType)
+	}
 	if err = check.SameType("", val); err != nil {
 		return time.Time{}, notice.From(err, "log entry").
 			Prepend("field", "%s", field).
@@ -89,13 +242,24 @@ func HasTime(ent Entry, field string) (time.Time, error) {
 	return have, nil
 }
 
+// epochTime returns the time the given number of units since the Unix epoch.
+func epochTime(num float64, unit time.Duration) time.Time {
+	whole, frac := math.Modf(num)
+	nsec := int64(whole)*int64(unit) + int64(math.Round(frac*float64(unit)))
+	return time.Unix(0, nsec).UTC()
+}
+
 // HasDur checks if the specified duration field exists in the Entry's map of
 // fields. If the field is missing, it returns 0, and the error has
-// [ErrMissing] in its chain. If the field exists but its value is not of
-// type float64, it returns 0 and error having [ErrType] in its chain.
-// Otherwise, it returns the duration value of the field and a nil error.
+// [ErrMissing] in its chain. Numeric values are in [Config.DurationUnits]
+// unit for the field or [Config.DurationUnit] if not set. String values are
+// parsed with [time.ParseDuration], if it fails, it returns 0 and error
+// having [ErrFormat] in its chain. If the field exists but its value is not
+// of type float64 or string, it returns 0 and error having [ErrType] in its
+// chain. Otherwise, it returns the duration value of the field and a nil
+// error.
 func HasDur(ent Entry, field string) (time.Duration, error) {
-	val, err := check.HasKey(field, ent.m)
+	val, err := hasKey(ent, field)
 	if err != nil {
 		return 0, notice.From(err, "log entry").
 			Prepend("type", "number").
@@ -103,14 +267,25 @@ func HasDur(ent Entry, field string) (time.Duration, error) {
 			Remove("key").
 			Wrap(ErrMissing)
 	}
+	if haveStr, ok := val.(string); ok {
+		have, err := time.ParseDuration(haveStr)
+		if err != nil {
+			format := "[log entry] expected log entry field to have duration"
+			return 0, notice.New(format).
+				Append("field", "%s", field).
+				Have("%s", haveStr).
+				Wrap(ErrFormat)
+		}
+		return have, nil
+	}
 	if err = check.SameType(1.1, val); err != nil {
 		return 0, notice.From(err, "log entry").
 			Prepend("field", "%s", field).
 			Wrap(ErrType)
 	}
 	haveVal := val.(float64) // nolint: forcetypeassert
-	have := time.Duration(int(haveVal)) * ent.cfg.DurationUnit
-	return have, nil
+	unit := ent.cfg.durationUnit(field)
+	return time.Duration(math.Round(haveVal * float64(unit))), nil
 }
 
 // HasNum checks if the specified number field exists in the Entry's map of
@@ -119,7 +294,7 @@ func HasDur(ent Entry, field string) (time.Duration, error) {
 // float64, it returns 0 and error having [ErrType] in its chain.
 // Otherwise, it returns the float64 value of the field and a nil error.
 func HasNum(ent Entry, field string) (float64, error) {
-	val, err := check.HasKey(field, ent.m)
+	val, err := hasKey(ent, field)
 	if err != nil {
 		return 0, notice.From(err, "log entry").
 			Prepend("type", "number").
@@ -135,13 +310,133 @@ func HasNum(ent Entry, field string) (float64, error) {
 	return val.(float64), nil // nolint: forcetypeassert
 }
 
+// HasInt checks if the specified integer field exists in the Entry's map of
+// fields. It works like [HasInt64] but also checks that the value fits the
+// int type.
+func HasInt(ent Entry, field string) (int, error) {
+	num, err := hasInteger(ent, field, "int")
+	if err != nil {
+		return 0, err
+	}
+	if !num.IsInt64() || num.Int64() < math.MinInt || num.Int64() > math.MaxInt {
+		return 0, errIntRange(field, "int", num)
+	}
+	return int(num.Int64()), nil
+}
+
+// HasInt64 checks if the specified integer field exists in the Entry's map of
+// fields. The value is read from the raw log entry, so integers which cannot
+// be represented as float64 are returned without losing precision. If the
+// field is missing, it returns 0, and the error has [ErrMissing] in its
+// chain. If the field exists but its value is not a number, it is not
+// integral, or does not fit the int64 type, it returns 0 and error having
+// [ErrType] in its chain. Otherwise, it returns the int64 value of the field
+// and a nil error.
+func HasInt64(ent Entry, field string) (int64, error) {
+	num, err := hasInteger(ent, field, "int64")
+	if err != nil {
+		return 0, err
+	}
+	if !num.IsInt64() {
+		return 0, errIntRange(field, "int64", num)
+	}
+	return num.Int64(), nil
+}
+
+// HasUint64 checks if the specified unsigned integer field exists in the
+// Entry's map of fields. It works like [HasInt64] but checks that the value
+// fits the uint64 type.
+func HasUint64(ent Entry, field string) (uint64, error) {
+	num, err := hasInteger(ent, field, "uint64")
+	if err != nil {
+		return 0, err
+	}
+	if !num.IsUint64() {
+		return 0, errIntRange(field, "uint64", num)
+	}
+	return num.Uint64(), nil
+}
+
+// HasJSONNumber checks if the specified number field exists in the Entry's
+// map of fields. The value is read from the raw log entry, so it is returned
+// exactly as it was logged, without losing precision. If the field is
+// missing, it returns an empty number, and the error has [ErrMissing] in its
+// chain. If the field exists but its value is not a number, it returns an
+// empty number and error having [ErrType] in its chain. Otherwise, it returns
+// the number and a nil error.
+func HasJSONNumber(ent Entry, field string) (json.Number, error) {
+	have, err := HasNum(ent, field)
+	if err != nil {
+		return "", err
+	}
+	if str := rawNumber(ent, field); str != "" {
+		return json.Number(str), nil
+	}
+	return json.Number(strconv.FormatFloat(have, 'f', -1, 64)), nil
+}
+
+// hasInteger checks if the specified field exists in the Entry's map of
+// fields and its value is an integral number. The number is taken from the
+// raw log entry when possible, so it does not lose precision. The "typ" is
+// the name of the requested type used in error messages.
+func hasInteger(ent Entry, field, typ string) (*big.Int, error) {
+	val, err := hasKey(ent, field)
+	if err != nil {
+		return nil, notice.From(err, "log entry").
+			Prepend("type", "%s", typ).
+			Prepend("field", "%s", field).
+			Remove("key").
+			Wrap(ErrMissing)
+	}
+	if err = check.SameType(1.1, val); err != nil {
+		return nil, notice.From(err, "log entry").
+			Prepend("field", "%s", field).
+			Wrap(ErrType)
+	}
+
+	str := rawNumber(ent, field)
+	if str == "" {
+		num := val.(float64) // nolint: forcetypeassert
+		str = strconv.FormatFloat(num, 'f', -1, 64)
+	}
+	rat, ok := new(big.Rat).SetString(str)
+	if !ok || !rat.IsInt() {
+		mHeader := "[log entry] expected log entry field to be an integer"
+		return nil, notice.New(mHeader).
+			Append("field", "%s", field).
+			Append("value", "%s", str).
+			Wrap(ErrType)
+	}
+	return rat.Num(), nil
+}
+
+// rawNumber returns the string representation of the number field as it
+// appears in the raw log entry. The nested fields are resolved the same way
+// as in [HasPath]. Returns an empty string if the raw log entry cannot be
+// decoded or the field is not a number.
+func rawNumber(ent Entry, field string) string {
+	val, _ := hasKey(exactEntry(ent), field)
+	num, _ := val.(json.Number)
+	return num.String()
+}
+
+// errIntRange returns an error for the integer field value which does not fit
+// the "typ" type.
+func errIntRange(field, typ string, num *big.Int) error {
+	return notice.New("[log entry] expected log entry field to fit the type").
+		Append("field", "%s", field).
+		Append("type", "%s", typ).
+		Append("value", "%s", num.String()).
+		Wrap(ErrType)
+}
+
 // HasMap checks if the specified map field exists in the Entry's map of
 // fields. If the field is missing, it returns nil, and the error has
 // [ErrMissing] in its chain. If the field exists but its value is not of
 // type map[string]any, it returns nil and error having [ErrType] in its chain.
 // Otherwise, it returns the map value of the field and a nil error.
 func HasMap(ent Entry, field string) (map[string]any, error) {
-	val, err := check.HasKey(field, ent.m)
+	val, err := hasKey(ent, field)
 	if err != nil {
 		return nil, notice.From(err, "log entry").
 			Prepend("field", "%s", field).
@@ -155,3 +450,185 @@ func HasMap(ent Entry, field string) (map[string]any, error) {
 	}
 	return val.(map[string]any), nil // nolint: forcetypeassert
 }
+
+// HasSlice checks if the specified array field exists in the Entry's map of
+// fields. If the field is missing, it returns nil, and the error has
+// [ErrMissing] in its chain. If the field exists but its value is not of
+// type []any, it returns nil and error having [ErrType] in its chain.
+// Otherwise, it returns the slice value of the field and a nil error.
+func HasSlice(ent Entry, field string) ([]any, error) {
+	val, err := hasKey(ent, field)
+	if err != nil {
+		return nil, notice.From(err, "log entry").
+			Prepend("type", "array").
+			Prepend("field", "%s", field).
+			Remove("key").
+			Wrap(ErrMissing)
+	}
+	if err = check.SameType([]any{}, val); err != nil {
+		return nil, notice.From(err, "log entry").
+			Prepend("field", "%s", field).
+			Wrap(ErrType)
+	}
+	return val.([]any), nil // nolint: forcetypeassert
+}
+
+// HasStrSlice checks if the specified array field exists in the Entry's map
+// of fields and all its elements are strings. It works like [HasSlice] but
+// also returns error having [ErrType] in its chain when any of the elements
+// is not a string.
+func HasStrSlice(ent Entry, field string) ([]string, error) {
+	return hasSliceOf[string](ent, field)
+}
+
+// HasNumSlice checks if the specified array field exists in the Entry's map
+// of fields and all its elements are numbers. It works like [HasSlice] but
+// also returns error having [ErrType] in its chain when any of the elements
+// is not a number.
+func HasNumSlice(ent Entry, field string) ([]float64, error) {
+	return hasSliceOf[float64](ent, field)
+}
+
+// hasSliceOf checks if the specified array field exists in the Entry's map of
+// fields and all its elements are of type T.
+func hasSliceOf[T any](ent Entry, field string) ([]T, error) {
+	vals, err := HasSlice(ent, field)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]T, 0, len(vals))
+	for i, val := range vals {
+		v, ok := val.(T)
+		if !ok {
+			var want T
+			mHeader := "[log entry] expected array elements to have the same type"
+			return nil, notice.New(mHeader).
+				Append("field", "%s", field).
+				Append("index", "%d", i).
+				Want("%T", want).
+				Have("%T", val).
+				Wrap(ErrType)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// HasAs checks if the specified field exists in the Entry's map of fields and
+// decodes its value into type T the way [json.Unmarshal] does. It allows
+// extracting fields of custom types, like structs or slices of structs,
+// without casting helpers. Numbers are decoded from the raw log entry, so
+// they do not lose precision. If the field is missing, it returns zero value
+// T, and the error has [ErrMissing] in its chain. If the field value cannot
+// be decoded into T, it returns zero value T and error having [ErrType] in
+// its chain. Otherwise, it returns the decoded value and a nil error.
+//
+// Example usage:
+//
+//	type Span struct {
+//		Name string `json:"name"`
+//		Rows int    `json:"rows"`
+//	}
+//	spans, err := logkit.HasAs[[]Span](ent, "spans")
+func HasAs[T any](ent Entry, field string) (T, error) {
+	var want T
+	val, err := hasKey(ent, field)
+	if err != nil {
+		return want, notice.From(err, "log entry").
+			Prepend("type", "%T", want).
+			Prepend("field", "%s", field).
+			Remove("key").
+			Wrap(ErrMissing)
+	}
+	if exact, eErr := hasKey(exactEntry(ent), field); eErr == nil {
+		val = exact
+	}
+	data, _ := json.Marshal(val) // Decoded JSON always marshals.
+	if err = json.Unmarshal(data, &want); err != nil {
+		var zero T
+		mHeader := "[log entry] expected log entry field to decode as the type"
+		return zero, notice.New(mHeader).
+			Append("field", "%s", field).
+			Append("type", "%T", zero).
+			Append("error", "%s", err).
+			Wrap(ErrType)
+	}
+	return want, nil
+}
+
+// exactEntry returns the entry with fields decoded from the raw log entry
+// with numbers represented as [json.Number], so they do not lose precision.
+// The raw log entry is decoded once and cached for all copies of the entry.
+// Returns the entry as it is if the raw log entry cannot be decoded.
+func exactEntry(ent Entry) Entry {
+	if ent.exact == nil {
+		ent.exact = &exactFields{}
+	}
+	ent.exact.once.Do(func() {
+		var m map[string]any
+		dec := json.NewDecoder(strings.NewReader(ent.raw))
+		dec.UseNumber()
+		if err := dec.Decode(&m); err == nil {
+			ent.exact.m = m
+		}
+	})
+	if ent.exact.m != nil {
+		ent.m = ent.exact.m
+	}
+	return ent
+}
+
+// HasPath checks if the value at the specified path exists in the Entry's map
+// of fields. The path is a dot-separated list of keys like
+// "http.request.method" where integer segments, or segments in square
+// brackets like "items[0].name", index arrays. A field whose name is the
+// whole path takes precedence over the nested lookup. If the path is missing,
+// it returns nil, and the error has [ErrMissing] in its chain. If any of the
+// path segments cannot be applied to the value it refers to, it returns nil
+// and error having [ErrType] in its chain. Otherwise, it returns the value at
+// the path and a nil error.
+func HasPath(ent Entry, path string) (any, error) {
+	if val, ok := ent.m[path]; ok {
+		return val, nil
+	}
+
+	var val any = ent.m
+	segs := strings.FieldsFunc(path, func(r rune) bool {
+		return r == '.' || r == '[' || r == ']'
+	})
+	for i, seg := range segs {
+		var ok bool
+		switch v := val.(type) {
+		case map[string]any:
+			val, ok = v[seg]
+
+		case []any:
+			idx, err := strconv.Atoi(seg)
+			if err != nil {
+				mHeader := "[log entry] expected path segment to be an array index"
+				return nil, notice.New(mHeader).
+					Append("path", "%s", path).
+					Append("segment", "%s", seg).
+					Wrap(ErrType)
+			}
+			if ok = idx >= 0 && idx < len(v); ok {
+				val = v[idx]
+			}
+
+		default:
+			mHeader := "[log entry] expected path segment to be an object or array"
+			return nil, notice.New(mHeader).
+				Append("path", "%s", path).
+				Append("segment", "%s", strings.Join(segs[:i], ".")).
+				Append("type", "%T", val).
+				Wrap(ErrType)
+		}
+		if !ok {
+			return nil, notice.New("[log entry] expected log entry to have the path").
+				Append("path", "%s", path).
+				Append("missing", "%s", seg).
+				Wrap(ErrMissing)
+		}
+	}
+	return val, nil
+}
@@ -4,6 +4,8 @@
 package logkit
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +13,77 @@ import (
 	"github.com/ctx42/testing/pkg/tester"
 )
 
+func Test_hasKey(t *testing.T) {
+	t.Run("field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{m: map[string]any{"a.b": "flat"}, t: tspy}
+
+		// --- When ---
+		have, err := hasKey(ent, "a.b")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "flat", have)
+	})
+
+	t.Run("nested field", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: map[string]any{"a": map[string]any{"b": "nested"}},
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := hasKey(ent, "a.b")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "nested", have)
+	})
+
+	t.Run("flat field takes precedence", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: map[string]any{
+				"a.b": "flat",
+				"a":   map[string]any{"b": "nested"},
+			},
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := hasKey(ent, "a.b")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "flat", have)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{m: map[string]any{"a": map[string]any{}}, t: tspy}
+
+		// --- When ---
+		have, err := hasKey(ent, "a.b")
+
+		// --- Then ---
+		assert.ErrorContain(t, "expected map to have a key", err)
+		assert.Nil(t, have)
+	})
+}
+
 func Test_HasBool(t *testing.T) {
 	t.Run("true", func(t *testing.T) {
 		// --- Given ---
@@ -162,118 +235,177 @@ func Test_HasStr(t *testing.T) {
 	})
 }
 
-func Test_HasTime(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
+func Test_HasLevel(t *testing.T) {
+	// Parses bunyan style numeric levels.
+	parser := func(val any) (string, error) {
+		switch val {
+		case 30.0:
+			return "info", nil
+		case 50.0:
+			return "error", nil
+		}
+		return "", errors.New("unknown level")
+	}
+
+	t.Run("without parser", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
-		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
-		entTimS := entTim.Format(time.RFC3339)
-
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"time": entTimS, "number": 42.0},
+			m:   map[string]any{"level": "info"},
 			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasTime(ent, "time")
+		have, err := HasLevel(ent)
 
 		// --- Then ---
 		assert.NoError(t, err)
-		assert.Equal(t, entTim, have)
+		assert.Equal(t, "info", have)
 	})
 
-	t.Run("error - field has a wrong format", func(t *testing.T) {
+	t.Run("error - without parser field has a wrong type", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
 		ent := Entry{
 			cfg: DefaultConfig(),
-			m:   map[string]any{"time": "2000-01-02", "number": 42.0},
+			m:   map[string]any{"level": 30.0},
 			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasTime(ent, "time")
+		have, err := HasLevel(ent)
 
 		// --- Then ---
-		wMsg := "[log entry] expected log entry field to have formatted time:\n" +
-			"  field: time\n" +
-			"   want: 2006-01-02T15:04:05Z07:00\n" +
-			"   have: 2000-01-02"
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("with parser", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.LevelParser = parser
+		ent := Entry{cfg: cfg, m: map[string]any{"level": 50.0}, t: tspy}
+
+		// --- When ---
+		have, err := HasLevel(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "error", have)
+	})
+
+	t.Run("error - with parser field does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.LevelParser = parser
+		ent := Entry{cfg: cfg, m: map[string]any{}, t: tspy}
+
+		// --- When ---
+		have, err := HasLevel(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("error - parser error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.LevelParser = parser
+		ent := Entry{cfg: cfg, m: map[string]any{"level": 1.0}, t: tspy}
+
+		// --- When ---
+		have, err := HasLevel(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to have a valid level:\n" +
+			"  field: level\n" +
+			"  value: 1\n" +
+			"  error: unknown level"
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrFormat, err)
-		assert.Empty(t, have)
+		assert.Equal(t, "", have)
 	})
+}
 
-	t.Run("error - field has a wrong type", func(t *testing.T) {
+func Test_HasCaller(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
 		ent := Entry{
-			m: map[string]any{"time": 42.0},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"caller": "/src/app/pkg/file.go:42"},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasTime(ent, "time")
+		have, err := HasCaller(ent, "caller")
 
 		// --- Then ---
-		wMsg := "[log entry] expected same types:\n" +
-			"  field: time\n" +
-			"   want: string\n" +
-			"   have: float64"
-		assert.ErrorEqual(t, wMsg, err)
-		assert.ErrorIs(t, ErrType, err)
-		assert.Empty(t, have)
+		assert.NoError(t, err)
+		assert.Equal(t, Caller{File: "/src/app/pkg/file.go", Line: 42}, have)
 	})
 
-	t.Run("error - field does not exist", func(t *testing.T) {
+	t.Run("slog object", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
+		src := map[string]any{
+			"function": "main.run",
+			"file":     "/src/app/main.go",
+			"line":     7.0,
+		}
 		ent := Entry{
-			m: make(map[string]any),
-			t: tspy,
+			cfg: SlogConfig(),
+			m:   map[string]any{"source": src},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasTime(ent, "missing")
+		have, err := HasCaller(ent, "source")
 
 		// --- Then ---
-		wMsg := "[log entry] expected map to have a key:\n" +
-			"  field: missing\n" +
-			"   type: string\n" +
-			"    map: map[string]any{}"
-		assert.ErrorEqual(t, wMsg, err)
-		assert.ErrorIs(t, ErrMissing, err)
-		assert.Empty(t, have)
+		assert.NoError(t, err)
+		want := Caller{File: "/src/app/main.go", Line: 7, Func: "main.run"}
+		assert.Equal(t, want, have)
 	})
-}
 
-func Test_HasDur(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
+	t.Run("bunyan object", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
+		src := map[string]any{"file": "app.js", "line": 3.0, "func": "run"}
 		ent := Entry{
-			cfg: DefaultConfig(),
-			m:   map[string]any{"dur": 1000.0, "number": 42.0},
+			cfg: BunyanConfig(),
+			m:   map[string]any{"src": src},
 			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasDur(ent, "dur")
+		have, err := HasCaller(ent, "src")
 
 		// --- Then ---
 		assert.NoError(t, err)
-		assert.Equal(t, time.Second, have)
+		assert.Equal(t, Caller{File: "app.js", Line: 3, Func: "run"}, have)
 	})
 
 	t.Run("error - field has a wrong type", func(t *testing.T) {
@@ -282,130 +414,138 @@ func Test_HasDur(t *testing.T) {
 		tspy.Close()
 
 		ent := Entry{
-			m: map[string]any{"str": "abc", "number": 42.0},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"caller": 42.0},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasDur(ent, "str")
+		have, err := HasCaller(ent, "caller")
 
 		// --- Then ---
-		wMsg := "[log entry] expected same types:\n" +
-			"  field: str\n" +
-			"   want: float64\n" +
-			"   have: string"
+		wMsg := "" +
+			"[log entry] expected log entry field to be a caller:\n" +
+			"  field: caller\n" +
+			"   type: float64"
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrType, err)
-		assert.Empty(t, have)
+		assert.Zero(t, have)
 	})
 
-	t.Run("error - field does not exist", func(t *testing.T) {
+	t.Run("error - object without file", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
 		ent := Entry{
-			m: make(map[string]any),
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"caller": map[string]any{"line": 1.0}},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasDur(ent, "missing")
+		have, err := HasCaller(ent, "caller")
 
 		// --- Then ---
-		wMsg := "[log entry] expected map to have a key:\n" +
-			"  field: missing\n" +
-			"   type: number\n" +
-			"    map: map[string]any{}"
-		assert.ErrorEqual(t, wMsg, err)
-		assert.ErrorIs(t, ErrMissing, err)
-		assert.Empty(t, have)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Zero(t, have)
 	})
-}
 
-func Test_HasNum(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
+	t.Run("error - field does not exist", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
 		ent := Entry{
-			m: map[string]any{"float": 42.0, "str": "abc"},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasNum(ent, "float")
+		have, err := HasCaller(ent, "caller")
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.Equal(t, 42.0, have)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Zero(t, have)
 	})
+}
 
-	t.Run("error - field has a wrong type", func(t *testing.T) {
+func Test_HasStack(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
 		ent := Entry{
-			m: map[string]any{"float": 42.0, "str": "abc"},
-			t: tspy,
+			cfg: ZapConfig(),
+			m:   map[string]any{"stacktrace": "main.run\n\t/app/main.go:42"},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasNum(ent, "str")
+		have, err := HasStack(ent, "stacktrace")
 
 		// --- Then ---
-		wMsg := "[log entry] expected same types:\n" +
-			"  field: str\n" +
-			"   want: float64\n" +
-			"   have: string"
-		assert.ErrorEqual(t, wMsg, err)
-		assert.ErrorIs(t, ErrType, err)
-		assert.Empty(t, have)
+		assert.NoError(t, err)
+		want := []Caller{{File: "/app/main.go", Line: 42, Func: "main.run"}}
+		assert.Equal(t, want, have)
 	})
 
-	t.Run("error - field does not exist", func(t *testing.T) {
+	t.Run("array", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
+		stack := []any{
+			map[string]any{"func": "run", "line": "42", "source": "main.go"},
+			map[string]any{"func": "main", "line": "10", "source": "main.go"},
+		}
 		ent := Entry{
-			m: make(map[string]any),
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"stack": stack},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasNum(ent, "missing")
+		have, err := HasStack(ent, "stack")
 
 		// --- Then ---
-		wMsg := "[log entry] expected map to have a key:\n" +
-			"  field: missing\n" +
-			"   type: number\n" +
-			"    map: map[string]any{}"
-		assert.ErrorEqual(t, wMsg, err)
-		assert.ErrorIs(t, ErrMissing, err)
-		assert.Empty(t, have)
+		assert.NoError(t, err)
+		want := []Caller{
+			{File: "main.go", Line: 42, Func: "run"},
+			{File: "main.go", Line: 10, Func: "main"},
+		}
+		assert.Equal(t, want, have)
 	})
-}
 
-func Test_HasMap(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
+	t.Run("error - invalid frame", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t, 0)
 		tspy.Close()
 
+		stack := []any{
+			map[string]any{"func": "run", "line": "42", "source": "main.go"},
+			"main.go:10",
+		}
 		ent := Entry{
-			m: map[string]any{"map": map[string]any{"str": "abc"}},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"stack": stack},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasMap(ent, "map")
+		have, err := HasStack(ent, "stack")
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.Equal(t, map[string]any{"str": "abc"}, have)
+		wMsg := "" +
+			"[log entry] expected stack trace frame:\n" +
+			"  field: stack\n" +
+			"  index: 1\n" +
+			"   type: string"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
 	})
 
 	t.Run("error - field has a wrong type", func(t *testing.T) {
@@ -414,21 +554,22 @@ func Test_HasMap(t *testing.T) {
 		tspy.Close()
 
 		ent := Entry{
-			m: map[string]any{"str": "abc"},
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{"stack": 42.0},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasMap(ent, "str")
+		have, err := HasStack(ent, "stack")
 
 		// --- Then ---
-		wMsg := "[log entry] expected same types:\n" +
-			"  field: str\n" +
-			"   want: map[string]interface {}\n" +
-			"   have: string"
+		wMsg := "" +
+			"[log entry] expected log entry field to be a stack trace:\n" +
+			"  field: stack\n" +
+			"   type: float64"
 		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrType, err)
-		assert.Empty(t, have)
+		assert.Nil(t, have)
 	})
 
 	t.Run("error - field does not exist", func(t *testing.T) {
@@ -437,19 +578,1224 @@ func Test_HasMap(t *testing.T) {
 		tspy.Close()
 
 		ent := Entry{
-			m: make(map[string]any),
-			t: tspy,
+			cfg: DefaultConfig(),
+			m:   map[string]any{},
+			t:   tspy,
 		}
 
 		// --- When ---
-		have, err := HasMap(ent, "missing")
+		have, err := HasStack(ent, "stack")
 
 		// --- Then ---
-		wMsg := "[log entry] expected map to have a key:\n" +
-			"  field: missing\n" +
-			"    map: map[string]any{}"
-		assert.ErrorEqual(t, wMsg, err)
 		assert.ErrorIs(t, ErrMissing, err)
-		assert.Empty(t, have)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_HasTime(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		entTim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		entTimS := entTim.Format(time.RFC3339)
+
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": entTimS, "number": 42.0},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "time")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, entTim, have)
+	})
+
+	t.Run("numeric time", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.TimeUnit = time.Millisecond
+		ent := Entry{
+			cfg: cfg,
+			m:   map[string]any{"time": 946782245123.0},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "time")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := time.Date(2000, 1, 2, 3, 4, 5, 123000000, time.UTC)
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fractional numeric time", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.TimeUnit = time.Second
+		ent := Entry{
+			cfg: cfg,
+			m:   map[string]any{"time": 946782245.5},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "time")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := time.Date(2000, 1, 2, 3, 4, 5, 500000000, time.UTC)
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("time as number defaults to seconds", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.TimeAsNumber = true
+		ent := Entry{
+			cfg: cfg,
+			m:   map[string]any{"time": 946782245.0},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "time")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC), have)
+	})
+
+	t.Run("error - time as number with string value", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.TimeAsNumber = true
+		ent := Entry{
+			cfg: cfg,
+			m:   map[string]any{"time": "2000-01-02T03:04:05Z"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "time")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected same types:\n" +
+			"  field: time\n" +
+			"   want: float64\n" +
+			"   have: string"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - field has a wrong format", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": "2000-01-02", "number": 42.0},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "time")
+
+		// --- Then ---
+		wMsg := "[log entry] expected log entry field to have formatted time:\n" +
+			"  field: time\n" +
+			"   want: 2006-01-02T15:04:05Z07:00\n" +
+			"   have: 2000-01-02"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrFormat, err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - field has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"time": 42.0},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "time")
+
+		// --- Then ---
+		wMsg := "[log entry] expected same types:\n" +
+			"  field: time\n" +
+			"   want: string\n" +
+			"   have: float64"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: make(map[string]any),
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasTime(ent, "missing")
+
+		// --- Then ---
+		wMsg := "[log entry] expected map to have a key:\n" +
+			"  field: missing\n" +
+			"   type: string\n" +
+			"    map: map[string]any{}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Empty(t, have)
+	})
+}
+
+func Test_HasDur(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": 1000.0, "number": 42.0},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasDur(ent, "dur")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, time.Second, have)
+	})
+
+	t.Run("fractional value", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			cfg: ZapConfig(),
+			m:   map[string]any{"dur": 1.5},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasDur(ent, "dur")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1500*time.Millisecond, have)
+	})
+
+	t.Run("field unit", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.DurationUnits = map[string]time.Duration{"ns": time.Nanosecond}
+		ent := Entry{
+			cfg: cfg,
+			m:   map[string]any{"ns": 1000.0, "ms": 1000.0},
+			t:   tspy,
+		}
+
+		// --- When ---
+		haveNs, errNs := HasDur(ent, "ns")
+		haveMs, errMs := HasDur(ent, "ms")
+
+		// --- Then ---
+		assert.NoError(t, errNs)
+		assert.Equal(t, time.Microsecond, haveNs)
+		assert.NoError(t, errMs)
+		assert.Equal(t, time.Second, haveMs)
+	})
+
+	t.Run("string value", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": "1.5s"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasDur(ent, "dur")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1500*time.Millisecond, have)
+	})
+
+	t.Run("error - string value is not a duration", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			cfg: DefaultConfig(),
+			m:   map[string]any{"dur": "abc"},
+			t:   tspy,
+		}
+
+		// --- When ---
+		have, err := HasDur(ent, "dur")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to have duration:\n" +
+			"  field: dur\n" +
+			"   have: abc"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrFormat, err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - field has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: map[string]any{"bool": true, "number": 42.0},
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasDur(ent, "bool")
+
+		// --- Then ---
+		wMsg := "[log entry] expected same types:\n" +
+			"  field: bool\n" +
+			"   want: float64\n" +
+			"   have: bool"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: make(map[string]any),
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasDur(ent, "missing")
+
+		// --- Then ---
+		wMsg := "[log entry] expected map to have a key:\n" +
+			"  field: missing\n" +
+			"   type: number\n" +
+			"    map: map[string]any{}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Empty(t, have)
+	})
+}
+
+func Test_HasNum(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: map[string]any{"float": 42.0, "str": "abc"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasNum(ent, "float")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 42.0, have)
+	})
+
+	t.Run("error - field has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: map[string]any{"float": 42.0, "str": "abc"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasNum(ent, "str")
+
+		// --- Then ---
+		wMsg := "[log entry] expected same types:\n" +
+			"  field: str\n" +
+			"   want: float64\n" +
+			"   have: string"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: make(map[string]any),
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasNum(ent, "missing")
+
+		// --- Then ---
+		wMsg := "[log entry] expected map to have a key:\n" +
+			"  field: missing\n" +
+			"   type: number\n" +
+			"    map: map[string]any{}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Empty(t, have)
+	})
+}
+
+func Test_HasMap(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: map[string]any{"map": map[string]any{"str": "abc"}},
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasMap(ent, "map")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"str": "abc"}, have)
+	})
+
+	t.Run("error - field has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: map[string]any{"str": "abc"},
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasMap(ent, "str")
+
+		// --- Then ---
+		wMsg := "[log entry] expected same types:\n" +
+			"  field: str\n" +
+			"   want: map[string]interface {}\n" +
+			"   have: string"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ent := Entry{
+			m: make(map[string]any),
+			t: tspy,
+		}
+
+		// --- When ---
+		have, err := HasMap(ent, "missing")
+
+		// --- Then ---
+		wMsg := "[log entry] expected map to have a key:\n" +
+			"  field: missing\n" +
+			"    map: map[string]any{}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Empty(t, have)
+	})
+}
+
+func Test_HasAs(t *testing.T) {
+	type span struct {
+		Name string `json:"name"`
+		Rows int    `json:"rows"`
+	}
+
+	t.Run("struct slice", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"spans": [{"name": "db", "rows": 2}, {"name": "http"}]}`
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		have, err := HasAs[[]span](ent, "spans")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []span{{Name: "db", Rows: 2}, {Name: "http"}}, have)
+	})
+
+	t.Run("nested path", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"req": {"span": {"name": "db"}}}`).ets[0]
+
+		// --- When ---
+		have, err := HasAs[span](ent, "req.span")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, span{Name: "db"}, have)
+	})
+
+	t.Run("number precision", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"id": 9007199254740993}`).ets[0]
+
+		// --- When ---
+		have, err := HasAs[int64](ent, "id")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9007199254740993), have)
+	})
+
+	t.Run("entry without raw", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"tags": []any{"a", "b"}}}
+
+		// --- When ---
+		have, err := HasAs[[]string](ent, "tags")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, have)
+	})
+
+	t.Run("error - missing field", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{}`).ets[0]
+
+		// --- When ---
+		have, err := HasAs[span](ent, "span")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected map to have a key:\n" +
+			"  field: span\n" +
+			"   type: logkit.span\n" +
+			"    map: map[string]any{}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Zero(t, have)
+	})
+
+	t.Run("error - not decodable", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"spans": [{"name": 1}]}`).ets[0]
+
+		// --- When ---
+		have, err := HasAs[[]span](ent, "spans")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to decode as the type:\n" +
+			"  field: spans\n" +
+			"   type: []logkit.span\n" +
+			"  error: json: cannot unmarshal number into Go struct field "
+		assert.ErrorContain(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_HasPath(t *testing.T) {
+	m := map[string]any{
+		"http": map[string]any{
+			"request": map[string]any{"method": "GET"},
+		},
+		"items": []any{
+			map[string]any{"name": "abc"},
+			map[string]any{"name": "def"},
+		},
+		"dot.ted": "flat",
+		"str":     "abc",
+	}
+
+	t.Run("nested object", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "http.request.method")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "GET", have)
+	})
+
+	t.Run("object", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "http.request")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"method": "GET"}, have)
+	})
+
+	t.Run("array index", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "items.1.name")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "def", have)
+	})
+
+	t.Run("array index in brackets", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "items[0].name")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", have)
+	})
+
+	t.Run("field with dots in the name", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "dot.ted")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "flat", have)
+	})
+
+	t.Run("error - missing key", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "http.response.code")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to have the path:\n" +
+			"     path: http.response.code\n" +
+			"  missing: response"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - index out of range", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "items.2.name")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - index is not an integer", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "items.name")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected path segment to be an array index:\n" +
+			"     path: items.name\n" +
+			"  segment: name"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - value is not an object nor array", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: m}
+
+		// --- When ---
+		have, err := HasPath(ent, "str.abc")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected path segment to be an object or array:\n" +
+			"     path: str.abc\n" +
+			"  segment: str\n" +
+			"     type: string"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_HasInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"int": 123}`).ets[0]
+
+		// --- When ---
+		have, err := HasInt(ent, "int")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 123, have)
+	})
+
+	t.Run("error - out of range", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"int": 18446744073709551615}`
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		have, err := HasInt(ent, "int")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to fit the type:\n" +
+			"  field: int\n" +
+			"   type: int\n" +
+			"  value: 18446744073709551615"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, 0, have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{}`).ets[0]
+
+		// --- When ---
+		have, err := HasInt(ent, "int")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, 0, have)
+	})
+}
+
+func Test_HasInt64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"int": -123}`).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "int")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-123), have)
+	})
+
+	t.Run("large value keeps precision", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"int": 9007199254740993}`
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "int")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9007199254740993), have)
+	})
+
+	t.Run("nested field keeps precision", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"ctx": {"id": 9007199254740993}}`
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "ctx.id")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9007199254740993), have)
+	})
+
+	t.Run("integral value with exponent", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"int": 1.5e3}`).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "int")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1500), have)
+	})
+
+	t.Run("without raw log entry", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"int": 123.0}}
+
+		// --- When ---
+		have, err := HasInt64(ent, "int")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(123), have)
+	})
+
+	t.Run("error - not integral", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"num": 1.5}`).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "num")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to be an integer:\n" +
+			"  field: num\n" +
+			"  value: 1.5"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, int64(0), have)
+	})
+
+	t.Run("error - out of range", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"int": 9223372036854775808}`
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "int")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, int64(0), have)
+	})
+
+	t.Run("error - field has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"str": "abc"}`).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "str")
+
+		// --- Then ---
+		wMsg := "[log entry] expected same types:\n" +
+			"  field: str\n" +
+			"   want: float64\n" +
+			"   have: string"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, int64(0), have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{}`).ets[0]
+
+		// --- When ---
+		have, err := HasInt64(ent, "int")
+
+		// --- Then ---
+		wMsg := "[log entry] expected map to have a key:\n" +
+			"  field: int\n" +
+			"   type: int64\n" +
+			"    map: map[string]any{}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, int64(0), have)
+	})
+}
+
+func Test_HasUint64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"int": 18446744073709551615}`
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		have, err := HasUint64(ent, "int")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(18446744073709551615), have)
+	})
+
+	t.Run("error - negative", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"int": -1}`).ets[0]
+
+		// --- When ---
+		have, err := HasUint64(ent, "int")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry field to fit the type:\n" +
+			"  field: int\n" +
+			"   type: uint64\n" +
+			"  value: -1"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, uint64(0), have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{}`).ets[0]
+
+		// --- When ---
+		have, err := HasUint64(ent, "int")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, uint64(0), have)
+	})
+}
+
+func Test_HasJSONNumber(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"num": 9007199254740993}`).ets[0]
+
+		// --- When ---
+		have, err := HasJSONNumber(ent, "num")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, json.Number("9007199254740993"), have)
+	})
+
+	t.Run("nested field", func(t *testing.T) {
+		// --- Given ---
+		lin := `{"ctx": {"id": 9007199254740993}}`
+		ent := MustEntries(nil, lin).ets[0]
+
+		// --- When ---
+		have, err := HasJSONNumber(ent, "ctx.id")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, json.Number("9007199254740993"), have)
+	})
+
+	t.Run("no raw log entry", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"num": 1.5}}
+
+		// --- When ---
+		have, err := HasJSONNumber(ent, "num")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, json.Number("1.5"), have)
+	})
+
+	t.Run("error - not a number", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"num": "1"}`).ets[0]
+
+		// --- When ---
+		have, err := HasJSONNumber(ent, "num")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+		assert.Equal(t, json.Number(""), have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{}`).ets[0]
+
+		// --- When ---
+		have, err := HasJSONNumber(ent, "num")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Equal(t, json.Number(""), have)
+	})
+}
+
+func Test_rawNumber(t *testing.T) {
+	t.Run("number", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"int": 9007199254740993}`}
+
+		// --- When ---
+		have := rawNumber(ent, "int")
+
+		// --- Then ---
+		assert.Equal(t, "9007199254740993", have)
+	})
+
+	t.Run("nested number", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"ctx": {"id": 9007199254740993}}`}
+
+		// --- When ---
+		have := rawNumber(ent, "ctx.id")
+
+		// --- Then ---
+		assert.Equal(t, "9007199254740993", have)
+	})
+
+	t.Run("not a number", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"str": "abc"}`}
+
+		// --- When ---
+		have := rawNumber(ent, "str")
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("invalid raw log entry", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: ``}
+
+		// --- When ---
+		have := rawNumber(ent, "int")
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_exactEntry(t *testing.T) {
+	t.Run("numbers", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"int": 9007199254740993}`, exact: &exactFields{}}
+
+		// --- When ---
+		have := exactEntry(ent)
+
+		// --- Then ---
+		assert.Equal(t, json.Number("9007199254740993"), have.m["int"])
+	})
+
+	t.Run("decoded once", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"int": 1}`, exact: &exactFields{}}
+		_ = exactEntry(ent)
+		ent.raw = `{"int": 2}`
+
+		// --- When ---
+		have := exactEntry(ent)
+
+		// --- Then ---
+		assert.Equal(t, json.Number("1"), have.m["int"])
+	})
+
+	t.Run("without cache", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{raw: `{"int": 1}`}
+
+		// --- When ---
+		have := exactEntry(ent)
+
+		// --- Then ---
+		assert.Equal(t, json.Number("1"), have.m["int"])
+		assert.Nil(t, ent.exact)
+	})
+
+	t.Run("invalid raw log entry", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"int": 1.0}, exact: &exactFields{}}
+
+		// --- When ---
+		have := exactEntry(ent)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"int": 1.0}, have.m)
+	})
+}
+
+func Test_HasSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{"abc", 1.0}}}
+
+		// --- When ---
+		have, err := HasSlice(ent, "arr")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"abc", 1.0}, have)
+	})
+
+	t.Run("error - field has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"str": "abc"}}
+
+		// --- When ---
+		have, err := HasSlice(ent, "str")
+
+		// --- Then ---
+		wMsg := "[log entry] expected same types:\n" +
+			"  field: str\n" +
+			"   want: []interface {}\n" +
+			"   have: string"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: make(map[string]any)}
+
+		// --- When ---
+		have, err := HasSlice(ent, "missing")
+
+		// --- Then ---
+		wMsg := "[log entry] expected map to have a key:\n" +
+			"  field: missing\n" +
+			"   type: array\n" +
+			"    map: map[string]any{}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_HasStrSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{"abc", "def"}}}
+
+		// --- When ---
+		have, err := HasStrSlice(ent, "arr")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"abc", "def"}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{}}}
+
+		// --- When ---
+		have, err := HasStrSlice(ent, "arr")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, have)
+	})
+
+	t.Run("error - element has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{"abc", 1.0}}}
+
+		// --- When ---
+		have, err := HasStrSlice(ent, "arr")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected array elements to have the same type:\n" +
+			"  field: arr\n" +
+			"  index: 1\n" +
+			"   want: string\n" +
+			"   have: float64"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - field does not exist", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: make(map[string]any)}
+
+		// --- When ---
+		have, err := HasStrSlice(ent, "missing")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_HasNumSlice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{1.0, 2.5}}}
+
+		// --- When ---
+		have, err := HasNumSlice(ent, "arr")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{1, 2.5}, have)
+	})
+
+	t.Run("error - element has a wrong type", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"arr": []any{1.0, "abc"}}}
+
+		// --- When ---
+		have, err := HasNumSlice(ent, "arr")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
 	})
 }
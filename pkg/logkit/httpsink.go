@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// NewHTTPSink creates a new [Tester] and starts an HTTP log ingest endpoint
+// feeding it until the test ends. Returns the URL of the endpoint and the
+// [Tester]. The endpoint accepts POST requests on any path with batches of
+// newline-delimited JSON log entries, the way HTTP outputs of log shippers
+// like Vector or Fluent Bit send them. Batches being a JSON array of log
+// entries and gzip compressed requests are supported as well. The options
+// are applied to the created [Tester], see [New]. Invalid batches are
+// rejected and fail the test. Errors returned by the [Tester], see
+// [WithWriteError], are responded with the 500 status code.
+//
+// Example usage:
+//
+//	url, tst := logkit.NewHTTPSink(t)
+//	// Configure the log shipper to send logs to url.
+//	tst.WaitFor("5s", logkit.CheckMsg("started"))
+func NewHTTPSink(t tester.T, opts ...func(*Tester)) (string, *Tester) {
+	t.Helper()
+	tst := New(t, opts...)
+	srv := httptest.NewServer(http.HandlerFunc(tst.httpSink))
+	t.Cleanup(srv.Close)
+	return srv.URL, tst
+}
+
+// httpSink handles the HTTP log ingest requests.
+func (tst *Tester) httpSink(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	buf, err := httpBody(r)
+	if err == nil {
+		buf, err = httpBatch(buf)
+	}
+	if err != nil {
+		err = notice.New("[http sink] expected batch of log entries").
+			Append("reason", "%s", err.Error()).
+			Wrap(ErrFormat)
+		tst.t.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if _, err = tst.ReadFrom(bytes.NewReader(buf)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// httpBody reads the request body, decompressing it when the request has the
+// gzip content encoding.
+func httpBody(r *http.Request) ([]byte, error) {
+	if r.Header.Get("Content-Encoding") != "gzip" {
+		return io.ReadAll(r.Body)
+	}
+	zr, err := gzip.NewReader(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = zr.Close() }()
+	return io.ReadAll(zr)
+}
+
+// httpBatch returns the batch of log entries as newline-delimited JSON. When
+// the batch is a JSON array, each of its elements is a log entry.
+func httpBatch(buf []byte) ([]byte, error) {
+	if trim := bytes.TrimSpace(buf); len(trim) == 0 || trim[0] != '[' {
+		return buf, nil
+	}
+	var ets []json.RawMessage
+	if err := json.Unmarshal(buf, &ets); err != nil {
+		return nil, err
+	}
+	out := &bytes.Buffer{}
+	for _, ent := range ets {
+		if err := json.Compact(out, ent); err != nil {
+			return nil, err
+		}
+		out.WriteByte('\n')
+	}
+	return out.Bytes(), nil
+}
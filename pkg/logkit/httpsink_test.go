@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// sinkPost posts the body to the URL and returns the response status code.
+func sinkPost(url, body string, gz bool) int {
+	data := []byte(body)
+	if gz {
+		buf := &bytes.Buffer{}
+		zw := gzip.NewWriter(buf)
+		must.Value(zw.Write(data))
+		must.Nil(zw.Close())
+		data = buf.Bytes()
+	}
+	req := must.Value(http.NewRequest(
+		http.MethodPost,
+		url,
+		bytes.NewReader(data),
+	))
+	if gz {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	res := must.Value(http.DefaultClient.Do(req))
+	_ = res.Body.Close()
+	return res.StatusCode
+}
+
+func Test_NewHTTPSink(t *testing.T) {
+	t.Run("ndjson", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewHTTPSink(tspy)
+
+		// --- When ---
+		code := sinkPost(url+"/logs", `{"A":1}`+"\n\n"+`{"A":2}`, false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusNoContent, code)
+		assert.Equal(t, `{"A":1}`+"\n"+`{"A":2}`+"\n", tst.String())
+	})
+
+	t.Run("json array", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewHTTPSink(tspy)
+
+		// --- When ---
+		code := sinkPost(url, ` [{"A": 1}, {"A": 2}]`, false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusNoContent, code)
+		assert.Equal(t, `{"A":1}`+"\n"+`{"A":2}`+"\n", tst.String())
+	})
+
+	t.Run("gzip", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewHTTPSink(tspy)
+
+		// --- When ---
+		code := sinkPost(url, `{"A":1}`+"\n", true)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusNoContent, code)
+		assert.Equal(t, `{"A":1}`+"\n", tst.String())
+	})
+
+	t.Run("multiple batches", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewHTTPSink(tspy)
+
+		// --- When ---
+		sinkPost(url, `{"A":1}`, false)
+		sinkPost(url, `[{"A":2}]`, false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, 2, tst.Len())
+	})
+
+	t.Run("options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		// --- When ---
+		_, tst := NewHTTPSink(tspy, WithConfig(OTelConfig()))
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, OTelConfig(), tst.cfg)
+	})
+
+	t.Run("error - write error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		e := errors.New("sink failed")
+		url, tst := NewHTTPSink(tspy, WithWriteError(e, 1))
+
+		// --- When ---
+		code := sinkPost(url, `{"A":1}`+"\n"+`{"A":2}`+"\n", false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, `{"A":1}`+"\n", tst.String())
+	})
+
+	t.Run("error - method not allowed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewHTTPSink(tspy)
+
+		// --- When ---
+		res := must.Value(http.Get(url))
+		_ = res.Body.Close()
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("error - invalid json array", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[http sink] expected batch of log entries:\n" +
+			"  reason: unexpected end of JSON input"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		url, tst := NewHTTPSink(tspy)
+
+		// --- When ---
+		code := sinkPost(url, `[{"A": 1}`, false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("error - invalid gzip", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("reason: gzip: invalid header")
+		tspy.Close()
+
+		url, tst := NewHTTPSink(tspy)
+		req := must.Value(http.NewRequest(
+			http.MethodPost,
+			url,
+			strings.NewReader("not a gzip stream"),
+		))
+		req.Header.Set("Content-Encoding", "gzip")
+
+		// --- When ---
+		res := must.Value(http.DefaultClient.Do(req))
+		_ = res.Body.Close()
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.Equal(t, 0, tst.Len())
+	})
+}
@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Kinds of the JSON path segments.
+const (
+	segKey   = iota // Object key, or array index in GJSON syntax.
+	segIndex        // Array index.
+	segWild         // All object values or array elements.
+	segCount        // Number of array elements.
+)
+
+// pathSeg represents a segment of the JSON path.
+type pathSeg struct {
+	kind int    // Segment kind.
+	key  string // Object key.
+	idx  int    // Array index, negative counts from the end.
+}
+
+// HasJSONPath evaluates the JSON path expression against the log entry and
+// returns the selected value. Two syntaxes are supported:
+//
+//   - JSONPath, starting with "$", like "$.spans[0].name", "$['a.b']" or
+//     "$.spans[*].name", where negative indexes count from the array end,
+//   - GJSON, like "spans.0.name", "spans.#.name" or "spans.#", where "#"
+//     selects all array elements or, as the last segment, their count.
+//
+// Expressions with wildcards return an array of the selected values, which
+// skips the elements where the rest of the path is missing. If the path is
+// missing, it returns nil, and the error has [ErrMissing] in its chain. If
+// any of the path segments cannot be applied to the value it refers to, it
+// returns nil and error having [ErrType] in its chain. If the expression is
+// not valid, it returns nil and error having [ErrQuery] in its chain.
+func HasJSONPath(ent Entry, expr string) (any, error) {
+	segs, err := parseJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	return evalJSONPath(expr, ent.m, segs)
+}
+
+// CheckJSONPath returns a function that takes an [Entry] and checks if the
+// value selected by the JSON path expression, see [HasJSONPath], is deeply
+// equal to the given value. The "want" value is normalized with a JSON round
+// trip before the comparison, so Go numbers match JSON numbers. Returns nil
+// if the path exists and matches. Returns [ErrMissing], [ErrType], or
+// [ErrValue] if the path is missing, cannot be followed, or does not match,
+// respectively.
+func CheckJSONPath(expr string, want any) Checker {
+	return func(ent Entry) error {
+		have, err := HasJSONPath(ent, expr)
+		if err != nil {
+			return err
+		}
+		norm, err := jsonNormalize(want)
+		if err != nil {
+			return err
+		}
+		if err = check.Equal(norm, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("path", "%s", expr).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// JSONPath retrieves the value selected by the JSON path expression, see
+// [HasJSONPath]. Returns the value and nil error if the path exists. If the
+// path is missing or cannot be followed, returns nil and [ErrMissing] or
+// [ErrType], respectively.
+func (ent Entry) JSONPath(expr string) (any, error) {
+	ent.t.Helper()
+	return HasJSONPath(ent, expr)
+}
+
+// AssertJSONPath asserts that the value selected by the JSON path expression
+// matches the provided "want" value, see [CheckJSONPath]. Returns true if the
+// path exists and matches. If the path is missing or the value doesn't
+// match, it marks the test as failed, logs an error message, and returns
+// false.
+func (ent Entry) AssertJSONPath(expr string, want any) bool {
+	ent.t.Helper()
+	if err := CheckJSONPath(expr, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// errJSONPath returns an error for the JSON path expression which is not
+// valid.
+func errJSONPath(expr, reason string) error {
+	return notice.New("[log entry] invalid JSON path").
+		Append("path", "%s", expr).
+		Append("reason", "%s", reason).
+		Wrap(ErrQuery)
+}
+
+// parseJSONPath parses the JSON path expression into segments.
+func parseJSONPath(expr string) ([]pathSeg, error) {
+	if expr == "" {
+		return nil, errJSONPath(expr, "empty path")
+	}
+	if expr[0] != '$' {
+		parts := strings.Split(expr, ".")
+		segs := make([]pathSeg, 0, len(parts))
+		for i, part := range parts {
+			switch {
+			case part == "":
+				return nil, errJSONPath(expr, "empty segment")
+			case part == "#" && i == len(parts)-1:
+				segs = append(segs, pathSeg{kind: segCount})
+			case part == "#":
+				segs = append(segs, pathSeg{kind: segWild})
+			default:
+				segs = append(segs, pathSeg{kind: segKey, key: part})
+			}
+		}
+		return segs, nil
+	}
+
+	var segs []pathSeg
+	for rest := expr[1:]; rest != ""; {
+		switch {
+		case strings.HasPrefix(rest, ".*"):
+			segs = append(segs, pathSeg{kind: segWild})
+			rest = rest[2:]
+
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			if end == 1 {
+				return nil, errJSONPath(expr, "empty segment")
+			}
+			segs = append(segs, pathSeg{kind: segKey, key: rest[1:end]})
+			rest = rest[end:]
+
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, errJSONPath(expr, "missing ]")
+			}
+			seg, err := parseBracket(expr, rest[1:end])
+			if err != nil {
+				return nil, err
+			}
+			segs = append(segs, seg)
+			rest = rest[end+1:]
+
+		default:
+			return nil, errJSONPath(expr, "unexpected "+strconv.Quote(rest))
+		}
+	}
+	return segs, nil
+}
+
+// parseBracket parses the JSONPath segment in square brackets.
+func parseBracket(expr, sel string) (pathSeg, error) {
+	if sel == "*" {
+		return pathSeg{kind: segWild}, nil
+	}
+	if len(sel) >= 2 && (sel[0] == '\'' || sel[0] == '"') &&
+		sel[len(sel)-1] == sel[0] {
+		return pathSeg{kind: segKey, key: sel[1 : len(sel)-1]}, nil
+	}
+	idx, err := strconv.Atoi(sel)
+	if err != nil {
+		return pathSeg{}, errJSONPath(expr, "invalid index "+strconv.Quote(sel))
+	}
+	return pathSeg{kind: segIndex, idx: idx}, nil
+}
+
+// evalJSONPath returns the value selected by the JSON path segments.
+func evalJSONPath(expr string, val any, segs []pathSeg) (any, error) {
+	if len(segs) == 0 {
+		return val, nil
+	}
+	seg, rest := segs[0], segs[1:]
+	switch v := val.(type) {
+	case map[string]any:
+		switch seg.kind {
+		case segKey:
+			if elem, ok := v[seg.key]; ok {
+				return evalJSONPath(expr, elem, rest)
+			}
+			return nil, errPathMissing(expr, seg)
+
+		case segWild:
+			elems := make([]any, 0, len(v))
+			for _, key := range slices.Sorted(maps.Keys(v)) {
+				elems = append(elems, v[key])
+			}
+			return evalJSONPathAll(expr, elems, rest), nil
+		}
+
+	case []any:
+		switch seg.kind {
+		case segKey, segIndex:
+			idx := seg.idx
+			if seg.kind == segKey {
+				var err error
+				if idx, err = strconv.Atoi(seg.key); err != nil {
+					break
+				}
+			}
+			if idx < 0 {
+				idx += len(v)
+			}
+			if idx >= 0 && idx < len(v) {
+				return evalJSONPath(expr, v[idx], rest)
+			}
+			return nil, errPathMissing(expr, seg)
+
+		case segWild:
+			return evalJSONPathAll(expr, v, rest), nil
+
+		case segCount:
+			return float64(len(v)), nil
+		}
+	}
+	mHeader := "[log entry] expected JSON path segment to be applicable"
+	return nil, notice.New(mHeader).
+		Append("path", "%s", expr).
+		Append("segment", "%s", seg).
+		Append("type", "%T", val).
+		Wrap(ErrType)
+}
+
+// evalJSONPathAll returns the values selected by the JSON path segments for
+// all the values. The values where the path cannot be followed are skipped.
+func evalJSONPathAll(expr string, vals []any, segs []pathSeg) []any {
+	res := make([]any, 0, len(vals))
+	for _, val := range vals {
+		if have, err := evalJSONPath(expr, val, segs); err == nil {
+			res = append(res, have)
+		}
+	}
+	return res
+}
+
+// errPathMissing returns an error for the missing JSON path segment.
+func errPathMissing(expr string, seg pathSeg) error {
+	return notice.New("[log entry] expected log entry to have the path").
+		Append("path", "%s", expr).
+		Append("missing", "%s", seg).
+		Wrap(ErrMissing)
+}
+
+// String returns the segment as it would appear in the JSONPath syntax.
+func (seg pathSeg) String() string {
+	switch seg.kind {
+	case segKey:
+		return seg.key
+	case segIndex:
+		return "[" + strconv.Itoa(seg.idx) + "]"
+	case segWild:
+		return "*"
+	}
+	return "#"
+}
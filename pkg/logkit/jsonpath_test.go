@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_HasJSONPath(t *testing.T) {
+	const lin = `{
+		"a.b": 1,
+		"spans": [
+			{"name": "db", "tags": {"rows": 2}},
+			{"name": "http"}
+		],
+		"user": {"name": "bob"}
+	}`
+
+	tests := []struct {
+		testN string
+
+		expr string
+		want any
+	}{
+		{"gjson key", "user.name", "bob"},
+		{"gjson index", "spans.0.name", "db"},
+		{"gjson nested", "spans.0.tags.rows", 2.0},
+		{"gjson all elements", "spans.#.name", []any{"db", "http"}},
+		{"gjson count", "spans.#", 2.0},
+		{"gjson skips missing", "spans.#.tags.rows", []any{2.0}},
+		{"jsonpath key", "$.user.name", "bob"},
+		{"jsonpath index", "$.spans[1].name", "http"},
+		{"jsonpath negative index", "$.spans[-1].name", "http"},
+		{"jsonpath quoted key", "$['a.b']", 1.0},
+		{"jsonpath double quoted key", `$["user"].name`, "bob"},
+		{"jsonpath wildcard", "$.spans[*].name", []any{"db", "http"}},
+		{"jsonpath object wildcard", "$.user.*", []any{"bob"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ent := MustEntries(nil, lin).ets[0]
+
+			// --- When ---
+			have, err := HasJSONPath(ent, tc.expr)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, have)
+		})
+	}
+
+	t.Run("root", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"A": 1}`).ets[0]
+
+		// --- When ---
+		have, err := HasJSONPath(ent, "$")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"A": 1.0}, have)
+	})
+
+	t.Run("error - missing key", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"user": {"name": "bob"}}`).ets[0]
+
+		// --- When ---
+		have, err := HasJSONPath(ent, "$.user.id")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to have the path:\n" +
+			"     path: $.user.id\n" +
+			"  missing: id"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - index out of range", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"spans": [1]}`).ets[0]
+
+		// --- When ---
+		have, err := HasJSONPath(ent, "$.spans[1]")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to have the path:\n" +
+			"     path: $.spans[1]\n" +
+			"  missing: [1]"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - segment not applicable", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"spans": [1]}`).ets[0]
+
+		// --- When ---
+		have, err := HasJSONPath(ent, "spans.name")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected JSON path segment to be applicable:\n" +
+			"     path: spans.name\n" +
+			"  segment: name\n" +
+			"     type: []interface {}"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrType, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_HasJSONPath_invalid(t *testing.T) {
+	tests := []struct {
+		testN string
+
+		expr   string
+		reason string
+	}{
+		{"empty", "", "empty path"},
+		{"gjson empty segment", "a..b", "empty segment"},
+		{"jsonpath empty segment", "$..a", "empty segment"},
+		{"missing bracket", "$.a[0", "missing ]"},
+		{"invalid index", "$.a[x]", `invalid index "x"`},
+		{"unexpected", "$a", `unexpected "a"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ent := MustEntries(nil, `{"a": [1]}`).ets[0]
+
+			// --- When ---
+			have, err := HasJSONPath(ent, tc.expr)
+
+			// --- Then ---
+			wMsg := "" +
+				"[log entry] invalid JSON path:\n" +
+				"    path: " + tc.expr + "\n" +
+				"  reason: " + tc.reason
+			assert.ErrorEqual(t, wMsg, err)
+			assert.ErrorIs(t, ErrQuery, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_CheckJSONPath(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"spans": [{"rows": 2}]}`).ets[0]
+
+		// --- When ---
+		err := CheckJSONPath("spans.0.rows", 2)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("equal array", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"spans": [{"n": "a"}, {"n": "b"}]}`).ets[0]
+
+		// --- When ---
+		err := CheckJSONPath("$.spans[*].n", []string{"a", "b"})(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"spans": [{"rows": 2}]}`).ets[0]
+
+		// --- When ---
+		err := CheckJSONPath("spans.0.rows", 3)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  path: spans.0.rows\n" +
+			"  want: 3\n" +
+			"  have: 2"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - missing", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{}`).ets[0]
+
+		// --- When ---
+		err := CheckJSONPath("spans.0", 3)(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+	})
+
+	t.Run("error - want not JSON compatible", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"A": 1}`).ets[0]
+
+		// --- When ---
+		err := CheckJSONPath("A", func() {})(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_Entry_JSONPath(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := MustEntries(tspy, `{"spans": [{"name": "db"}]}`).Entry(0)
+
+	// --- When ---
+	have, err := ent.JSONPath("$.spans[0].name")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, "db", have)
+}
+
+func Test_Entry_AssertJSONPath(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"spans": [{"name": "db"}]}`).Entry(0)
+
+		// --- When ---
+		have := ent.AssertJSONPath("spans.0.name", "db")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"  path: spans.0.name\n" +
+			"  want: \"http\"\n" +
+			"  have: \"db\""
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"spans": [{"name": "db"}]}`).Entry(0)
+
+		// --- When ---
+		have := ent.AssertJSONPath("spans.0.name", "http")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// LogT represents a [tester.T] wrapper which writes messages logged with it
+// to a [Tester] as log entries, and passes all calls to the wrapped test
+// manager. It allows asserting on messages logged by helpers which accept a
+// [tester.T].
+//
+// The [Config.LevelField] field of the log entries is set to the info level
+// for Log, Logf and Skip calls, to the error level for Error and Errorf calls,
+// and to the fatal level for Fatal and Fatalf calls. The message is set in the
+// [Config.MessageField] field.
+type LogT struct {
+	tester.T         // Wrapped test manager.
+	tst      *Tester // Tester the messages are written to.
+}
+
+// LogT returns a [LogT] wrapping the given test manager, which writes logged
+// messages to the [Tester].
+//
+// Example usage:
+//
+//	lt := tst.LogT(t)
+//	helperUnderTest(lt)
+//	tst.Filter(logkit.CheckMsg("connected")).AssertLen(1)
+func (tst *Tester) LogT(t tester.T) *LogT {
+	t.Helper()
+	return &LogT{T: t, tst: tst}
+}
+
+// Error writes the message to the [Tester] and calls the wrapped Error.
+func (lt *LogT) Error(args ...any) {
+	lt.T.Helper()
+	lt.write(lt.tst.cfg.LevelErrorValue, sprintln(args...))
+	lt.T.Error(args...)
+}
+
+// Errorf writes the message to the [Tester] and calls the wrapped Errorf.
+func (lt *LogT) Errorf(format string, args ...any) {
+	lt.T.Helper()
+	lt.write(lt.tst.cfg.LevelErrorValue, fmt.Sprintf(format, args...))
+	lt.T.Errorf(format, args...)
+}
+
+// Fatal writes the message to the [Tester] and calls the wrapped Fatal.
+func (lt *LogT) Fatal(args ...any) {
+	lt.T.Helper()
+	lt.write(lt.tst.cfg.LevelFatalValue, sprintln(args...))
+	lt.T.Fatal(args...)
+}
+
+// Fatalf writes the message to the [Tester] and calls the wrapped Fatalf.
+func (lt *LogT) Fatalf(format string, args ...any) {
+	lt.T.Helper()
+	lt.write(lt.tst.cfg.LevelFatalValue, fmt.Sprintf(format, args...))
+	lt.T.Fatalf(format, args...)
+}
+
+// Log writes the message to the [Tester] and calls the wrapped Log.
+func (lt *LogT) Log(args ...any) {
+	lt.T.Helper()
+	lt.write(lt.tst.cfg.LevelInfoValue, sprintln(args...))
+	lt.T.Log(args...)
+}
+
+// Logf writes the message to the [Tester] and calls the wrapped Logf.
+func (lt *LogT) Logf(format string, args ...any) {
+	lt.T.Helper()
+	lt.write(lt.tst.cfg.LevelInfoValue, fmt.Sprintf(format, args...))
+	lt.T.Logf(format, args...)
+}
+
+// Skip writes the message to the [Tester] and calls the wrapped Skip.
+func (lt *LogT) Skip(args ...any) {
+	lt.T.Helper()
+	lt.write(lt.tst.cfg.LevelInfoValue, sprintln(args...))
+	lt.T.Skip(args...)
+}
+
+// write writes the log entry with the given level and message to the
+// [Tester].
+func (lt *LogT) write(level, msg string) {
+	m := map[string]string{
+		lt.tst.cfg.LevelField:   level,
+		lt.tst.cfg.MessageField: strings.TrimSuffix(msg, "\n"),
+	}
+	data, _ := json.Marshal(m) // Map of strings always marshals.
+	_, _ = lt.tst.Write(append(data, '\n'))
+}
+
+// sprintln formats its arguments the same way as [testing.T.Log] does.
+func sprintln(args ...any) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Tester_LogT(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	tst := New(tspy)
+
+	// --- When ---
+	have := tst.LogT(tspy)
+
+	// --- Then ---
+	assert.Same(t, tspy, have.T)
+	assert.Same(t, tst, have.tst)
+}
+
+func Test_LogT_Error(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.ExpectError()
+	tspy.ExpectLogEqual("msg 1")
+	tspy.Close()
+
+	tst := New(tspy)
+	lt := tst.LogT(tspy)
+
+	// --- When ---
+	lt.Error("msg", 1)
+
+	// --- Then ---
+	want := `{"level":"error","message":"msg 1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
+
+func Test_LogT_Errorf(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.ExpectError()
+	tspy.ExpectLogEqual("msg 1")
+	tspy.Close()
+
+	tst := New(tspy)
+	lt := tst.LogT(tspy)
+
+	// --- When ---
+	lt.Errorf("msg %d", 1)
+
+	// --- Then ---
+	want := `{"level":"error","message":"msg 1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
+
+func Test_LogT_Fatal(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.ExpectFatal()
+	tspy.ExpectLogEqual("msg 1")
+	tspy.Close()
+
+	tst := New(tspy)
+	lt := tst.LogT(tspy)
+
+	// --- When ---
+	msg := assert.PanicMsg(t, func() { lt.Fatal("msg", 1) })
+
+	// --- Then ---
+	assert.Equal(t, tester.FailNowMsg, *msg)
+	want := `{"level":"fatal","message":"msg 1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
+
+func Test_LogT_Fatalf(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.ExpectFatal()
+	tspy.ExpectLogEqual("msg 1")
+	tspy.Close()
+
+	tst := New(tspy)
+	lt := tst.LogT(tspy)
+
+	// --- When ---
+	msg := assert.PanicMsg(t, func() { lt.Fatalf("msg %d", 1) })
+
+	// --- Then ---
+	assert.Equal(t, tester.FailNowMsg, *msg)
+	want := `{"level":"fatal","message":"msg 1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
+
+func Test_LogT_Log(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.ExpectLogEqual("msg 1")
+	tspy.Close()
+
+	tst := New(tspy)
+	lt := tst.LogT(tspy)
+
+	// --- When ---
+	lt.Log("msg", 1)
+
+	// --- Then ---
+	want := `{"level":"info","message":"msg 1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
+
+func Test_LogT_Logf(t *testing.T) {
+	t.Run("log", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectLogEqual("msg 1")
+		tspy.Close()
+
+		tst := New(tspy)
+		lt := tst.LogT(tspy)
+
+		// --- When ---
+		lt.Logf("msg %d", 1)
+
+		// --- Then ---
+		want := `{"level":"info","message":"msg 1"}` + "\n"
+		assert.Equal(t, want, tst.String())
+	})
+
+	t.Run("trailing new line is removed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		cfg := ZapConfig()
+		tst := New(tspy, WithConfig(cfg))
+		lt := tst.LogT(tspy)
+
+		// --- When ---
+		lt.Logf("msg\n")
+
+		// --- Then ---
+		want := `{"level":"info","msg":"msg"}` + "\n"
+		assert.Equal(t, want, tst.String())
+	})
+}
+
+func Test_LogT_Skip(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.ExpectSkipped()
+	tspy.ExpectLogEqual("msg 1")
+	tspy.Close()
+
+	tst := New(tspy)
+	lt := tst.LogT(tspy)
+
+	// --- When ---
+	lt.Skip("msg", 1)
+
+	// --- Then ---
+	want := `{"level":"info","message":"msg 1"}` + "\n"
+	assert.Equal(t, want, tst.String())
+}
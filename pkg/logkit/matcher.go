@@ -11,12 +11,24 @@ import (
 	"slices"
 	"sync"
 
+	"github.com/ctx42/testing/pkg/notice"
 	"github.com/ctx42/testing/pkg/tester"
 )
 
 // Checker represents a function which checks a log entry for a condition.
 type Checker func(Entry) error
 
+// runChecks runs all the checks on the provided [Entry]. Returns true if all
+// checks pass; otherwise, returns false.
+func runChecks(ent Entry, checks ...Checker) bool {
+	for _, chk := range checks {
+		if err := chk(ent); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 // Matcher represents log line matcher.
 type Matcher struct {
 	// Log messages fields and their formats.
@@ -25,6 +37,9 @@ type Matcher struct {
 	// Checks to run against the lines.
 	checks []Checker
 
+	// When true, a line or entry matches when any of the checks pass.
+	anyOf bool
+
 	// Number of times the marcher matched a line or entry.
 	cnt int
 
@@ -34,6 +49,15 @@ type Matcher struct {
 	// When not nil, it will be closed when a log line or [Entry] is matched.
 	notify chan Entry
 
+	// When true, notifications are dropped when the notify channel is full.
+	dropOnFull bool
+
+	// Number of notifications dropped because the notify channel was full.
+	dropped int
+
+	// When true, the matcher never matches and is removed from the [Tester].
+	discarded bool
+
 	// Test manager.
 	t tester.T
 }
@@ -49,6 +73,16 @@ func NewMatcher(t tester.T, cfg *Config, checks ...Checker) *Matcher {
 	return &Matcher{cfg: cfg, checks: checks, t: t}
 }
 
+// NewAnyMatcher creates a new [Matcher] instance for the given checks, which
+// matches a log line when any of the checks pass. If no checks are provided,
+// it matches all log lines. If config is nil, [DefaultConfig] is used.
+func NewAnyMatcher(t tester.T, cfg *Config, checks ...Checker) *Matcher {
+	t.Helper()
+	mcr := NewMatcher(t, cfg, checks...)
+	mcr.anyOf = true
+	return mcr
+}
+
 // Checks returns a copy of the checks.
 func (mcr *Matcher) Checks() []Checker {
 	return slices.Clone(mcr.checks)
@@ -61,13 +95,58 @@ func (mcr *Matcher) Matched() int {
 	return mcr.cnt
 }
 
+// ExpectMatches registers a test cleanup function which marks the test as
+// failed if the matcher did not match exactly n lines or entries by the end
+// of the test. Use [Tester.Watch] to run the matcher on the log entries
+// written to a [Tester]. Implements fluent interface.
+func (mcr *Matcher) ExpectMatches(n int) *Matcher {
+	mcr.t.Helper()
+	mcr.t.Cleanup(func() {
+		mcr.t.Helper()
+		if have := mcr.Matched(); have != n {
+			msg := notice.New("[log entry] expected matcher to match N times").
+				Want("%d", n).
+				Have("%d", have)
+			mcr.t.Error(msg)
+		}
+	})
+	return mcr
+}
+
 // Notify returns a channel for notifications when a log line or [Entry]
-// matches. The channel closes automatically when the test ends.
+// matches. The channel closes automatically when the test ends. Sending
+// notifications to the channel blocks until they are received.
 func (mcr *Matcher) Notify() <-chan Entry {
 	mcr.mx.Lock()
 	defer mcr.mx.Unlock()
+	return mcr.notifyChan(0, false)
+}
+
+// NotifyBuffered works like [Matcher.Notify] but returns a channel with a
+// buffer of size n and never blocks when sending notifications. When the
+// buffer is full, the notification is dropped; use [Matcher.Dropped] to get
+// the number of dropped notifications. If the notification channel already
+// exists, it is returned as is.
+func (mcr *Matcher) NotifyBuffered(n int) <-chan Entry {
+	mcr.mx.Lock()
+	defer mcr.mx.Unlock()
+	return mcr.notifyChan(n, true)
+}
+
+// Dropped returns the number of notifications dropped because the channel
+// returned by [Matcher.NotifyBuffered] was full.
+func (mcr *Matcher) Dropped() int {
+	mcr.mx.Lock()
+	defer mcr.mx.Unlock()
+	return mcr.dropped
+}
+
+// notifyChan creates, if needed, and returns the notification channel with
+// a buffer of size n. It must be called with the lock held.
+func (mcr *Matcher) notifyChan(n int, dropOnFull bool) <-chan Entry {
 	if mcr.notify == nil {
-		mcr.notify = make(chan Entry)
+		mcr.notify = make(chan Entry, n)
+		mcr.dropOnFull = dropOnFull
 		mcr.t.Cleanup(func() {
 			mcr.mx.Lock()
 			if mcr.notify != nil {
@@ -80,6 +159,23 @@ func (mcr *Matcher) Notify() <-chan Entry {
 	return mcr.notify
 }
 
+// send sends the entry to the notification channel if it exists. It must be
+// called with the lock held.
+func (mcr *Matcher) send(ent Entry) {
+	if mcr.notify == nil {
+		return
+	}
+	if !mcr.dropOnFull {
+		mcr.notify <- ent
+		return
+	}
+	select {
+	case mcr.notify <- ent:
+	default:
+		mcr.dropped++
+	}
+}
+
 // NotifyStop closes the notification channel returned by [Matcher.Notify].
 func (mcr *Matcher) NotifyStop() {
 	mcr.mx.Lock()
@@ -90,56 +186,100 @@ func (mcr *Matcher) NotifyStop() {
 	mcr.mx.Unlock()
 }
 
+// Discard closes the notification channel returned by [Matcher.Notify] and
+// marks the matcher as discarded. Discarded matchers never match and are
+// removed from the [Tester] on the next write.
+func (mcr *Matcher) Discard() {
+	mcr.mx.Lock()
+	defer mcr.mx.Unlock()
+	mcr.discarded = true
+	if mcr.notify != nil {
+		close(mcr.notify)
+		mcr.notify = nil
+	}
+}
+
+// Discarded returns true if [Matcher.Discard] was called.
+func (mcr *Matcher) Discarded() bool {
+	mcr.mx.Lock()
+	defer mcr.mx.Unlock()
+	return mcr.discarded
+}
+
 // MatchEntry runs all checks on the provided [Entry]. Returns true if all
-// checks pass; otherwise, returns false.
+// checks pass (any check for matchers created with [NewAnyMatcher]);
+// otherwise, returns false. Discarded matcher always returns false.
 //
 // When [Matcher.Notify] is called, it sends the entry to the channel returned
-// if nothing listens on that channel, this call will block.
+// if nothing listens on that channel, this call will block. Use
+// [Matcher.NotifyBuffered] to never block.
 func (mcr *Matcher) MatchEntry(ent Entry) bool {
 	mcr.mx.Lock()
 	defer mcr.mx.Unlock()
 
-	for _, chk := range mcr.checks {
-		if err := chk(ent); err != nil {
-			return false
-		}
+	if mcr.discarded {
+		return false
 	}
-	if mcr.notify != nil {
-		mcr.notify <- ent
+
+	if !mcr.match(ent) {
+		return false
 	}
+	mcr.send(ent)
 	mcr.cnt++
 	return true
 }
 
 // MatchLine decodes a log line into a map[string]any, creates an [Entry], and
-// runs all checks on it. Returns the entry if all checks pass; otherwise,
-// returns a zero-value entry.
+// runs all checks on it. Returns the entry if all checks pass (any check for
+// matchers created with [NewAnyMatcher]); otherwise, returns a zero-value
+// entry. Discarded matcher always returns a zero-value
+// entry.
 func (mcr *Matcher) MatchLine(idx int, line []byte) Entry {
 	mcr.mx.Lock()
 	defer mcr.mx.Unlock()
 
-	line = bytes.TrimSpace(line)
-	dst := make(map[string]any)
-	if err := json.Unmarshal(line, &dst); err != nil {
-		mcr.t.Error(fmt.Errorf("matcher line %d: %w", idx, err))
+	if mcr.discarded {
 		return ZeroEntry(mcr.t, mcr.cfg)
 	}
 
-	ent := Entry{
-		cfg: mcr.cfg,
-		raw: string(line),
-		m:   maps.Clone(dst),
-		idx: idx,
-		t:   mcr.t,
-	}
-	for _, chk := range mcr.checks {
-		if err := chk(ent); err != nil {
+	var ent Entry
+	if mcr.cfg.LineDecoder != nil {
+		var err error
+		if ent, err = decodeEntry(mcr.cfg, line); err != nil {
+			mcr.t.Error(fmt.Errorf("matcher line %d: %w", idx, err))
+			return ZeroEntry(mcr.t, mcr.cfg)
+		}
+		ent.idx, ent.t = idx, mcr.t
+	} else {
+		line = bytes.TrimSpace(line)
+		dst := make(map[string]any)
+		if err := json.Unmarshal(line, &dst); err != nil {
+			mcr.t.Error(fmt.Errorf("matcher line %d: %w", idx, err))
 			return ZeroEntry(mcr.t, mcr.cfg)
 		}
+		ent = Entry{
+			cfg:   mcr.cfg,
+			raw:   string(line),
+			m:     maps.Clone(dst),
+			idx:   idx,
+			t:     mcr.t,
+			exact: &exactFields{},
+		}
 	}
-	if mcr.notify != nil {
-		mcr.notify <- ent
+	if !mcr.match(ent) {
+		return ZeroEntry(mcr.t, mcr.cfg)
 	}
+	mcr.send(ent)
 	mcr.cnt++
 	return ent
 }
+
+// match runs the matcher checks on the provided [Entry]. Returns true if all
+// checks pass or, for matchers created with [NewAnyMatcher], if any of the
+// checks pass or there are no checks.
+func (mcr *Matcher) match(ent Entry) bool {
+	if !mcr.anyOf || len(mcr.checks) == 0 {
+		return runChecks(ent, mcr.checks...)
+	}
+	return CheckAnyOf(mcr.checks...)(ent) == nil
+}
@@ -10,6 +10,41 @@ import (
 	"github.com/ctx42/testing/pkg/tester"
 )
 
+func Test_runChecks(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": "b"}}
+
+		// --- When ---
+		have := runChecks(ent, CheckStr("A", "a"), CheckStr("B", "b"))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("no checks", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a"}}
+
+		// --- When ---
+		have := runChecks(ent)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("one of the checks fails", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{m: map[string]any{"A": "a", "B": "b"}}
+
+		// --- When ---
+		have := runChecks(ent, CheckStr("A", "a"), CheckStr("B", "x"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
 func Test_NewMatcher(t *testing.T) {
 	t.Run("no checks", func(t *testing.T) {
 		// --- Given ---
@@ -60,6 +95,25 @@ func Test_NewMatcher(t *testing.T) {
 	})
 }
 
+func Test_NewAnyMatcher(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	cfg := DefaultConfig()
+	fn := func(Entry) error { return nil }
+
+	// --- When ---
+	mcr := NewAnyMatcher(tspy, cfg, fn)
+
+	// --- Then ---
+	assert.Same(t, cfg, mcr.cfg)
+	assert.Len(t, 1, mcr.checks)
+	assert.True(t, mcr.anyOf)
+	assert.Equal(t, 0, mcr.cnt)
+	assert.Same(t, tspy, mcr.t)
+}
+
 func Test_Matcher_Checks(t *testing.T) {
 	// --- Given ---
 	tspy := tester.New(t)
@@ -90,6 +144,66 @@ func Test_Matcher_Matched(t *testing.T) {
 	assert.Equal(t, 42, have)
 }
 
+func Test_Matcher_ExpectMatches(t *testing.T) {
+	lin := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+
+	t.Run("matched expected number of times", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil, CheckInfo())
+
+		// --- When ---
+		have := mcr.ExpectMatches(2)
+
+		// --- Then ---
+		assert.Same(t, mcr, have)
+		mcr.MatchLine(0, lin)
+		mcr.MatchLine(1, lin)
+		tspy.Finish()
+	})
+
+	t.Run("never matched", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil, CheckError())
+
+		// --- When ---
+		mcr.ExpectMatches(0)
+
+		// --- Then ---
+		mcr.MatchLine(0, lin)
+		tspy.Finish()
+	})
+
+	t.Run("error - matched different number of times", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected matcher to match N times:\n" +
+			"  want: 2\n" +
+			"  have: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil, CheckInfo())
+
+		// --- When ---
+		mcr.ExpectMatches(2)
+
+		// --- Then ---
+		mcr.MatchLine(0, lin)
+		tspy.Finish()
+	})
+}
+
 func Test_Matcher_Notify(t *testing.T) {
 	t.Run("returned chan is closed at the test end", func(t *testing.T) {
 		// --- Given ---
@@ -124,6 +238,98 @@ func Test_Matcher_Notify(t *testing.T) {
 	})
 }
 
+func Test_Matcher_NotifyBuffered(t *testing.T) {
+	t.Run("returned chan is buffered", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil)
+
+		// --- When ---
+		have := mcr.NotifyBuffered(2)
+
+		// --- Then ---
+		assert.Equal(t, 2, cap(have))
+		assert.True(t, mcr.dropOnFull)
+		tspy.Finish()
+		assert.ChannelWillClose(t, "1s", have)
+	})
+
+	t.Run("returns existing chan", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil)
+		have0 := mcr.Notify()
+
+		// --- When ---
+		have1 := mcr.NotifyBuffered(2)
+
+		// --- Then ---
+		assert.Same(t, have0, have1)
+		assert.False(t, mcr.dropOnFull)
+	})
+
+	t.Run("drops notifications when full", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil)
+		notify := mcr.NotifyBuffered(1)
+
+		// --- When ---
+		have0 := mcr.MatchLine(0, []byte(`{"message":"msg0"}`))
+		have1 := mcr.MatchLine(1, []byte(`{"message":"msg1"}`))
+		have2 := mcr.MatchEntry(have1)
+
+		// --- Then ---
+		assert.False(t, have0.IsZero())
+		assert.False(t, have1.IsZero())
+		assert.True(t, have2)
+		assert.Equal(t, 3, mcr.Matched())
+		assert.Equal(t, 2, mcr.Dropped())
+		assert.Equal(t, have0, <-notify)
+	})
+
+	t.Run("zero buffer drops without a listener", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil)
+		mcr.NotifyBuffered(0)
+
+		// --- When ---
+		have := mcr.MatchLine(0, []byte(`{"message":"msg0"}`))
+
+		// --- Then ---
+		assert.False(t, have.IsZero())
+		assert.Equal(t, 1, mcr.Dropped())
+	})
+}
+
+func Test_Matcher_Dropped(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	mcr := NewMatcher(tspy, nil)
+	mcr.dropped = 2
+
+	// --- When ---
+	have := mcr.Dropped()
+
+	// --- Then ---
+	assert.Equal(t, 2, have)
+}
+
 func Test_Matcher_NotifyStop(t *testing.T) {
 	t.Run("existing notification", func(t *testing.T) {
 		// --- Given ---
@@ -154,7 +360,90 @@ func Test_Matcher_NotifyStop(t *testing.T) {
 	})
 }
 
+func Test_Matcher_Discard(t *testing.T) {
+	t.Run("existing notification", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil)
+		notify := mcr.Notify()
+
+		// --- When ---
+		mcr.Discard()
+
+		// --- Then ---
+		_, open := <-notify
+		assert.False(t, open)
+		assert.True(t, mcr.discarded)
+	})
+
+	t.Run("call without a previous call to Notify", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil)
+
+		// --- When ---
+		mcr.Discard()
+
+		// --- Then ---
+		assert.True(t, mcr.discarded)
+	})
+}
+
+func Test_Matcher_Discarded(t *testing.T) {
+	t.Run("not discarded", func(t *testing.T) {
+		// --- Given ---
+		mcr := &Matcher{}
+
+		// --- When ---
+		have := mcr.Discarded()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("discarded", func(t *testing.T) {
+		// --- Given ---
+		mcr := &Matcher{discarded: true}
+
+		// --- When ---
+		have := mcr.Discarded()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+}
+
 func Test_Matcher_MatchEntry(t *testing.T) {
+	t.Run("discarded matcher does not match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level":"info", "str":"abc", "message":"msg0"}`
+		ent := Entry{
+			cfg: DefaultConfig(),
+			raw: lin,
+			m:   JSON2Map(t, lin),
+			idx: 1,
+			t:   tspy,
+		}
+
+		mcr := NewMatcher(tspy, nil)
+		mcr.Discard()
+
+		// --- When ---
+		have := mcr.MatchEntry(ent)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 0, mcr.cnt)
+	})
+
 	t.Run("without checks", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
@@ -302,7 +591,76 @@ func Test_Matcher_MatchEntry(t *testing.T) {
 	})
 }
 
+func Test_Matcher_MatchEntry_anyOf(t *testing.T) {
+	lin := `{"level":"info", "str":"abc", "message":"msg0"}`
+
+	t.Run("without checks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := Entry{cfg: DefaultConfig(), m: JSON2Map(t, lin), t: tspy}
+		mcr := NewAnyMatcher(tspy, nil)
+
+		// --- When ---
+		have := mcr.MatchEntry(ent)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, 1, mcr.cnt)
+	})
+
+	t.Run("one check passes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := Entry{cfg: DefaultConfig(), m: JSON2Map(t, lin), t: tspy}
+		mcr := NewAnyMatcher(tspy, nil, CheckError(), CheckStr("str", "abc"))
+
+		// --- When ---
+		have := mcr.MatchEntry(ent)
+
+		// --- Then ---
+		assert.True(t, have)
+		assert.Equal(t, 1, mcr.cnt)
+	})
+
+	t.Run("no check passes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := Entry{cfg: DefaultConfig(), m: JSON2Map(t, lin), t: tspy}
+		mcr := NewAnyMatcher(tspy, nil, CheckError(), CheckStr("str", "xyz"))
+
+		// --- When ---
+		have := mcr.MatchEntry(ent)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 0, mcr.cnt)
+	})
+}
+
 func Test_Matcher_MatchLine(t *testing.T) {
+	t.Run("discarded matcher does not match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level":"info", "str":"abc", "message":"msg0"}`
+		mcr := NewMatcher(tspy, nil)
+		mcr.Discard()
+
+		// --- When ---
+		have := mcr.MatchLine(1, []byte(lin))
+
+		// --- Then ---
+		assert.True(t, have.IsZero())
+		assert.Equal(t, 0, mcr.cnt)
+	})
+
 	t.Run("without checks", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
@@ -430,3 +788,37 @@ func Test_Matcher_MatchLine(t *testing.T) {
 		assert.Zero(t, have)
 	})
 }
+
+func Test_Matcher_MatchLine_anyOf(t *testing.T) {
+	t.Run("one check passes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := []byte(`{"level":"error", "str":"abc", "message":"msg0"}`)
+		mcr := NewAnyMatcher(tspy, nil, CheckError(), CheckStr("str", "xyz"))
+
+		// --- When ---
+		have := mcr.MatchLine(0, lin)
+
+		// --- Then ---
+		assert.False(t, have.IsZero())
+		assert.Equal(t, 1, mcr.cnt)
+	})
+
+	t.Run("no check passes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		mcr := NewAnyMatcher(tspy, nil, CheckError(), CheckStr("str", "xyz"))
+
+		// --- When ---
+		have := mcr.MatchLine(0, lin)
+
+		// --- Then ---
+		assert.True(t, have.IsZero())
+		assert.Equal(t, 0, mcr.cnt)
+	})
+}
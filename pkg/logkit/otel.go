@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ctx42/testing/pkg/check"
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Names of the OpenTelemetry log data model fields.
+const (
+	OTelTimestamp         = "Timestamp"
+	OTelObservedTimestamp = "ObservedTimestamp"
+	OTelSeverityText      = "SeverityText"
+	OTelSeverityNumber    = "SeverityNumber"
+	OTelBody              = "Body"
+	OTelAttributes        = "Attributes"
+	OTelTraceID           = "TraceId"
+	OTelSpanID            = "SpanId"
+	OTelEventName         = "EventName"
+	OTelResource          = "Resource"
+	OTelScope             = "InstrumentationScope"
+)
+
+// Lowest OpenTelemetry severity numbers of the severity ranges.
+const (
+	SeverityTrace = 1
+	SeverityDebug = 5
+	SeverityInfo  = 9
+	SeverityWarn  = 13
+	SeverityError = 17
+	SeverityFatal = 21
+)
+
+// OTelConfig returns the instance of [Config] configured for log records
+// following the OpenTelemetry log data model. The level is taken from the
+// [OTelSeverityNumber] field, see [OTelLevels].
+func OTelConfig() *Config {
+	return &Config{
+		TimeField:    OTelTimestamp,
+		LevelField:   OTelSeverityNumber,
+		MessageField: OTelBody,
+		ErrorField:   "", // Not used in OpenTelemetry.
+
+		ServiceField:   "", // Not used in OpenTelemetry.
+		ComponentField: "", // Not used in OpenTelemetry.
+		CallerField:    "", // Not used in OpenTelemetry.
+		StackField:     "", // Not used in OpenTelemetry.
+
+		TimeFormat:   time.RFC3339Nano,
+		DurationUnit: time.Millisecond,
+
+		LevelTraceValue: "trace",
+		LevelDebugValue: "debug",
+		LevelInfoValue:  "info",
+		LevelWarnValue:  "warn",
+		LevelErrorValue: "error",
+		LevelFatalValue: "fatal",
+		LevelPanicValue: "panic", // Not supported by OpenTelemetry.
+
+		LevelParser: OTelLevels,
+	}
+}
+
+// OTelLevels is a [Config.LevelParser] which converts OpenTelemetry severity
+// numbers to level names, each severity range (e.g. 17-20 for errors) maps
+// to one level. String level values are returned as they are. For any other
+// values or numbers outside the 1-24 range, it returns an error.
+func OTelLevels(val any) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || v < SeverityTrace || v > SeverityFatal+3 {
+			return "", fmt.Errorf("unknown severity number: %v", v)
+		}
+		names := []string{"trace", "debug", "info", "warn", "error", "fatal"}
+		return names[(int(v)-1)/4], nil
+	default:
+		return "", fmt.Errorf("expected severity number, got %T", val)
+	}
+}
+
+// HasAttr checks if the [OTelAttributes] field exists in the Entry's map of
+// fields and has the given attribute. Attribute names are not split on dots.
+// If the field or attribute is missing, it returns nil and error having
+// [ErrMissing] in its chain. If the field is not an object, it returns nil
+// and error having [ErrType] in its chain. Otherwise, it returns the
+// attribute value and a nil error.
+func HasAttr(ent Entry, name string) (any, error) {
+	attrs, err := HasMap(ent, OTelAttributes)
+	if err != nil {
+		return nil, err
+	}
+	val, ok := attrs[name]
+	if !ok {
+		mHeader := "[log entry] expected log entry to have attribute"
+		return nil, notice.New(mHeader).
+			Append("field", "%s", OTelAttributes).
+			Append("attribute", "%s", name).
+			Wrap(ErrMissing)
+	}
+	return val, nil
+}
+
+// CheckSeverityAtLeast returns a function that takes an [Entry] and checks
+// if the [OTelSeverityNumber] field exists with an integer value greater or
+// equal to the given severity number. Returns nil if the field exists, is an
+// integer, and is at least the given number. Returns [ErrMissing], [ErrType],
+// or [ErrValue] if the field is missing, not an integer, or lower than the
+// given number, respectively.
+func CheckSeverityAtLeast(want int) Checker {
+	return func(ent Entry) error {
+		have, err := HasInt(ent, OTelSeverityNumber)
+		if err != nil {
+			return err
+		}
+		if have < want {
+			mHeader := "[log entry] expected log entry severity number at least"
+			return notice.New(mHeader).
+				Append("field", "%s", OTelSeverityNumber).
+				Want("%d", want).
+				Have("%d", have).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckAttr returns a function that takes an [Entry] and checks if the
+// [OTelAttributes] field has the given attribute, see [HasAttr], equal to
+// the given value. Returns nil if the attribute exists and matches. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the attribute is missing, the
+// field is not an object, or the attribute does not match, respectively.
+func CheckAttr(name string, want any) Checker {
+	return func(ent Entry) error {
+		have, err := HasAttr(ent, name)
+		if err != nil {
+			return err
+		}
+		if err = check.Equal(want, have); err != nil {
+			return notice.From(err, "log entry").
+				Prepend("attribute", "%s", name).
+				Prepend("field", "%s", OTelAttributes).
+				Wrap(ErrValue)
+		}
+		return nil
+	}
+}
+
+// CheckTraceID returns a function that takes an [Entry] and checks if the
+// [OTelTraceID] field exists with a string value equal to the given value.
+// Returns nil if the field exists, is a string, and matches. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a
+// string, or does not match, respectively.
+func CheckTraceID(want string) Checker { return CheckStr(OTelTraceID, want) }
+
+// CheckSpanID returns a function that takes an [Entry] and checks if the
+// [OTelSpanID] field exists with a string value equal to the given value.
+// Returns nil if the field exists, is a string, and matches. Returns
+// [ErrMissing], [ErrType], or [ErrValue] if the field is missing, not a
+// string, or does not match, respectively.
+func CheckSpanID(want string) Checker { return CheckStr(OTelSpanID, want) }
+
+// SeverityNumber retrieves the value of the [OTelSeverityNumber] field.
+// Returns the number and nil error if the field exists and is an integer. If
+// the field is missing or not an integer, it returns 0 and [ErrMissing] or
+// [ErrType], respectively.
+func (ent Entry) SeverityNumber() (int, error) {
+	ent.t.Helper()
+	return HasInt(ent, OTelSeverityNumber)
+}
+
+// SeverityText retrieves the value of the [OTelSeverityText] field. Returns
+// the text and nil error if the field exists and is a string. If the field
+// is missing or not a string, it returns an empty string and [ErrMissing] or
+// [ErrType], respectively.
+func (ent Entry) SeverityText() (string, error) {
+	ent.t.Helper()
+	return HasStr(ent, OTelSeverityText)
+}
+
+// Body retrieves the value of the [OTelBody] field, which may be of any
+// type. Returns the value and nil error if the field exists. If the field is
+// missing, it returns nil and [ErrMissing].
+func (ent Entry) Body() (any, error) {
+	ent.t.Helper()
+	return HasPath(ent, OTelBody)
+}
+
+// Attributes retrieves the value of the [OTelAttributes] field. Returns the
+// attributes and nil error if the field exists and is an object. If the
+// field is missing or not an object, it returns nil and [ErrMissing] or
+// [ErrType], respectively.
+func (ent Entry) Attributes() (map[string]any, error) {
+	ent.t.Helper()
+	return HasMap(ent, OTelAttributes)
+}
+
+// Attr retrieves the value of the attribute, see [HasAttr].
+func (ent Entry) Attr(name string) (any, error) {
+	ent.t.Helper()
+	return HasAttr(ent, name)
+}
+
+// TraceID retrieves the value of the [OTelTraceID] field. Returns the ID and
+// nil error if the field exists and is a string. If the field is missing or
+// not a string, it returns an empty string and [ErrMissing] or [ErrType],
+// respectively.
+func (ent Entry) TraceID() (string, error) {
+	ent.t.Helper()
+	return HasStr(ent, OTelTraceID)
+}
+
+// SpanID retrieves the value of the [OTelSpanID] field. Returns the ID and
+// nil error if the field exists and is a string. If the field is missing or
+// not a string, it returns an empty string and [ErrMissing] or [ErrType],
+// respectively.
+func (ent Entry) SpanID() (string, error) {
+	ent.t.Helper()
+	return HasStr(ent, OTelSpanID)
+}
+
+// AssertSeverityAtLeast asserts that the log entry's [OTelSeverityNumber] is
+// greater or equal to the given severity number, see [SeverityError] and
+// other constants. Returns true if the field exists and is at least the
+// given number. If the field is missing or lower, it marks the test as
+// failed, logs an error message, and returns false.
+func (ent Entry) AssertSeverityAtLeast(want int) bool {
+	ent.t.Helper()
+	if err := CheckSeverityAtLeast(want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertSeverityText asserts that the log entry's [OTelSeverityText] matches
+// the expected value. Returns true if the field exists and matches. If the
+// field is missing or the value doesn't match, it marks the test as failed,
+// logs an error message, and returns false.
+func (ent Entry) AssertSeverityText(want string) bool {
+	ent.t.Helper()
+	return ent.AssertStr(OTelSeverityText, want)
+}
+
+// AssertBody asserts that the log entry's [OTelBody] is equal to the
+// expected value. Returns true if the field exists and matches. If the field
+// is missing or the value doesn't match, it marks the test as failed, logs
+// an error message, and returns false.
+func (ent Entry) AssertBody(want any) bool {
+	ent.t.Helper()
+	return ent.AssertPath(OTelBody, want)
+}
+
+// AssertAttr asserts that the log entry's attribute, see [HasAttr], is equal
+// to the expected value. Returns true if the attribute exists and matches.
+// If the attribute is missing or the value doesn't match, it marks the test
+// as failed, logs an error message, and returns false.
+func (ent Entry) AssertAttr(name string, want any) bool {
+	ent.t.Helper()
+	if err := CheckAttr(name, want)(ent); err != nil {
+		ent.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// AssertTraceID asserts that the log entry's [OTelTraceID] matches the
+// expected value. Returns true if the field exists and matches. If the field
+// is missing or the value doesn't match, it marks the test as failed, logs
+// an error message, and returns false.
+func (ent Entry) AssertTraceID(want string) bool {
+	ent.t.Helper()
+	return ent.AssertStr(OTelTraceID, want)
+}
+
+// AssertSpanID asserts that the log entry's [OTelSpanID] matches the
+// expected value. Returns true if the field exists and matches. If the field
+// is missing or the value doesn't match, it marks the test as failed, logs
+// an error message, and returns false.
+func (ent Entry) AssertSpanID(want string) bool {
+	ent.t.Helper()
+	return ent.AssertStr(OTelSpanID, want)
+}
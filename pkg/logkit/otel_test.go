@@ -0,0 +1,344 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// otelLine is an example log record in OpenTelemetry log data model.
+const otelLine = `{"Timestamp":"2025-01-02T03:04:05.123456789Z",` +
+	`"SeverityText":"ERROR","SeverityNumber":17,"Body":"msg",` +
+	`"Attributes":{"http.method":"GET","http.status_code":500},` +
+	`"TraceId":"4bf92f3577b34da6a3ce929d0e0e4736",` +
+	`"SpanId":"00f067aa0ba902b7"}`
+
+// otelEntry returns [otelLine] entry using [OTelConfig].
+func otelEntry(t tester.T) Entry {
+	return New(t, WithConfig(OTelConfig()), WithString(otelLine)).FirstEntry()
+}
+
+func Test_OTelConfig(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	// --- When ---
+	ent := otelEntry(tspy)
+
+	// --- Then ---
+	want := time.Date(2025, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	assert.True(t, ent.AssertLevel("error"))
+	assert.True(t, ent.AssertMsg("msg"))
+	assert.True(t, ent.AssertLoggedWithin(want, "0s"))
+	assert.NoError(t, OTelConfig().Validate())
+}
+
+func Test_OTelLevels(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val  any
+		want string
+	}{
+		{"trace", 1.0, "trace"},
+		{"trace4", 4.0, "trace"},
+		{"debug", 5.0, "debug"},
+		{"info", 9.0, "info"},
+		{"warn", 16.0, "warn"},
+		{"error", 17.0, "error"},
+		{"fatal", 24.0, "fatal"},
+		{"string", "INFO", "INFO"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := OTelLevels(tc.val)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, have)
+		})
+	}
+
+	t.Run("error - out of range", func(t *testing.T) {
+		// --- When ---
+		have, err := OTelLevels(25.0)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "unknown severity number: 25", err)
+		assert.Empty(t, have)
+	})
+
+	t.Run("error - not a number", func(t *testing.T) {
+		// --- When ---
+		have, err := OTelLevels(true)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected severity number, got bool", err)
+		assert.Empty(t, have)
+	})
+}
+
+func Test_HasAttr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		have, err := HasAttr(ent, "http.method")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "GET", have)
+	})
+
+	t.Run("error - missing attribute", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		have, err := HasAttr(ent, "http.route")
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to have attribute:\n" +
+			"      field: Attributes\n" +
+			"  attribute: http.route"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - missing attributes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"Body":"msg"}`).Entry(0)
+
+		// --- When ---
+		have, err := HasAttr(ent, "http.method")
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrMissing, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_CheckSeverityAtLeast(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		err := CheckSeverityAtLeast(SeverityError)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("greater", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		err := CheckSeverityAtLeast(SeverityWarn)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - lower", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		err := CheckSeverityAtLeast(SeverityFatal)(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry severity number at least:\n" +
+			"  field: SeverityNumber\n" +
+			"   want: 21\n" +
+			"   have: 17"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckAttr(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		err := CheckAttr("http.status_code", 500.0)(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not equal", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		err := CheckAttr("http.method", "POST")(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected values to be equal:\n" +
+			"      field: Attributes\n" +
+			"  attribute: http.method\n" +
+			"       want: \"POST\"\n" +
+			"       have: \"GET\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_CheckTraceID(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := otelEntry(tspy)
+
+	// --- When ---
+	err := CheckTraceID("4bf92f3577b34da6a3ce929d0e0e4736")(ent)
+
+	// --- Then ---
+	assert.NoError(t, err)
+}
+
+func Test_CheckSpanID(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := otelEntry(tspy)
+
+	// --- When ---
+	err := CheckSpanID("abc")(ent)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrValue, err)
+}
+
+func Test_Entry_OTelFields(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := otelEntry(tspy)
+
+	// --- When ---
+	num := must.Value(ent.SeverityNumber())
+	txt := must.Value(ent.SeverityText())
+	body := must.Value(ent.Body())
+	attrs := must.Value(ent.Attributes())
+	attr := must.Value(ent.Attr("http.method"))
+	tid := must.Value(ent.TraceID())
+	sid := must.Value(ent.SpanID())
+
+	// --- Then ---
+	assert.Equal(t, 17, num)
+	assert.Equal(t, "ERROR", txt)
+	assert.Equal(t, "msg", body)
+	assert.Len(t, 2, attrs)
+	assert.Equal(t, "GET", attr)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", tid)
+	assert.Equal(t, "00f067aa0ba902b7", sid)
+}
+
+func Test_Entry_AssertSeverityAtLeast(t *testing.T) {
+	t.Run("at least", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		have := ent.AssertSeverityAtLeast(SeverityWarn)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - lower", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("   want: 21\n   have: 17")
+		tspy.Close()
+
+		ent := otelEntry(tspy)
+
+		// --- When ---
+		have := ent.AssertSeverityAtLeast(SeverityFatal)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Entry_OTelAsserts(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	ent := otelEntry(tspy)
+
+	// --- Then ---
+	assert.True(t, ent.AssertSeverityText("ERROR"))
+	assert.True(t, ent.AssertBody("msg"))
+	assert.True(t, ent.AssertAttr("http.method", "GET"))
+	assert.True(t, ent.AssertTraceID("4bf92f3577b34da6a3ce929d0e0e4736"))
+	assert.True(t, ent.AssertSpanID("00f067aa0ba902b7"))
+}
+
+func Test_Entry_AssertAttr(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.ExpectError()
+	tspy.ExpectLogContain("  attribute: http.method\n")
+	tspy.Close()
+
+	ent := otelEntry(tspy)
+
+	// --- When ---
+	have := ent.AssertAttr("http.method", "POST")
+
+	// --- Then ---
+	assert.False(t, have)
+}
@@ -0,0 +1,521 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// OTLPLogsPath is the path of the OTLP/HTTP logs endpoint.
+const OTLPLogsPath = "/v1/logs"
+
+// NewOTLPReceiver creates a new [Tester] configured with [OTelConfig] and
+// starts an OTLP/HTTP logs endpoint feeding it until the test ends. Returns
+// the base URL of the endpoint, which serves export requests on the
+// [OTLPLogsPath] path, and the [Tester]. Both the binary protobuf and the
+// JSON encoded requests, optionally gzip compressed, are supported. Each
+// received log record is captured as a log entry following the OpenTelemetry
+// log data model, see [OTelTimestamp] and other field names, with the
+// resource and instrumentation scope set in the [OTelResource] and
+// [OTelScope] fields. The options are applied to the created [Tester], see
+// [New]. Invalid export requests are rejected and fail the test. Errors
+// returned by the [Tester], see [WithWriteError], are responded with the 500
+// status code.
+//
+// Example usage:
+//
+//	url, tst := logkit.NewOTLPReceiver(t)
+//	// Start the service with OTEL_EXPORTER_OTLP_ENDPOINT set to url.
+//	tst.WaitFor("5s", logkit.CheckAttr("user", "alice"))
+func NewOTLPReceiver(t tester.T, opts ...func(*Tester)) (string, *Tester) {
+	t.Helper()
+	opts = append([]func(*Tester){WithConfig(OTelConfig())}, opts...)
+	tst := New(t, opts...)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(OTLPLogsPath, tst.otlpLogs)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv.URL, tst
+}
+
+// otlpLogs handles the OTLP/HTTP logs export requests.
+func (tst *Tester) otlpLogs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	typ, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if typ != "application/x-protobuf" && typ != "application/json" {
+		code := http.StatusUnsupportedMediaType
+		http.Error(w, "unsupported content type", code)
+		return
+	}
+
+	lines, err := otlpRead(r, typ)
+	if err != nil {
+		tst.t.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, line := range lines {
+		if _, err = tst.Write(append(line, '\n')); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", typ)
+	if typ == "application/json" {
+		_, _ = w.Write([]byte("{}"))
+	}
+}
+
+// otlpRead reads the OTLP logs export request and returns its log records
+// as JSON log entries.
+func otlpRead(r *http.Request, typ string) ([][]byte, error) {
+	errFn := func(reason string) error {
+		return notice.New("[otlp] expected OTLP logs export request").
+			Append("reason", "%s", reason).
+			Wrap(ErrFormat)
+	}
+
+	buf, err := httpBody(r)
+	if err != nil {
+		return nil, errFn(err.Error())
+	}
+
+	var req otlpRequest
+	if typ == "application/json" {
+		err = json.Unmarshal(buf, &req)
+	} else {
+		err = req.decode(buf)
+	}
+	if err != nil {
+		return nil, errFn(err.Error())
+	}
+
+	var lines [][]byte
+	for _, rl := range req.ResourceLogs {
+		for _, sl := range rl.ScopeLogs {
+			for _, rec := range sl.LogRecords {
+				m := rec.entry()
+				if attrs := otlpAttrs(rl.Resource.Attributes); attrs != nil {
+					m[OTelResource] = attrs
+				}
+				if scope := sl.Scope.entry(); len(scope) > 0 {
+					m[OTelScope] = scope
+				}
+				data, err := json.Marshal(m)
+				if err != nil {
+					return nil, errFn(err.Error())
+				}
+				lines = append(lines, data)
+			}
+		}
+	}
+	return lines, nil
+}
+
+// otlpRequest represents the OTLP logs export request.
+type otlpRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+// otlpResourceLogs represents the log records produced by a resource.
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+// otlpResource represents the entity producing the log records.
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+// otlpScopeLogs represents the log records produced by an instrumentation
+// scope.
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+// otlpScope represents the instrumentation scope.
+type otlpScope struct {
+	Name       string         `json:"name"`
+	Version    string         `json:"version"`
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+// otlpLogRecord represents the log record.
+type otlpLogRecord struct {
+	TimeUnixNano         json.Number    `json:"timeUnixNano"`
+	ObservedTimeUnixNano json.Number    `json:"observedTimeUnixNano"`
+	SeverityNumber       int            `json:"severityNumber"`
+	SeverityText         string         `json:"severityText"`
+	Body                 *otlpAnyValue  `json:"body"`
+	Attributes           []otlpKeyValue `json:"attributes"`
+	TraceID              string         `json:"traceId"`
+	SpanID               string         `json:"spanId"`
+	EventName            string         `json:"eventName"`
+}
+
+// otlpKeyValue represents the attribute.
+type otlpKeyValue struct {
+	Key   string        `json:"key"`
+	Value *otlpAnyValue `json:"value"`
+}
+
+// otlpAnyValue represents the attribute or body value, only one of the
+// fields is set.
+type otlpAnyValue struct {
+	StringValue *string      `json:"stringValue"`
+	BoolValue   *bool        `json:"boolValue"`
+	IntValue    *json.Number `json:"intValue"`
+	DoubleValue *float64     `json:"doubleValue"`
+	ArrayValue  *otlpArray   `json:"arrayValue"`
+	KvlistValue *otlpKVList  `json:"kvlistValue"`
+	BytesValue  []byte       `json:"bytesValue"`
+}
+
+// otlpArray represents the array value.
+type otlpArray struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+// otlpKVList represents the key-value list value.
+type otlpKVList struct {
+	Values []otlpKeyValue `json:"values"`
+}
+
+// entry returns the log record as log entry fields.
+func (rec otlpLogRecord) entry() map[string]any {
+	m := make(map[string]any)
+	tim := otlpTime(rec.TimeUnixNano)
+	obs := otlpTime(rec.ObservedTimeUnixNano)
+	if tim == "" {
+		tim = obs
+	}
+	set := func(name string, val any, ok bool) {
+		if ok {
+			m[name] = val
+		}
+	}
+	set(OTelTimestamp, tim, tim != "")
+	set(OTelObservedTimestamp, obs, obs != "")
+	set(OTelSeverityText, rec.SeverityText, rec.SeverityText != "")
+	set(OTelSeverityNumber, rec.SeverityNumber, rec.SeverityNumber != 0)
+	set(OTelBody, rec.Body.value(), rec.Body != nil)
+	attrs := otlpAttrs(rec.Attributes)
+	set(OTelAttributes, attrs, attrs != nil)
+	set(OTelTraceID, rec.TraceID, rec.TraceID != "")
+	set(OTelSpanID, rec.SpanID, rec.SpanID != "")
+	set(OTelEventName, rec.EventName, rec.EventName != "")
+	return m
+}
+
+// entry returns the instrumentation scope as log entry fields.
+func (scp otlpScope) entry() map[string]any {
+	m := make(map[string]any)
+	if scp.Name != "" {
+		m["Name"] = scp.Name
+	}
+	if scp.Version != "" {
+		m["Version"] = scp.Version
+	}
+	if attrs := otlpAttrs(scp.Attributes); attrs != nil {
+		m["Attributes"] = attrs
+	}
+	return m
+}
+
+// value returns the Go representation of the value.
+func (val *otlpAnyValue) value() any {
+	switch {
+	case val == nil:
+		return nil
+	case val.StringValue != nil:
+		return *val.StringValue
+	case val.BoolValue != nil:
+		return *val.BoolValue
+	case val.IntValue != nil:
+		return *val.IntValue
+	case val.DoubleValue != nil:
+		return *val.DoubleValue
+	case val.ArrayValue != nil:
+		vals := make([]any, 0, len(val.ArrayValue.Values))
+		for _, elem := range val.ArrayValue.Values {
+			vals = append(vals, elem.value())
+		}
+		return vals
+	case val.KvlistValue != nil:
+		if attrs := otlpAttrs(val.KvlistValue.Values); attrs != nil {
+			return attrs
+		}
+		return map[string]any{}
+	case val.BytesValue != nil:
+		return val.BytesValue
+	}
+	return nil
+}
+
+// otlpAttrs returns the attributes as a map. Returns nil if there are no
+// attributes.
+func otlpAttrs(kvs []otlpKeyValue) map[string]any {
+	if len(kvs) == 0 {
+		return nil
+	}
+	m := make(map[string]any, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value.value()
+	}
+	return m
+}
+
+// otlpTime returns the time in nanoseconds since the Unix epoch formatted
+// with [time.RFC3339Nano]. Returns an empty string for zero or invalid time.
+func otlpTime(num json.Number) string {
+	ns, err := strconv.ParseUint(string(num), 10, 64)
+	if err != nil || ns == 0 || ns > math.MaxInt64 {
+		return ""
+	}
+	return time.Unix(0, int64(ns)).UTC().Format(time.RFC3339Nano)
+}
+
+// Protocol buffers wire types.
+const (
+	wireVarint  = 0 // Variable length integer.
+	wireFixed64 = 1 // Fixed 64-bit value.
+	wireLen     = 2 // Length delimited value.
+	wireFixed32 = 5 // Fixed 32-bit value.
+)
+
+// pbField represents a protocol buffers encoded field.
+type pbField struct {
+	num  int    // Field number.
+	wire int    // Wire type.
+	val  uint64 // Value of the integer and fixed wire types.
+	buf  []byte // Value of the length delimited wire type.
+}
+
+// pbWalk decodes the protocol buffers encoded message and calls the function
+// for each of its fields.
+func pbWalk(buf []byte, fn func(fld pbField) error) error {
+	errTrunc := io.ErrUnexpectedEOF
+	for len(buf) > 0 {
+		key, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return errTrunc
+		}
+		buf = buf[n:]
+		fld := pbField{num: int(key >> 3), wire: int(key & 7)}
+		switch fld.wire {
+		case wireVarint:
+			if fld.val, n = binary.Uvarint(buf); n <= 0 {
+				return errTrunc
+			}
+			buf = buf[n:]
+
+		case wireFixed64:
+			if len(buf) < 8 {
+				return errTrunc
+			}
+			fld.val, buf = binary.LittleEndian.Uint64(buf), buf[8:]
+
+		case wireLen:
+			size, n := binary.Uvarint(buf)
+			if n <= 0 || size > uint64(len(buf)-n) {
+				return errTrunc
+			}
+			end := n + int(size)
+			fld.buf, buf = buf[n:end], buf[end:]
+
+		case wireFixed32:
+			if len(buf) < 4 {
+				return errTrunc
+			}
+			val := binary.LittleEndian.Uint32(buf)
+			fld.val, buf = uint64(val), buf[4:]
+
+		default:
+			return fmt.Errorf("unsupported wire type %d", fld.wire)
+		}
+		if err := fn(fld); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decode decodes the protocol buffers encoded logs export request.
+func (req *otlpRequest) decode(buf []byte) error {
+	return pbWalk(buf, func(fld pbField) error {
+		if fld.num != 1 || fld.wire != wireLen {
+			return nil
+		}
+		var rl otlpResourceLogs
+		if err := rl.decode(fld.buf); err != nil {
+			return err
+		}
+		req.ResourceLogs = append(req.ResourceLogs, rl)
+		return nil
+	})
+}
+
+// decode decodes the protocol buffers encoded resource logs.
+func (rl *otlpResourceLogs) decode(buf []byte) error {
+	return pbWalk(buf, func(fld pbField) error {
+		switch {
+		case fld.wire != wireLen:
+			return nil
+		case fld.num == 1:
+			return pbWalk(fld.buf, func(fld pbField) error {
+				return pbKeyValues(fld, 1, &rl.Resource.Attributes)
+			})
+		case fld.num == 2:
+			var sl otlpScopeLogs
+			if err := sl.decode(fld.buf); err != nil {
+				return err
+			}
+			rl.ScopeLogs = append(rl.ScopeLogs, sl)
+		}
+		return nil
+	})
+}
+
+// decode decodes the protocol buffers encoded scope logs.
+func (sl *otlpScopeLogs) decode(buf []byte) error {
+	return pbWalk(buf, func(fld pbField) error {
+		switch {
+		case fld.wire != wireLen:
+			return nil
+		case fld.num == 1:
+			return pbWalk(fld.buf, func(fld pbField) error {
+				switch {
+				case fld.wire != wireLen:
+				case fld.num == 1:
+					sl.Scope.Name = string(fld.buf)
+				case fld.num == 2:
+					sl.Scope.Version = string(fld.buf)
+				}
+				return pbKeyValues(fld, 3, &sl.Scope.Attributes)
+			})
+		case fld.num == 2:
+			var rec otlpLogRecord
+			if err := rec.decode(fld.buf); err != nil {
+				return err
+			}
+			sl.LogRecords = append(sl.LogRecords, rec)
+		}
+		return nil
+	})
+}
+
+// decode decodes the protocol buffers encoded log record.
+func (rec *otlpLogRecord) decode(buf []byte) error {
+	return pbWalk(buf, func(fld pbField) error {
+		num := json.Number(strconv.FormatUint(fld.val, 10))
+		switch {
+		case fld.num == 1 && fld.wire == wireFixed64:
+			rec.TimeUnixNano = num
+		case fld.num == 11 && fld.wire == wireFixed64:
+			rec.ObservedTimeUnixNano = num
+		case fld.num == 2 && fld.wire == wireVarint:
+			rec.SeverityNumber = int(int32(fld.val))
+		case fld.wire != wireLen:
+		case fld.num == 3:
+			rec.SeverityText = string(fld.buf)
+		case fld.num == 5:
+			rec.Body = &otlpAnyValue{}
+			return rec.Body.decode(fld.buf)
+		case fld.num == 9:
+			rec.TraceID = hex.EncodeToString(fld.buf)
+		case fld.num == 10:
+			rec.SpanID = hex.EncodeToString(fld.buf)
+		case fld.num == 12:
+			rec.EventName = string(fld.buf)
+		}
+		return pbKeyValues(fld, 6, &rec.Attributes)
+	})
+}
+
+// decode decodes the protocol buffers encoded value.
+func (val *otlpAnyValue) decode(buf []byte) error {
+	return pbWalk(buf, func(fld pbField) error {
+		switch {
+		case fld.num == 1 && fld.wire == wireLen:
+			str := string(fld.buf)
+			val.StringValue = &str
+		case fld.num == 2 && fld.wire == wireVarint:
+			b := fld.val != 0
+			val.BoolValue = &b
+		case fld.num == 3 && fld.wire == wireVarint:
+			num := json.Number(strconv.FormatInt(int64(fld.val), 10))
+			val.IntValue = &num
+		case fld.num == 4 && fld.wire == wireFixed64:
+			f := math.Float64frombits(fld.val)
+			val.DoubleValue = &f
+		case fld.num == 5 && fld.wire == wireLen:
+			val.ArrayValue = &otlpArray{Values: []otlpAnyValue{}}
+			return pbWalk(fld.buf, func(fld pbField) error {
+				if fld.num != 1 || fld.wire != wireLen {
+					return nil
+				}
+				var elem otlpAnyValue
+				if err := elem.decode(fld.buf); err != nil {
+					return err
+				}
+				val.ArrayValue.Values = append(val.ArrayValue.Values, elem)
+				return nil
+			})
+		case fld.num == 6 && fld.wire == wireLen:
+			val.KvlistValue = &otlpKVList{}
+			return pbWalk(fld.buf, func(fld pbField) error {
+				return pbKeyValues(fld, 1, &val.KvlistValue.Values)
+			})
+		case fld.num == 7 && fld.wire == wireLen:
+			val.BytesValue = append([]byte{}, fld.buf...)
+		}
+		return nil
+	})
+}
+
+// pbKeyValues decodes the field as a key-value and appends it to the slice
+// when the field has the given number.
+func pbKeyValues(fld pbField, num int, kvs *[]otlpKeyValue) error {
+	if fld.num != num || fld.wire != wireLen {
+		return nil
+	}
+	var kv otlpKeyValue
+	err := pbWalk(fld.buf, func(fld pbField) error {
+		switch {
+		case fld.wire != wireLen:
+		case fld.num == 1:
+			kv.Key = string(fld.buf)
+		case fld.num == 2:
+			kv.Value = &otlpAnyValue{}
+			return kv.Value.decode(fld.buf)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*kvs = append(*kvs, kv)
+	return nil
+}
@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// pbKey returns the protocol buffers field key.
+func pbKey(num, wire int) []byte {
+	return binary.AppendUvarint(nil, uint64(num<<3|wire))
+}
+
+// pbLen returns the protocol buffers length delimited field.
+func pbLen(num int, parts ...[]byte) []byte {
+	data := slices.Concat(parts...)
+	buf := binary.AppendUvarint(pbKey(num, wireLen), uint64(len(data)))
+	return append(buf, data...)
+}
+
+// pbStr returns the protocol buffers string field.
+func pbStr(num int, str string) []byte { return pbLen(num, []byte(str)) }
+
+// pbVarint returns the protocol buffers variable length integer field.
+func pbVarint(num int, val uint64) []byte {
+	return binary.AppendUvarint(pbKey(num, wireVarint), val)
+}
+
+// pbFixed64 returns the protocol buffers fixed 64-bit field.
+func pbFixed64(num int, val uint64) []byte {
+	return binary.LittleEndian.AppendUint64(pbKey(num, wireFixed64), val)
+}
+
+// pbKV returns the protocol buffers key-value field with the encoded value.
+func pbKV(num int, key string, val []byte) []byte {
+	return pbLen(num, pbStr(1, key), pbLen(2, val))
+}
+
+// otlpPost posts the OTLP logs export request and returns the response
+// status code and body.
+func otlpPost(url, typ string, body []byte, gz bool) (int, string) {
+	if gz {
+		buf := &bytes.Buffer{}
+		zw := gzip.NewWriter(buf)
+		must.Value(zw.Write(body))
+		must.Nil(zw.Close())
+		body = buf.Bytes()
+	}
+	req := must.Value(http.NewRequest(
+		http.MethodPost,
+		url+OTLPLogsPath,
+		bytes.NewReader(body),
+	))
+	req.Header.Set("Content-Type", typ)
+	if gz {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	res := must.Value(http.DefaultClient.Do(req))
+	defer func() { _ = res.Body.Close() }()
+	return res.StatusCode, string(must.Value(io.ReadAll(res.Body)))
+}
+
+// otlpJSON is an example OTLP logs export request in JSON encoding.
+const otlpJSON = `{"resourceLogs": [{
+	"resource": {"attributes": [
+		{"key": "service.name", "value": {"stringValue": "svc"}}
+	]},
+	"scopeLogs": [{
+		"scope": {"name": "app", "version": "1.0"},
+		"logRecords": [{
+			"timeUnixNano": "1735787045123456789",
+			"severityNumber": 17,
+			"severityText": "ERROR",
+			"body": {"stringValue": "msg"},
+			"attributes": [
+				{"key": "http.method", "value": {"stringValue": "GET"}},
+				{"key": "http.status_code", "value": {"intValue": "500"}}
+			],
+			"traceId": "4bf92f3577b34da6a3ce929d0e0e4736",
+			"spanId": "00f067aa0ba902b7"
+		}]
+	}]
+}]}`
+
+func Test_NewOTLPReceiver(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewOTLPReceiver(tspy)
+
+		// --- When ---
+		code, body := otlpPost(url, "application/json", []byte(otlpJSON), false)
+
+		// --- Then ---
+		ent := tst.FirstEntry()
+		assert.True(t, ent.AssertLevel("error"))
+		assert.True(t, ent.AssertMsg("msg"))
+		tspy.Finish()
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "{}", body)
+		want := `{
+			"Attributes": {"http.method": "GET", "http.status_code": 500},
+			"Body": "msg",
+			"InstrumentationScope": {"Name": "app", "Version": "1.0"},
+			"Resource": {"service.name": "svc"},
+			"SeverityNumber": 17,
+			"SeverityText": "ERROR",
+			"SpanId": "00f067aa0ba902b7",
+			"Timestamp": "2025-01-02T03:04:05.123456789Z",
+			"TraceId": "4bf92f3577b34da6a3ce929d0e0e4736"
+		}`
+		assert.JSON(t, want, tst.String())
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewOTLPReceiver(tspy)
+
+		rec := pbLen(2,
+			pbFixed64(11, 1735787045123456789),
+			pbVarint(2, 9),
+			pbLen(5, pbLen(6, pbKV(1, "a", pbStr(1, "b")))),
+			pbKV(6, "n", pbVarint(3, math.MaxUint64)),
+			pbKV(6, "f", pbFixed64(4, math.Float64bits(0.5))),
+			pbKV(6, "ok", pbVarint(2, 1)),
+			pbKV(6, "tags", pbLen(5, pbLen(1, pbStr(1, "a")), pbLen(1))),
+			pbKV(6, "raw", pbLen(7, []byte{1, 2})),
+			pbLen(9, []byte{0xab, 0xcd}),
+			pbLen(10, []byte{0xef}),
+			pbStr(12, "login"),
+			pbVarint(99, 1),
+		)
+		scope := pbLen(2, pbLen(1, pbKV(3, "k", pbStr(1, "v"))), rec)
+		req := pbLen(1, scope)
+
+		// --- When ---
+		code, body := otlpPost(url, "application/x-protobuf", req, true)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "", body)
+		want := `{
+			"Attributes": {
+				"f": 0.5,
+				"n": -1,
+				"ok": true,
+				"raw": "AQI=",
+				"tags": ["a", null]
+			},
+			"Body": {"a": "b"},
+			"EventName": "login",
+			"InstrumentationScope": {"Attributes": {"k": "v"}},
+			"ObservedTimestamp": "2025-01-02T03:04:05.123456789Z",
+			"SeverityNumber": 9,
+			"SpanId": "ef",
+			"Timestamp": "2025-01-02T03:04:05.123456789Z",
+			"TraceId": "abcd"
+		}`
+		assert.JSON(t, want, tst.String())
+	})
+
+	t.Run("multiple records", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewOTLPReceiver(tspy)
+
+		rec0 := pbLen(2, pbLen(5, pbStr(1, "msg0")))
+		rec1 := pbLen(2, pbLen(5, pbStr(1, "msg1")))
+		req := pbLen(1, pbLen(2, rec0, rec1))
+
+		// --- When ---
+		code, _ := otlpPost(url, "application/x-protobuf", req, false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusOK, code)
+		want := `{"Body":"msg0"}` + "\n" + `{"Body":"msg1"}` + "\n"
+		assert.Equal(t, want, tst.String())
+	})
+
+	t.Run("options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		// --- When ---
+		_, tst := NewOTLPReceiver(tspy, WithConfig(DefaultConfig()))
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, DefaultConfig(), tst.cfg)
+	})
+
+	t.Run("error - write error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		e := errors.New("sink failed")
+		url, tst := NewOTLPReceiver(tspy, WithWriteError(e, 0))
+
+		// --- When ---
+		code, body := otlpPost(url, "application/json", []byte(otlpJSON), false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, "sink failed\n", body)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("error - method not allowed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewOTLPReceiver(tspy)
+
+		// --- When ---
+		res := must.Value(http.Get(url + OTLPLogsPath))
+		_ = res.Body.Close()
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("error - unsupported content type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		url, tst := NewOTLPReceiver(tspy)
+
+		// --- When ---
+		code, _ := otlpPost(url, "text/plain", []byte(otlpJSON), false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusUnsupportedMediaType, code)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("error - invalid json", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[otlp] expected OTLP logs export request:\n" +
+			"  reason: unexpected end of JSON input"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		url, tst := NewOTLPReceiver(tspy)
+
+		// --- When ---
+		code, body := otlpPost(url, "application/json", []byte(`{`), false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, wMsg+"\n", body)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("error - truncated protobuf", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("reason: unexpected EOF")
+		tspy.Close()
+
+		url, tst := NewOTLPReceiver(tspy)
+		req := pbLen(1, pbLen(2, pbLen(2, pbFixed64(1, 1))))
+
+		// --- When ---
+		code, _ := otlpPost(url, "application/x-protobuf", req[:8], false)
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("error - invalid gzip", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("reason: gzip: invalid header")
+		tspy.Close()
+
+		url, _ := NewOTLPReceiver(tspy)
+		req := must.Value(http.NewRequest(
+			http.MethodPost,
+			url+OTLPLogsPath,
+			strings.NewReader("not a gzip stream"),
+		))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Encoding", "gzip")
+
+		// --- When ---
+		res := must.Value(http.DefaultClient.Do(req))
+		_ = res.Body.Close()
+
+		// --- Then ---
+		tspy.Finish()
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func Test_pbWalk(t *testing.T) {
+	t.Run("all wire types", func(t *testing.T) {
+		// --- Given ---
+		buf := slices.Concat(
+			pbVarint(1, 300),
+			pbFixed64(2, 7),
+			pbStr(3, "abc"),
+			binary.LittleEndian.AppendUint32(pbKey(4, wireFixed32), 9),
+		)
+
+		// --- When ---
+		var have []pbField
+		err := pbWalk(buf, func(fld pbField) error {
+			have = append(have, fld)
+			return nil
+		})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := []pbField{
+			{num: 1, wire: wireVarint, val: 300},
+			{num: 2, wire: wireFixed64, val: 7},
+			{num: 3, wire: wireLen, buf: []byte("abc")},
+			{num: 4, wire: wireFixed32, val: 9},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error - unsupported wire type", func(t *testing.T) {
+		// --- When ---
+		err := pbWalk(pbKey(1, 3), func(pbField) error { return nil })
+
+		// --- Then ---
+		assert.ErrorEqual(t, "unsupported wire type 3", err)
+	})
+
+	t.Run("error - length exceeds the message", func(t *testing.T) {
+		// --- When ---
+		err := pbWalk(pbStr(1, "abc")[:3], func(pbField) error { return nil })
+
+		// --- Then ---
+		assert.ErrorIs(t, io.ErrUnexpectedEOF, err)
+	})
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"regexp"
+)
+
+// SourceField is the name of the field added to log entries with the line
+// prefix stripped by the [WithLinePrefix] line decoder.
+const SourceField = "source"
+
+// ComposePrefix matches the "service-name  | " prefix added by docker-compose
+// to log lines of the services, see [WithLinePrefix].
+var ComposePrefix = regexp.MustCompile(`^([\w.-]+) *\| ?`)
+
+// WithLinePrefix is an option for [NewConfig] setting [Config.LineDecoder]
+// which strips the prefix matching the regular expression from the log lines
+// and records it in the [SourceField] field. When the expression has a
+// capturing group, the first group is recorded instead of the whole prefix.
+// If the field already exists in the log line, its value is preserved. Lines
+// which don't start with the prefix are decoded as they are.
+//
+// Example usage:
+//
+//	cfg := logkit.NewConfig(logkit.WithLinePrefix(logkit.ComposePrefix))
+//	tst := logkit.Load(t, "testdata/compose.log", logkit.WithConfig(cfg))
+//	ets := tst.Filter(logkit.CheckStr(logkit.SourceField, "api-1"))
+func WithLinePrefix(rx *regexp.Regexp) ConfigOption {
+	return func(cfg *Config) {
+		cfg.LineDecoder = func(line []byte) ([]byte, map[string]string, error) {
+			loc := rx.FindSubmatchIndex(line)
+			if loc == nil || loc[0] != 0 {
+				return line, nil, nil
+			}
+			src := bytes.TrimSpace(line[:loc[1]])
+			if len(loc) > 2 && loc[2] >= 0 {
+				src = line[loc[2]:loc[3]]
+			}
+			rest := bytes.TrimSpace(line[loc[1]:])
+			return prependField(cfg, rest, SourceField, string(src)), nil, nil
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_WithLinePrefix(t *testing.T) {
+	t.Run("compose prefix", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(WithLinePrefix(ComposePrefix))
+		lin := `api-1   | {"level":"info","message":"msg0"}`
+
+		// --- When ---
+		have, meta, err := cfg.LineDecoder([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{"source":"api-1","level":"info","message":"msg0"}`
+		assert.Equal(t, want, string(have))
+		assert.Nil(t, meta)
+	})
+
+	t.Run("text line", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(WithLinePrefix(ComposePrefix))
+		lin := `db-1  | database system is ready`
+
+		// --- When ---
+		have, _, err := cfg.LineDecoder([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{"source":"db-1","message":"database system is ready"}`
+		assert.Equal(t, want, string(have))
+	})
+
+	t.Run("existing source field", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(WithLinePrefix(ComposePrefix))
+		lin := `api-1   | {"source":"app","message":"msg0"}`
+
+		// --- When ---
+		have, _, err := cfg.LineDecoder([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"source":"app","message":"msg0"}`, string(have))
+	})
+
+	t.Run("without capturing group", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(WithLinePrefix(regexp.MustCompile(`^\[\w+\] `)))
+		lin := `[worker] {"message":"msg0"}`
+
+		// --- When ---
+		have, _, err := cfg.LineDecoder([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"source":"[worker]","message":"msg0"}`, string(have))
+	})
+
+	t.Run("line without prefix", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(WithLinePrefix(ComposePrefix))
+		lin := `{"message":"a | b"}`
+
+		// --- When ---
+		have, _, err := cfg.LineDecoder([]byte(lin))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, lin, string(have))
+	})
+
+	t.Run("filter by source", func(t *testing.T) {
+		// --- Given ---
+		cfg := NewConfig(WithLinePrefix(ComposePrefix))
+		tst := New(t, WithConfig(cfg))
+		MustWriteLine(tst,
+			`api-1  | {"level":"info","message":"msg0"}`,
+			`db-1   | {"level":"info","message":"msg1"}`,
+			`api-1  | {"level":"info","message":"msg2"}`,
+		)
+
+		// --- When ---
+		have := tst.Filter(CheckStr(SourceField, "api-1"))
+
+		// --- Then ---
+		ets := have.Get()
+		assert.Len(t, 2, ets)
+		ets[0].AssertMsg("msg0")
+		ets[1].AssertMsg("msg2")
+	})
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// ANSI escape sequences used to colorize pretty-printed log entries.
+const (
+	colorKey    = "\x1b[34m" // Blue.
+	colorString = "\x1b[32m" // Green.
+	colorValue  = "\x1b[33m" // Yellow.
+	colorReset  = "\x1b[0m"
+)
+
+// prettyColor controls if pretty-printed log entries are colorized. It is
+// set when the standard output is attached to a terminal.
+var prettyColor = isTerminal(os.Stdout)
+
+// isTerminal reports whether the file is a character device like a terminal.
+func isTerminal(fil *os.File) bool {
+	inf, err := fil.Stat()
+	if err != nil {
+		return false
+	}
+	return inf.Mode()&os.ModeCharDevice != 0
+}
+
+// Pretty returns the log entry as indented JSON with the fields sorted by
+// their names. The values of the [Config.RedactFields] fields are replaced
+// with [RedactedValue]. When the standard output is attached to a terminal,
+// the keys and values are colorized.
+func (ent Entry) Pretty() string {
+	var m map[string]any
+	dec := json.NewDecoder(strings.NewReader(ent.redacted()))
+	dec.UseNumber()
+	if err := dec.Decode(&m); err != nil {
+		return ent.raw
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(m); err != nil {
+		return ent.raw
+	}
+	out := bytes.TrimRight(buf.Bytes(), "\n")
+	if prettyColor {
+		return colorJSON(out)
+	}
+	return string(out)
+}
+
+// PrettySummary returns all log entries pretty-printed, see [Entry.Pretty].
+// When checks are provided, only the entries passing all of them are
+// rendered.
+func (ets Entries) PrettySummary(checks ...Checker) string {
+	ets.t.Helper()
+	head := "entries logged so far"
+	if len(checks) > 0 {
+		ets = ets.filter(checks...)
+		head = "matching entries logged so far"
+	}
+	if len(ets.ets) == 0 {
+		return "no " + head
+	}
+	sb := strings.Builder{}
+	sb.WriteString(head + ":\n")
+	for _, ent := range ets.ets {
+		sb.WriteString(notice.Indent(2, ' ', ent.Pretty()) + "\n")
+	}
+	return sb.String()
+}
+
+// colorJSON returns the indented JSON with the ANSI color escape sequences
+// around object keys, string values and other scalar values.
+func colorJSON(data []byte) string {
+	sb := strings.Builder{}
+	for i := 0; i < len(data); i++ {
+		chr := data[i]
+		switch {
+		case chr == '"':
+			end := i + 1
+			for ; end < len(data) && data[end] != '"'; end++ {
+				if data[end] == '\\' {
+					end++
+				}
+			}
+			color := colorString
+			if end+1 < len(data) && data[end+1] == ':' {
+				color = colorKey
+			}
+			sb.WriteString(color)
+			sb.Write(data[i : end+1])
+			sb.WriteString(colorReset)
+			i = end
+
+		case strings.IndexByte("{}[]:, \n", chr) >= 0:
+			sb.WriteByte(chr)
+
+		default:
+			end := i
+			for ; end < len(data); end++ {
+				if strings.IndexByte("{}[]:, \n", data[end]) >= 0 {
+					break
+				}
+			}
+			sb.WriteString(colorValue)
+			sb.Write(data[i:end])
+			sb.WriteString(colorReset)
+			i = end - 1
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// setPrettyColor sets the pretty-printed entries colorization for the test.
+func setPrettyColor(t *testing.T, color bool) {
+	prev := prettyColor
+	prettyColor = color
+	t.Cleanup(func() { prettyColor = prev })
+}
+
+func Test_Entry_Pretty(t *testing.T) {
+	setPrettyColor(t, false)
+
+	t.Run("sorted and indented", func(t *testing.T) {
+		// --- Given ---
+		tst := New(t)
+		lin := `{"level":"info","B":{"y":1,"x":[true,null]},"A":"<a>"}`
+		MustWriteLine(tst, lin)
+		ent := tst.LastEntry()
+
+		// --- When ---
+		have := ent.Pretty()
+
+		// --- Then ---
+		want := "" +
+			"{\n" +
+			"  \"A\": \"<a>\",\n" +
+			"  \"B\": {\n" +
+			"    \"x\": [\n" +
+			"      true,\n" +
+			"      null\n" +
+			"    ],\n" +
+			"    \"y\": 1\n" +
+			"  },\n" +
+			"  \"level\": \"info\"\n" +
+			"}"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("number precision", func(t *testing.T) {
+		// --- Given ---
+		tst := New(t)
+		MustWriteLine(tst, `{"id":9007199254740993}`)
+		ent := tst.LastEntry()
+
+		// --- When ---
+		have := ent.Pretty()
+
+		// --- Then ---
+		assert.Equal(t, "{\n  \"id\": 9007199254740993\n}", have)
+	})
+
+	t.Run("redacted fields", func(t *testing.T) {
+		// --- Given ---
+		cfg := DefaultConfig()
+		cfg.RedactFields = []string{"token"}
+		tst := New(t, WithConfig(cfg))
+		MustWriteLine(tst, `{"token":"secret"}`)
+		ent := tst.LastEntry()
+
+		// --- When ---
+		have := ent.Pretty()
+
+		// --- Then ---
+		assert.Equal(t, "{\n  \"token\": \"[REDACTED]\"\n}", have)
+	})
+
+	t.Run("colorized", func(t *testing.T) {
+		// --- Given ---
+		setPrettyColor(t, true)
+
+		tst := New(t)
+		MustWriteLine(tst, `{"A":"a","B":1}`)
+		ent := tst.LastEntry()
+
+		// --- When ---
+		have := ent.Pretty()
+
+		// --- Then ---
+		want := "" +
+			"{\n" +
+			"  \x1b[34m\"A\"\x1b[0m: \x1b[32m\"a\"\x1b[0m,\n" +
+			"  \x1b[34m\"B\"\x1b[0m: \x1b[33m1\x1b[0m\n" +
+			"}"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("zero entry", func(t *testing.T) {
+		// --- Given ---
+		ent := Entry{}
+
+		// --- When ---
+		have := ent.Pretty()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_colorJSON(t *testing.T) {
+	t.Run("escaped quote", func(t *testing.T) {
+		// --- When ---
+		have := colorJSON([]byte(`{"A": "a\":b"}`))
+
+		// --- Then ---
+		want := "{\x1b[34m\"A\"\x1b[0m: \x1b[32m\"a\\\":b\"\x1b[0m}"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("array values", func(t *testing.T) {
+		// --- When ---
+		have := colorJSON([]byte(`[1, false]`))
+
+		// --- Then ---
+		want := "[\x1b[33m1\x1b[0m, \x1b[33mfalse\x1b[0m]"
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_Entries_PrettySummary(t *testing.T) {
+	setPrettyColor(t, false)
+
+	t.Run("no entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := ets.PrettySummary()
+
+		// --- Then ---
+		assert.Equal(t, "no entries logged so far", have)
+	})
+
+	t.Run("some entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info"}`, `{"level": "error"}`)
+
+		// --- When ---
+		have := ets.PrettySummary()
+
+		// --- Then ---
+		want := "" +
+			"entries logged so far:\n" +
+			"  {\n" +
+			"    \"level\": \"info\"\n" +
+			"  }\n" +
+			"  {\n" +
+			"    \"level\": \"error\"\n" +
+			"  }\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("with checks", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info"}`, `{"level": "error"}`)
+
+		// --- When ---
+		have := ets.PrettySummary(CheckError())
+
+		// --- Then ---
+		want := "" +
+			"matching entries logged so far:\n" +
+			"  {\n" +
+			"    \"level\": \"error\"\n" +
+			"  }\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("with checks no matching entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info"}`)
+
+		// --- When ---
+		have := ets.PrettySummary(CheckError())
+
+		// --- Then ---
+		assert.Equal(t, "no matching entries logged so far", have)
+	})
+}
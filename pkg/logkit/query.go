@@ -0,0 +1,453 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"cmp"
+	"errors"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// ErrQuery represents an error for invalid query, see [ParseQuery].
+var ErrQuery = errors.New("invalid query")
+
+// Kinds of the query tokens.
+const (
+	tokIdent  = iota // Field path or keyword.
+	tokString        // Quoted string literal.
+	tokNumber        // Number literal.
+	tokOp            // Operator or parenthesis.
+)
+
+// queryOps are the query operators, longer operators first.
+var queryOps = []string{
+	"==", "!=", ">=", "<=", "&&", "||", ">", "<", "!", "(", ")",
+}
+
+// cmpOps are the query comparison operators.
+var cmpOps = []string{"==", "!=", ">", ">=", "<", "<="}
+
+// queryToken represents a lexical token of the query.
+type queryToken struct {
+	kind int    // Token kind.
+	val  string // Token value, unquoted for string literals.
+	pos  int    // Offset of the token in the query.
+}
+
+// queryFn represents a compiled query expression.
+type queryFn func(ent Entry) bool
+
+// ParseQuery compiles the query expression into a [Checker]. The expression
+// compares log entry fields with literals:
+//
+//	level == "error" && fields.attempt > 2 && message contains "retry"
+//
+// The comparison operators are "==", "!=", ">", ">=", "<", "<=", "contains"
+// (substring of a string or element of an array) and "matches" (regular
+// expression). The literals are double-quoted strings, numbers, true, false,
+// and null. Comparisons can be combined with "&&", "||", "!" and parentheses.
+//
+// The "level", "message", "time" and "error" names refer to the fields
+// configured with [Config.LevelField], [Config.MessageField],
+// [Config.TimeField] and [Config.ErrorField], where the level is compared
+// after [Config.LevelParser] normalization. Names with the "fields." prefix
+// refer to the fields with the rest of the name, and other names are used
+// as they are. Names may be paths to nested values, see [HasPath].
+// Comparisons of missing fields are always false.
+//
+// The returned checker returns nil if the log entry matches the query, and
+// [ErrValue] otherwise. Returns error having [ErrQuery] in its chain if the
+// expression cannot be compiled.
+func ParseQuery(query string) (Checker, error) {
+	toks, err := lexQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	prs := &queryParser{query: query, toks: toks}
+	fn, err := prs.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok, ok := prs.peek(); ok {
+		return nil, errQuery(query, tok.pos, "unexpected "+tok.val)
+	}
+	return func(ent Entry) error {
+		if fn(ent) {
+			return nil
+		}
+		return notice.New("[log entry] expected log entry to match the query").
+			Append("query", "%s", query).
+			Wrap(ErrValue)
+	}, nil
+}
+
+// Query returns log entries matching the query, see [ParseQuery]. If the
+// query cannot be compiled, it marks the test as failed, logs an error
+// message, and returns no entries.
+//
+// Example usage:
+//
+//	ets := tst.Entries().Query(`level == "error" && fields.attempt > 2`)
+//	ets.Print()
+func (ets Entries) Query(query string) Entries {
+	ets.t.Helper()
+	chk, err := ParseQuery(query)
+	if err != nil {
+		ets.t.Error(err)
+		return Entries{cfg: ets.cfg, ets: make([]Entry, 0), t: ets.t}
+	}
+	return ets.filter(chk)
+}
+
+// errQuery returns an error for the query which cannot be compiled.
+func errQuery(query string, pos int, reason string) error {
+	return notice.New("[log entry] invalid query").
+		Append("query", "%s", query).
+		Append("offset", "%d", pos).
+		Append("reason", "%s", reason).
+		Wrap(ErrQuery)
+}
+
+// lexQuery splits the query into tokens.
+func lexQuery(query string) ([]queryToken, error) {
+	var toks []queryToken
+	for i := 0; i < len(query); {
+		chr := query[i]
+		num := isDigit(chr) ||
+			chr == '-' && i+1 < len(query) && isDigit(query[i+1])
+		switch {
+		case chr == ' ' || chr == '\t' || chr == '\n' || chr == '\r':
+			i++
+
+		case chr == '"':
+			end := i + 1
+			for ; end < len(query) && query[end] != '"'; end++ {
+				if query[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(query) {
+				return nil, errQuery(query, i, "unterminated string")
+			}
+			str, err := strconv.Unquote(query[i : end+1])
+			if err != nil {
+				return nil, errQuery(query, i, "invalid string")
+			}
+			toks = append(toks, queryToken{tokString, str, i})
+			i = end + 1
+
+		case num:
+			end := i + 1
+			for ; end < len(query); end++ {
+				if !isDigit(query[end]) &&
+					strings.IndexByte(".eE+-", query[end]) < 0 {
+					break
+				}
+			}
+			toks = append(toks, queryToken{tokNumber, query[i:end], i})
+			i = end
+
+		case isIdentStart(chr):
+			end := i + 1
+			for ; end < len(query); end++ {
+				c := query[end]
+				if !isIdentStart(c) && !isDigit(c) &&
+					strings.IndexByte(".[]-", c) < 0 {
+					break
+				}
+			}
+			toks = append(toks, queryToken{tokIdent, query[i:end], i})
+			i = end
+
+		default:
+			var op string
+			for _, o := range queryOps {
+				if strings.HasPrefix(query[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				reason := "unexpected character " + strconv.Quote(string(chr))
+				return nil, errQuery(query, i, reason)
+			}
+			toks = append(toks, queryToken{tokOp, op, i})
+			i += len(op)
+		}
+	}
+	return toks, nil
+}
+
+// isDigit reports whether the character is a decimal digit.
+func isDigit(chr byte) bool { return chr >= '0' && chr <= '9' }
+
+// isIdentStart reports whether the character can start a field name.
+func isIdentStart(chr byte) bool {
+	return chr >= 'a' && chr <= 'z' || chr >= 'A' && chr <= 'Z' ||
+		chr == '_' || chr == '@' || chr == '$'
+}
+
+// queryParser represents a recursive descent parser of the query tokens.
+type queryParser struct {
+	query string       // The parsed query.
+	toks  []queryToken // Query tokens.
+	pos   int          // Index of the next token.
+}
+
+// peek returns the next token without consuming it. Returns false if there
+// are no more tokens.
+func (prs *queryParser) peek() (queryToken, bool) {
+	if prs.pos >= len(prs.toks) {
+		return queryToken{}, false
+	}
+	return prs.toks[prs.pos], true
+}
+
+// next consumes and returns the next token. Returns error if there are no
+// more tokens.
+func (prs *queryParser) next() (queryToken, error) {
+	tok, ok := prs.peek()
+	if !ok {
+		return tok, errQuery(prs.query, len(prs.query), "unexpected end")
+	}
+	prs.pos++
+	return tok, nil
+}
+
+// isOp reports whether the next token is the given operator.
+func (prs *queryParser) isOp(op string) bool {
+	tok, ok := prs.peek()
+	return ok && tok.kind == tokOp && tok.val == op
+}
+
+// parseOr parses expressions joined with the "||" operator.
+func (prs *queryParser) parseOr() (queryFn, error) {
+	lhs, err := prs.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for prs.isOp("||") {
+		prs.pos++
+		rhs, err := prs.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		prev := lhs
+		lhs = func(ent Entry) bool { return prev(ent) || rhs(ent) }
+	}
+	return lhs, nil
+}
+
+// parseAnd parses expressions joined with the "&&" operator.
+func (prs *queryParser) parseAnd() (queryFn, error) {
+	lhs, err := prs.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for prs.isOp("&&") {
+		prs.pos++
+		rhs, err := prs.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		prev := lhs
+		lhs = func(ent Entry) bool { return prev(ent) && rhs(ent) }
+	}
+	return lhs, nil
+}
+
+// parseUnary parses negated and parenthesized expressions, and comparisons.
+func (prs *queryParser) parseUnary() (queryFn, error) {
+	switch {
+	case prs.isOp("!"):
+		prs.pos++
+		fn, err := prs.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(ent Entry) bool { return !fn(ent) }, nil
+
+	case prs.isOp("("):
+		prs.pos++
+		fn, err := prs.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		tok, err := prs.next()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind != tokOp || tok.val != ")" {
+			return nil, errQuery(prs.query, tok.pos, "expected )")
+		}
+		return fn, nil
+	}
+	return prs.parseCmp()
+}
+
+// parseCmp parses the comparison of the field with a literal.
+func (prs *queryParser) parseCmp() (queryFn, error) {
+	tok, err := prs.next()
+	if err != nil {
+		return nil, err
+	}
+	if tok.kind != tokIdent {
+		return nil, errQuery(prs.query, tok.pos, "expected field name")
+	}
+	path := tok.val
+
+	if tok, err = prs.next(); err != nil {
+		return nil, err
+	}
+	op := tok.val
+	switch {
+	case tok.kind == tokOp && slices.Contains(cmpOps, op):
+	case tok.kind == tokIdent && (op == "contains" || op == "matches"):
+	default:
+		return nil, errQuery(prs.query, tok.pos, "expected operator")
+	}
+
+	if tok, err = prs.next(); err != nil {
+		return nil, err
+	}
+	want, err := prs.literal(tok)
+	if err != nil {
+		return nil, err
+	}
+
+	if op == "matches" {
+		str, ok := want.(string)
+		if !ok {
+			return nil, errQuery(prs.query, tok.pos, "expected string")
+		}
+		rx, err := regexp.Compile(str)
+		if err != nil {
+			return nil, errQuery(prs.query, tok.pos, "invalid regexp")
+		}
+		return func(ent Entry) bool {
+			have, ok := queryValue(ent, path)
+			str, isStr := have.(string)
+			return ok && isStr && rx.MatchString(str)
+		}, nil
+	}
+	return func(ent Entry) bool {
+		have, ok := queryValue(ent, path)
+		return ok && queryCompare(op, have, want)
+	}, nil
+}
+
+// literal returns the value of the literal token.
+func (prs *queryParser) literal(tok queryToken) (any, error) {
+	switch tok.kind {
+	case tokString:
+		return tok.val, nil
+
+	case tokNumber:
+		num, err := strconv.ParseFloat(tok.val, 64)
+		if err != nil {
+			return nil, errQuery(prs.query, tok.pos, "invalid number")
+		}
+		return num, nil
+
+	case tokIdent:
+		switch tok.val {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+	}
+	return nil, errQuery(prs.query, tok.pos, "expected value")
+}
+
+// queryValue returns the value of the query field name in the log entry.
+// Returns false if the field is missing or the query field name maps to a
+// field which is not configured.
+func queryValue(ent Entry, name string) (any, bool) {
+	switch {
+	case name == "level":
+		lvl, err := HasLevel(ent)
+		return lvl, err == nil
+	case name == "message":
+		name = ent.cfg.MessageField
+	case name == "time":
+		name = ent.cfg.TimeField
+	case name == "error":
+		name = ent.cfg.ErrorField
+	case strings.HasPrefix(name, "fields."):
+		name = strings.TrimPrefix(name, "fields.")
+	}
+	if name == "" {
+		return nil, false
+	}
+	val, err := HasPath(ent, name)
+	return val, err == nil
+}
+
+// queryCompare compares the log entry value with the literal using the query
+// operator. Only numbers and strings are ordered.
+func queryCompare(op string, have, want any) bool {
+	switch op {
+	case "==":
+		return queryEqual(have, want)
+
+	case "!=":
+		return !queryEqual(have, want)
+
+	case "contains":
+		switch val := have.(type) {
+		case string:
+			str, ok := want.(string)
+			return ok && strings.Contains(val, str)
+		case []any:
+			for _, elem := range val {
+				if queryEqual(elem, want) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	var res int
+	hNum, hOK := have.(float64)
+	wNum, wOK := want.(float64)
+	hStr, hIsStr := have.(string)
+	wStr, wIsStr := want.(string)
+	switch {
+	case hOK && wOK:
+		res = cmp.Compare(hNum, wNum)
+	case hIsStr && wIsStr:
+		res = strings.Compare(hStr, wStr)
+	default:
+		return false
+	}
+	switch op {
+	case ">":
+		return res > 0
+	case ">=":
+		return res >= 0
+	case "<":
+		return res < 0
+	default:
+		return res <= 0
+	}
+}
+
+// queryEqual reports whether the log entry value equals the literal. Objects
+// and arrays are never equal to literals.
+func queryEqual(have, want any) bool {
+	switch have.(type) {
+	case map[string]any, []any:
+		return false
+	}
+	return have == want
+}
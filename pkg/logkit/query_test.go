@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_ParseQuery(t *testing.T) {
+	const lin = `{
+		"level": "error",
+		"message": "will retry request",
+		"error": "timeout",
+		"attempt": 3,
+		"ok": false,
+		"user": null,
+		"tags": ["a", "b"],
+		"http": {"status": 503}
+	}`
+
+	tests := []struct {
+		testN string
+
+		query string
+		want  bool
+	}{
+		{"string equal", `level == "error"`, true},
+		{"string not equal", `level != "error"`, false},
+		{"message contains", `message contains "retry"`, true},
+		{"message matches", `message matches "^will .+ request$"`, true},
+		{"error field", `error == "timeout"`, true},
+		{"fields prefix", `fields.attempt == 3`, true},
+		{"field name", `attempt >= 3`, true},
+		{"number greater", `fields.attempt > 2`, true},
+		{"number less", `fields.attempt < 3`, false},
+		{"number less or equal", `fields.attempt <= 3`, true},
+		{"negative number", `fields.attempt > -1.5`, true},
+		{"string ordering", `level < "info"`, true},
+		{"bool", `ok == false`, true},
+		{"null", `user == null`, true},
+		{"array contains", `tags contains "b"`, true},
+		{"array not contains", `tags contains "c"`, false},
+		{"array equal", `tags == "a"`, false},
+		{"nested path", `http.status == 503`, true},
+		{"missing field", `missing == "a"`, false},
+		{"missing field not equal", `missing != "a"`, false},
+		{"type mismatch", `fields.attempt > "2"`, false},
+		{"and", `level == "error" && fields.attempt > 2`, true},
+		{"and false", `level == "error" && fields.attempt > 3`, false},
+		{"or", `level == "info" || fields.attempt > 2`, true},
+		{"not", `!(level == "info")`, true},
+		{"precedence", `level == "info" && ok == false || attempt == 3`, true},
+		{
+			"example",
+			`level == "error" && fields.attempt > 2 ` +
+				`&& message contains "retry"`,
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ent := MustEntries(nil, lin).ets[0]
+			chk := must.Value(ParseQuery(tc.query))
+
+			// --- When ---
+			err := chk(ent)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, err == nil)
+		})
+	}
+
+	t.Run("level parser", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"level": 50}`).ets[0]
+		ent.cfg.LevelParser = func(any) (string, error) { return "error", nil }
+		chk := must.Value(ParseQuery(`level == "error"`))
+
+		// --- When ---
+		err := chk(ent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("error - not configured field", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"level": "info"}`).ets[0]
+		ent.cfg.ErrorField = ""
+		chk := must.Value(ParseQuery(`error != "timeout"`))
+
+		// --- When ---
+		err := chk(ent)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrValue, err)
+	})
+
+	t.Run("error - not matching", func(t *testing.T) {
+		// --- Given ---
+		ent := MustEntries(nil, `{"level": "info"}`).ets[0]
+		chk := must.Value(ParseQuery(`level == "error"`))
+
+		// --- When ---
+		err := chk(ent)
+
+		// --- Then ---
+		wMsg := "" +
+			"[log entry] expected log entry to match the query:\n" +
+			"  query: level == \"error\""
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrValue, err)
+	})
+}
+
+func Test_ParseQuery_errors(t *testing.T) {
+	tests := []struct {
+		testN string
+
+		query  string
+		offset string
+		reason string
+	}{
+		{"empty", ``, "0", "unexpected end"},
+		{"unterminated string", `A == "a`, "5", "unterminated string"},
+		{"invalid string", `A == "\q"`, "5", "invalid string"},
+		{"unexpected character", `A = 1`, "2", `unexpected character "="`},
+		{"invalid number", `A == 1-2`, "5", "invalid number"},
+		{"missing operator", `A "a"`, "2", "expected operator"},
+		{"missing value", `A ==`, "4", "unexpected end"},
+		{"invalid value", `A == B`, "5", "expected value"},
+		{"not field name", `"a" == A`, "0", "expected field name"},
+		{"missing paren", `(A == 1`, "7", "unexpected end"},
+		{"wrong paren", `(A == 1 (`, "8", "expected )"},
+		{"trailing token", `A == 1 B`, "7", "unexpected B"},
+		{"matches number", `A matches 1`, "10", "expected string"},
+		{"matches invalid", `A matches "["`, "10", "invalid regexp"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := ParseQuery(tc.query)
+
+			// --- Then ---
+			wMsg := "" +
+				"[log entry] invalid query:\n" +
+				"   query: " + tc.query + "\n" +
+				"  offset: " + tc.offset + "\n" +
+				"  reason: " + tc.reason
+			assert.ErrorEqual(t, wMsg, err)
+			assert.ErrorIs(t, ErrQuery, err)
+			assert.Nil(t, have)
+		})
+	}
+}
+
+func Test_Entries_Query(t *testing.T) {
+	t.Run("matching entries", func(t *testing.T) {
+		// --- Given ---
+		const lin0 = `{"level": "info", "message": "msg0"}`
+		const lin1 = `{"level": "error", "message": "retry", "attempt": 3}`
+		const lin2 = `{"level": "error", "message": "retry", "attempt": 1}`
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ets := MustEntries(tspy, lin0, lin1, lin2)
+
+		// --- When ---
+		have := ets.Query(`level == "error" && fields.attempt > 2`)
+
+		// --- Then ---
+		assert.Len(t, 1, have.Get())
+		assert.Equal(t, lin1, have.Get()[0].String())
+	})
+
+	t.Run("error - invalid query", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("reason: unexpected end")
+		tspy.Close()
+
+		ets := MustEntries(tspy, `{"level": "info"}`)
+
+		// --- When ---
+		have := ets.Query(`level ==`)
+
+		// --- Then ---
+		assert.Empty(t, have.Get())
+		assert.Same(t, tspy, have.t)
+	})
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Pattern represents a secret detector used by [Entries.AssertNoSecrets].
+type Pattern struct {
+	// Detector name used in error messages.
+	Name string
+
+	// When set, only values of the fields with matching names are scanned.
+	Field *regexp.Regexp
+
+	// Regular expression matching the secret in string values.
+	Value *regexp.Regexp
+}
+
+// Built-in secret detectors, see [SecretPatterns].
+var (
+	// PatternBearer detects bearer tokens, like in "Authorization" headers.
+	PatternBearer = Pattern{
+		Name:  "bearer token",
+		Value: regexp.MustCompile(`(?i)\bbearer\s+[\w\-.~+/]{8,}=*`),
+	}
+
+	// PatternAWSKey detects AWS access key IDs.
+	PatternAWSKey = Pattern{
+		Name:  "AWS access key",
+		Value: regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+	}
+
+	// PatternPEM detects PEM encoded private keys.
+	PatternPEM = Pattern{
+		Name:  "PEM private key",
+		Value: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	}
+
+	// PatternPassword detects passwords in "password=..." like strings.
+	PatternPassword = Pattern{
+		Name:  "password",
+		Value: regexp.MustCompile(`(?i)\b(password|passwd|pwd)\s*[=:]\s*\S`),
+	}
+
+	// PatternPasswordField detects non-empty values of the fields named like
+	// passwords.
+	PatternPasswordField = Pattern{
+		Name:  "password field",
+		Field: regexp.MustCompile(`(?i)^(password|passwd|pwd|secret)$`),
+		Value: regexp.MustCompile(`\S`),
+	}
+)
+
+// SecretPatterns returns the built-in secret detectors.
+func SecretPatterns() []Pattern {
+	return []Pattern{
+		PatternBearer,
+		PatternAWSKey,
+		PatternPEM,
+		PatternPassword,
+		PatternPasswordField,
+	}
+}
+
+// AssertNoSecrets asserts that no string value of the log entries, including
+// values in nested objects and arrays, matches any of the secret detectors.
+// When no patterns are given, the [SecretPatterns] are used. Values equal to
+// [RedactedValue] are skipped. Returns true if no secret is found. If not, it
+// marks the test as failed, logs an error message listing the detector names
+// and paths of the found secrets, without their values, and returns false.
+func (ets Entries) AssertNoSecrets(patterns ...Pattern) bool {
+	ets.t.Helper()
+	if len(patterns) == 0 {
+		patterns = SecretPatterns()
+	}
+	msg := notice.New("[log entry] expected no secrets in log entries")
+	var failed bool
+	for _, ent := range ets.ets {
+		var found []string
+		walkFields(ent.m, "", func(pth, name string, val any) {
+			str, ok := val.(string)
+			if !ok || str == RedactedValue {
+				return
+			}
+			for _, pat := range patterns {
+				if pat.Field != nil && !pat.Field.MatchString(name) {
+					continue
+				}
+				if pat.Value.MatchString(str) {
+					found = append(found, pat.Name+" in "+pth)
+				}
+			}
+		})
+		if len(found) > 0 {
+			name := fmt.Sprintf("entry %d", ent.idx)
+			msg = msg.Append(name, "%s", strings.Join(found, ", "))
+			failed = true
+		}
+	}
+	if failed {
+		ets.t.Error(msg)
+	}
+	return !failed
+}
+
+// AssertRedacted asserts that wherever the field appears in the log entries,
+// including nested objects, its value equals the redaction placeholder, like
+// [RedactedValue]. The field matches values with the given name at any
+// nesting level, or with the given path, see [HasPath]. Returns true if all
+// the field values are redacted. If not, it marks the test as failed, logs an
+// error message listing the paths of not redacted values, without the values,
+// and returns false.
+func (ets Entries) AssertRedacted(field, placeholder string) bool {
+	ets.t.Helper()
+	msg := notice.New("[log entry] expected field values to be redacted").
+		Append("field", "%s", field).
+		Append("placeholder", "%q", placeholder)
+	var failed bool
+	for _, ent := range ets.ets {
+		var found []string
+		walkFields(ent.m, "", func(pth, name string, val any) {
+			if name != field && pth != field {
+				return
+			}
+			if _, ok := val.([]any); ok {
+				return // Elements are checked separately.
+			}
+			if str, ok := val.(string); !ok || str != placeholder {
+				found = append(found, pth)
+			}
+		})
+		if len(found) > 0 {
+			name := fmt.Sprintf("entry %d", ent.idx)
+			msg = msg.Append(name, "%s", strings.Join(found, ", "))
+			failed = true
+		}
+	}
+	if failed {
+		ets.t.Error(msg)
+	}
+	return !failed
+}
+
+// walkFields calls the function for every value in the log entry fields,
+// including values nested in objects and arrays, with the value path in the
+// [HasPath] format and the name of the field holding it. Object fields are
+// visited in the order of their names.
+func walkFields(val any, pth string, fn func(pth, name string, val any)) {
+	switch v := val.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			sub := key
+			if pth != "" {
+				sub = pth + "." + key
+			}
+			fn(sub, key, v[key])
+			walkFields(v[key], sub, fn)
+		}
+
+	case []any:
+		name := pth[strings.LastIndexAny(pth, ".]")+1:]
+		for i, elem := range v {
+			sub := pth + "[" + strconv.Itoa(i) + "]"
+			fn(sub, name, elem)
+			walkFields(elem, sub, fn)
+		}
+	}
+}
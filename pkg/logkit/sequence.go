@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/ctx42/testing/pkg/notice"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// SequenceMatcher represents a log line matcher composed of several matchers
+// which must be satisfied, in order, by the incoming log lines or entries.
+type SequenceMatcher struct {
+	// Matchers to satisfy in order.
+	steps []*Matcher
+
+	// Entries matched by the steps so far.
+	ets []Entry
+
+	// When true, the sequence never matches and is removed from the [Tester].
+	discarded bool
+
+	// Guards the structure fields.
+	mx sync.Mutex
+
+	// Test manager.
+	t tester.T
+}
+
+// NewSequenceMatcher creates a new [SequenceMatcher] instance for the given
+// matchers. If no matchers are provided, the sequence is complete from the
+// start.
+func NewSequenceMatcher(t tester.T, steps ...*Matcher) *SequenceMatcher {
+	t.Helper()
+	return &SequenceMatcher{steps: steps, t: t}
+}
+
+// Steps returns the number of matchers in the sequence.
+func (seq *SequenceMatcher) Steps() int { return len(seq.steps) }
+
+// Progress returns the number of matchers in the sequence satisfied so far.
+func (seq *SequenceMatcher) Progress() int {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+	return len(seq.ets)
+}
+
+// Done returns true when all matchers in the sequence were satisfied.
+func (seq *SequenceMatcher) Done() bool {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+	return len(seq.ets) == len(seq.steps)
+}
+
+// Matched returns a copy of the entries matched by the sequence so far. The
+// entry at index i was matched by the matcher at index i.
+func (seq *SequenceMatcher) Matched() []Entry {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+	return append([]Entry(nil), seq.ets...)
+}
+
+// Discard discards all matchers in the sequence, see [Matcher.Discard], and
+// marks the sequence as discarded. Discarded sequences never match and are
+// removed from the [Tester] on the next write.
+func (seq *SequenceMatcher) Discard() {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+	seq.discarded = true
+	for _, mcr := range seq.steps {
+		mcr.Discard()
+	}
+}
+
+// Discarded returns true if [SequenceMatcher.Discard] was called.
+func (seq *SequenceMatcher) Discarded() bool {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+	return seq.discarded
+}
+
+// MatchEntry runs the current matcher in the sequence on the provided
+// [Entry]. Returns true and advances the sequence to the next matcher if the
+// entry matches; otherwise, returns false. Complete or discarded sequence
+// always returns false.
+func (seq *SequenceMatcher) MatchEntry(ent Entry) bool {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+
+	if seq.discarded || len(seq.ets) == len(seq.steps) {
+		return false
+	}
+	if !seq.steps[len(seq.ets)].MatchEntry(ent) {
+		return false
+	}
+	seq.ets = append(seq.ets, ent)
+	return true
+}
+
+// MatchLine runs the current matcher in the sequence on the provided log line.
+// Returns the entry and advances the sequence to the next matcher if the line
+// matches; otherwise, returns a zero-value entry. Complete or discarded
+// sequence always returns a zero-value entry.
+func (seq *SequenceMatcher) MatchLine(idx int, line []byte) Entry {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+
+	if seq.discarded || len(seq.ets) == len(seq.steps) {
+		return ZeroEntry(seq.t, nil)
+	}
+	mcr := seq.steps[len(seq.ets)]
+	ent := mcr.MatchLine(idx, line)
+	if !ent.IsZero() {
+		seq.ets = append(seq.ets, ent)
+	}
+	return ent
+}
+
+// AssertDone asserts all matchers in the sequence were satisfied.
+//
+// Returns true if the sequence is complete. If not, it marks the test as
+// failed, logs an error message with the entries matched so far and the step
+// the sequence is waiting on, and returns false.
+func (seq *SequenceMatcher) AssertDone() bool {
+	seq.t.Helper()
+	if err := seq.progress(); err != nil {
+		seq.t.Error(err)
+		return false
+	}
+	return true
+}
+
+// progress returns nil if the sequence is complete; otherwise, it returns an
+// error describing the sequence progress.
+func (seq *SequenceMatcher) progress() error {
+	seq.mx.Lock()
+	defer seq.mx.Unlock()
+
+	if len(seq.ets) == len(seq.steps) {
+		return nil
+	}
+	msg := notice.New("[log entry] expected log entries sequence to complete").
+		Append("steps", "%d", len(seq.steps)).
+		Append("matched", "%d", len(seq.ets))
+	for i, ent := range seq.ets {
+		name := "step " + strconv.Itoa(i)
+		msg = msg.Append(name, "%d: %s", ent.idx, ent.redacted())
+	}
+	return msg.Append("waiting", "step %d", len(seq.ets)).Wrap(ErrValue)
+}
@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_NewSequenceMatcher(t *testing.T) {
+	t.Run("with matchers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		mcr1 := NewMatcher(tspy, nil, CheckMsg("msg1"))
+
+		// --- When ---
+		seq := NewSequenceMatcher(tspy, mcr0, mcr1)
+
+		// --- Then ---
+		assert.Equal(t, []*Matcher{mcr0, mcr1}, seq.steps)
+		assert.Nil(t, seq.ets)
+		assert.Same(t, tspy, seq.t)
+		assert.Equal(t, 2, seq.Steps())
+		assert.Equal(t, 0, seq.Progress())
+		assert.False(t, seq.Done())
+	})
+
+	t.Run("no matchers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		// --- When ---
+		seq := NewSequenceMatcher(tspy)
+
+		// --- Then ---
+		assert.Equal(t, 0, seq.Steps())
+		assert.True(t, seq.Done())
+	})
+}
+
+func Test_SequenceMatcher_Discard(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+	mcr1 := NewMatcher(tspy, nil, CheckMsg("msg1"))
+	seq := NewSequenceMatcher(tspy, mcr0, mcr1)
+
+	// --- When ---
+	seq.Discard()
+
+	// --- Then ---
+	assert.True(t, seq.Discarded())
+	assert.True(t, mcr0.Discarded())
+	assert.True(t, mcr1.Discarded())
+}
+
+func Test_SequenceMatcher_Discarded(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	seq := NewSequenceMatcher(tspy)
+
+	// --- When ---
+	have := seq.Discarded()
+
+	// --- Then ---
+	assert.False(t, have)
+}
+
+func Test_SequenceMatcher_MatchEntry(t *testing.T) {
+	t.Run("matches in order", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin0 := `{"level":"info", "message":"msg1"}`
+		lin1 := `{"level":"info", "message":"msg0"}`
+		lin2 := `{"level":"info", "message":"msg1"}`
+		ets := MustEntries(nil, lin0, lin1, lin2).ets
+
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		mcr1 := NewMatcher(tspy, nil, CheckMsg("msg1"))
+		seq := NewSequenceMatcher(tspy, mcr0, mcr1)
+
+		// --- When ---
+		have0 := seq.MatchEntry(ets[0])
+		have1 := seq.MatchEntry(ets[1])
+		have2 := seq.MatchEntry(ets[2])
+
+		// --- Then ---
+		assert.False(t, have0)
+		assert.True(t, have1)
+		assert.True(t, have2)
+		assert.True(t, seq.Done())
+		assert.Equal(t, 2, seq.Progress())
+		assert.Equal(t, []Entry{ets[1], ets[2]}, seq.Matched())
+	})
+
+	t.Run("complete sequence never matches", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(nil, `{"message":"msg0"}`).ets[0]
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		seq := NewSequenceMatcher(tspy, mcr0)
+		assert.True(t, seq.MatchEntry(ent))
+
+		// --- When ---
+		have := seq.MatchEntry(ent)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 1, mcr0.Matched())
+	})
+
+	t.Run("discarded sequence never matches", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(nil, `{"message":"msg0"}`).ets[0]
+		seq := NewSequenceMatcher(tspy, NewMatcher(tspy, nil))
+		seq.Discard()
+
+		// --- When ---
+		have := seq.MatchEntry(ent)
+
+		// --- Then ---
+		assert.False(t, have)
+		assert.Equal(t, 0, seq.Progress())
+	})
+}
+
+func Test_SequenceMatcher_MatchLine(t *testing.T) {
+	t.Run("matches in order", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		mcr1 := NewMatcher(tspy, nil, CheckMsg("msg1"))
+		seq := NewSequenceMatcher(tspy, mcr0, mcr1)
+
+		// --- When ---
+		have0 := seq.MatchLine(0, []byte(`{"message":"msg1"}`))
+		have1 := seq.MatchLine(1, []byte(`{"message":"msg0"}`))
+		have2 := seq.MatchLine(2, []byte(`{"message":"msg1"}`))
+
+		// --- Then ---
+		assert.Zero(t, have0)
+		assert.Equal(t, 1, have1.Index())
+		assert.Equal(t, 2, have2.Index())
+		assert.True(t, seq.Done())
+	})
+
+	t.Run("complete sequence never matches", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		seq := NewSequenceMatcher(tspy)
+
+		// --- When ---
+		have := seq.MatchLine(0, []byte(`{"message":"msg0"}`))
+
+		// --- Then ---
+		assert.True(t, have.IsZero())
+	})
+}
+
+func Test_SequenceMatcher_AssertDone(t *testing.T) {
+	t.Run("done", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		seq := NewSequenceMatcher(tspy, mcr0)
+		seq.MatchLine(0, []byte(`{"message":"msg0"}`))
+
+		// --- When ---
+		have := seq.AssertDone()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not done", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log entries sequence to complete:\n" +
+			"    steps: 3\n" +
+			"  matched: 1\n" +
+			"   step 0: 1: {\"message\":\"msg0\"}\n" +
+			"  waiting: step 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		mcr1 := NewMatcher(tspy, nil, CheckMsg("msg1"))
+		mcr2 := NewMatcher(tspy, nil, CheckMsg("msg2"))
+		seq := NewSequenceMatcher(tspy, mcr0, mcr1, mcr2)
+		seq.MatchLine(0, []byte(`{"message":"msg1"}`))
+		seq.MatchLine(1, []byte(`{"message":"msg0"}`))
+
+		// --- When ---
+		have := seq.AssertDone()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Tester_Sequence(t *testing.T) {
+	t.Run("done", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		seq := tst.Sequence(
+			NewMatcher(tspy, nil, CheckMsg("msg0")),
+			NewMatcher(tspy, nil, CheckMsg("msg1")),
+		)
+
+		// --- When ---
+		must.Value(tst.Write([]byte(`{"message":"msg1"}` + "\n")))
+		must.Value(tst.Write([]byte(`{"message":"msg0"}` + "\n")))
+		must.Value(tst.Write([]byte(`{"message":"msg2"}` + "\n")))
+		must.Value(tst.Write([]byte(`{"message":"msg1"}` + "\n")))
+
+		// --- Then ---
+		assert.True(t, seq.AssertDone())
+		ets := seq.Matched()
+		assert.Len(t, 2, ets)
+		assert.Equal(t, 1, ets[0].Index())
+		assert.Equal(t, 3, ets[1].Index())
+		assert.Len(t, 1, tst.seqs)
+	})
+
+	t.Run("complete sequence is removed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		tst.Sequence(NewMatcher(tspy, nil, CheckMsg("msg0")))
+		must.Value(tst.Write([]byte(`{"message":"msg0"}` + "\n")))
+
+		// --- When ---
+		must.Value(tst.Write([]byte(`{"message":"msg1"}` + "\n")))
+
+		// --- Then ---
+		assert.Len(t, 0, tst.seqs)
+	})
+
+	t.Run("lines written before are not checked", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithString(`{"message":"msg0"}`+"\n"))
+
+		// --- When ---
+		seq := tst.Sequence(NewMatcher(tspy, nil, CheckMsg("msg0")))
+
+		// --- Then ---
+		assert.Equal(t, 0, seq.Progress())
+	})
+}
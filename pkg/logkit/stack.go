@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"strconv"
+	"strings"
+)
+
+// parseStack parses a stack trace in the [runtime/debug.Stack] format used
+// by `zap`, where each function name line is followed by an indented
+// "file:line" location line. The goroutine header and function arguments are
+// skipped.
+func parseStack(str string) []Caller {
+	var frames []Caller
+	for _, line := range strings.Split(str, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "goroutine ") {
+			continue
+		}
+		if line[0] == '\t' || line[0] == ' ' {
+			loc := strings.TrimSpace(line)
+			if idx := strings.LastIndex(loc, " +0x"); idx > 0 {
+				loc = loc[:idx]
+			}
+			cll := parseCaller(loc)
+			if n := len(frames); n > 0 && frames[n-1].File == "" {
+				cll.Func = frames[n-1].Func
+				frames[n-1] = cll
+				continue
+			}
+			frames = append(frames, cll)
+			continue
+		}
+		fn := stripArgs(strings.TrimPrefix(line, "created by "))
+		frames = append(frames, Caller{Func: fn})
+	}
+	return frames
+}
+
+// stripArgs returns the function name line without the trailing argument
+// list like in "main.(*T).Method(0x1, {0x2, 0x3})". Parentheses inside the
+// function name, like the method receiver, are kept.
+func stripArgs(fn string) string {
+	if !strings.HasSuffix(fn, ")") {
+		return fn
+	}
+	var depth int
+	for idx := len(fn) - 1; idx > 0; idx-- {
+		switch fn[idx] {
+		case ')':
+			depth++
+		case '(':
+			if depth--; depth == 0 {
+				return fn[:idx]
+			}
+		}
+	}
+	return fn
+}
+
+// parseFrame parses a stack frame object in the format used by `zerolog`
+// [github.com/rs/zerolog/pkgerrors] marshaller, with "func", "source" (or
+// "file") and "line" fields. The line may be a string or a number. Returns
+// false if the value is not an object or has no function nor file.
+func parseFrame(val any) (Caller, bool) {
+	m, ok := val.(map[string]any)
+	if !ok {
+		return Caller{}, false
+	}
+	var cll Caller
+	cll.Func, _ = m["func"].(string)
+	if cll.File, ok = m["source"].(string); !ok {
+		cll.File, _ = m["file"].(string)
+	}
+	switch v := m["line"].(type) {
+	case float64:
+		cll.Line = int(v)
+	case string:
+		cll.Line, _ = strconv.Atoi(v)
+	}
+	return cll, cll.Func != "" || cll.File != ""
+}
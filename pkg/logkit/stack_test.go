@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_parseStack(t *testing.T) {
+	t.Run("zap stack trace", func(t *testing.T) {
+		// --- Given ---
+		str := "" +
+			"main.run\n" +
+			"\t/src/app/main.go:42\n" +
+			"main.main\n" +
+			"\t/src/app/main.go:10"
+
+		// --- When ---
+		have := parseStack(str)
+
+		// --- Then ---
+		want := []Caller{
+			{File: "/src/app/main.go", Line: 42, Func: "main.run"},
+			{File: "/src/app/main.go", Line: 10, Func: "main.main"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("method receiver", func(t *testing.T) {
+		// --- Given ---
+		str := "" +
+			"main.(*T).Method(0xc000010000, {0x2, 0x3})\n" +
+			"\t/src/app/main.go:42 +0x1d\n" +
+			"main.(*T).Run\n" +
+			"\t/src/app/main.go:10"
+
+		// --- When ---
+		have := parseStack(str)
+
+		// --- Then ---
+		want := []Caller{
+			{File: "/src/app/main.go", Line: 42, Func: "main.(*T).Method"},
+			{File: "/src/app/main.go", Line: 10, Func: "main.(*T).Run"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("debug stack", func(t *testing.T) {
+		// --- Given ---
+		str := "" +
+			"goroutine 1 [running]:\n" +
+			"main.run(0x1, {0x2, 0x3})\n" +
+			"\t/src/app/main.go:42 +0x1d\n" +
+			"created by main.main in goroutine 1\n" +
+			"\t/src/app/main.go:10 +0x25\n"
+
+		// --- When ---
+		have := parseStack(str)
+
+		// --- Then ---
+		want := []Caller{
+			{File: "/src/app/main.go", Line: 42, Func: "main.run"},
+			{
+				File: "/src/app/main.go",
+				Line: 10,
+				Func: "main.main in goroutine 1",
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := parseStack("")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_stripArgs(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		fn   string
+		want string
+	}{
+		{"no arguments", "main.run", "main.run"},
+		{"arguments", "main.run(0x1, {0x2, 0x3})", "main.run"},
+		{"empty arguments", "main.run()", "main.run"},
+		{"receiver", "main.(*T).Method", "main.(*T).Method"},
+		{"receiver arguments", "main.(*T).Method(0x1)", "main.(*T).Method"},
+		{"unbalanced", "main.run)", "main.run)"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := stripArgs(tc.fn)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_parseFrame(t *testing.T) {
+	t.Run("zerolog frame", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{
+			"func":   "run",
+			"line":   "42",
+			"source": "main.go",
+		}
+
+		// --- When ---
+		have, ok := parseFrame(val)
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, Caller{File: "main.go", Line: 42, Func: "run"}, have)
+	})
+
+	t.Run("numeric line and file field", func(t *testing.T) {
+		// --- Given ---
+		val := map[string]any{"file": "main.go", "line": 42.0}
+
+		// --- When ---
+		have, ok := parseFrame(val)
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, Caller{File: "main.go", Line: 42}, have)
+	})
+
+	t.Run("not a frame", func(t *testing.T) {
+		// --- When ---
+		have, ok := parseFrame(map[string]any{"line": 42.0})
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, Caller{Line: 42}, have)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		// --- When ---
+		have, ok := parseFrame("main.go:42")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Zero(t, have)
+	})
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"time"
+)
+
+// stdLogRx matches the date and time written by the standard library [log]
+// package loggers.
+var stdLogRx = regexp.MustCompile(
+	`^(\d{4}/\d{2}/\d{2} )?(\d{2}:\d{2}:\d{2}(?:\.\d{1,6})? )?`,
+)
+
+// StdLogWriter returns an [io.Writer] for the standard library [log] package
+// loggers, which converts each line to a JSON log entry written to the
+// [Tester]. The date and time written by the logger are set in the
+// [Config.TimeField] field formatted with [Config.TimeFormat], and the rest of
+// the line is set in the [Config.MessageField] field. When the logger writes
+// time without a date, the current date is used. The date and time are parsed
+// in the local time zone, so loggers with [log.LUTC] flag should be used with
+// UTC as the local time zone. Log prefixes, file names and line numbers are
+// kept in the message.
+//
+// Example usage:
+//
+//	log.SetOutput(tst.StdLogWriter())
+//	log.Printf("user %d logged in", 42)
+//	tst.FirstEntry().AssertMsg("user 42 logged in")
+func (tst *Tester) StdLogWriter() io.Writer {
+	return &lineWriter{fn: tst.stdLogLine}
+}
+
+// stdLogLine converts the standard library [log] package line to a JSON log
+// entry and writes it to the [Tester].
+func (tst *Tester) stdLogLine(line []byte) {
+	line = bytes.TrimRight(line, "\r\n")
+	if len(bytes.TrimSpace(line)) == 0 {
+		return
+	}
+
+	m := make(map[string]string, 2)
+	loc := stdLogRx.FindSubmatchIndex(line)
+	date, clock := "", ""
+	if loc[2] >= 0 {
+		date = string(line[loc[2] : loc[3]-1])
+	}
+	if loc[4] >= 0 {
+		clock = string(line[loc[4] : loc[5]-1])
+	}
+	if tm, ok := stdLogTime(date, clock); ok {
+		m[tst.cfg.TimeField] = tm.Format(tst.cfg.TimeFormat)
+	}
+	m[tst.cfg.MessageField] = string(line[loc[1]:])
+
+	data, _ := json.Marshal(m) // Map of strings always marshals.
+	_, _ = tst.Write(append(data, '\n'))
+}
+
+// stdLogTime parses the date and time written by the standard library [log]
+// package loggers. Returns false if both are empty or cannot be parsed.
+func stdLogTime(date, clock string) (time.Time, bool) {
+	if date == "" && clock == "" {
+		return time.Time{}, false
+	}
+	if date == "" {
+		date = time.Now().Format("2006/01/02")
+	}
+	if clock == "" {
+		clock = "00:00:00"
+	}
+	const layout = "2006/01/02 15:04:05"
+	tm, err := time.ParseInLocation(layout, date+" "+clock, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return tm, true
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Tester_StdLogWriter(t *testing.T) {
+	t.Run("standard flags", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		lgr := log.New(tst.StdLogWriter(), "", log.LstdFlags)
+
+		// --- When ---
+		lgr.Printf("user %d logged in", 42)
+
+		// --- Then ---
+		ent := tst.FirstEntry()
+		assert.True(t, ent.AssertMsg("user 42 logged in"))
+		assert.True(t, ent.AssertLoggedWithin(time.Now(), "2s"))
+	})
+
+	t.Run("no flags", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		lgr := log.New(tst.StdLogWriter(), "", 0)
+
+		// --- When ---
+		lgr.Print("msg0\nmsg1")
+
+		// --- Then ---
+		want := `{"message":"msg0"}` + "\n" + `{"message":"msg1"}` + "\n"
+		assert.Equal(t, want, tst.String())
+	})
+}
+
+func Test_Tester_stdLogLine(t *testing.T) {
+	today := time.Now().Format("2006-01-02")
+
+	tt := []struct {
+		testN string
+
+		line string
+		want string
+	}{
+		{
+			"date and time",
+			"2009/11/10 23:00:00 message",
+			`{"message":"message","time":"2009-11-10T23:00:00Z"}`,
+		},
+		{
+			"date time and microseconds",
+			"2009/11/10 23:00:00.123456 message",
+			`{"message":"message","time":"2009-11-10T23:00:00Z"}`,
+		},
+		{
+			"date only",
+			"2009/11/10 message",
+			`{"message":"message","time":"2009-11-10T00:00:00Z"}`,
+		},
+		{
+			"time only",
+			"23:00:00 message",
+			`{"message":"message","time":"` + today + `T23:00:00Z"}`,
+		},
+		{
+			"with file",
+			"2009/11/10 23:00:00 main.go:10: message",
+			`{"message":"main.go:10: message","time":"2009-11-10T23:00:00Z"}`,
+		},
+		{
+			"message only",
+			"message",
+			`{"message":"message"}`,
+		},
+		{
+			"prefix",
+			"app: 2009/11/10 23:00:00 message",
+			`{"message":"app: 2009/11/10 23:00:00 message"}`,
+		},
+		{
+			"invalid date",
+			"2009/13/10 message",
+			`{"message":"message"}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			orig := time.Local
+			time.Local = time.UTC
+			defer func() { time.Local = orig }()
+
+			tspy := tester.New(t)
+			tspy.Close()
+
+			tst := New(tspy)
+
+			// --- When ---
+			tst.stdLogLine([]byte(tc.line + "\n"))
+
+			// --- Then ---
+			assert.Equal(t, tc.want+"\n", tst.String())
+		})
+	}
+
+	t.Run("empty line", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		tst.stdLogLine([]byte(" \n"))
+
+		// --- Then ---
+		assert.Equal(t, 0, tst.Len())
+	})
+}
+
+func Test_stdLogTime(t *testing.T) {
+	t.Run("no date and time", func(t *testing.T) {
+		// --- When ---
+		have, ok := stdLogTime("", "")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Zero(t, have)
+	})
+
+	t.Run("date and time", func(t *testing.T) {
+		// --- When ---
+		have, ok := stdLogTime("2009/11/10", "23:00:00")
+
+		// --- Then ---
+		assert.True(t, ok)
+		want := must.Value(time.ParseInLocation(
+			time.DateTime, "2009-11-10 23:00:00", time.Local,
+		))
+		assert.Equal(t, want, have)
+	})
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Suggest returns Go code with assertions reproducing the given log entries.
+// It's meant to bootstrap characterization tests for the existing code: run
+// the code under test, print the suggestion, review it, and paste it into the
+// test. The code expects the entries to be in the `ets` variable. The
+// [Config.TimeField] field is skipped as its values are rarely stable between
+// test runs. Object fields are asserted with [Entry.AssertSubset]. Fields with
+// values which cannot be asserted with typed assertions are listed as
+// comments.
+func Suggest(ets Entries) string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("ets.AssertLen(%d)\n", len(ets.ets)))
+	for i, ent := range ets.ets {
+		sb.WriteString("\n")
+		if i == 0 {
+			sb.WriteString("ent := ets.Entry(0)\n")
+		} else {
+			sb.WriteString(fmt.Sprintf("ent = ets.Entry(%d)\n", i))
+		}
+		sb.WriteString(suggestEntry(ent))
+	}
+	return sb.String()
+}
+
+// suggestEntry returns Go code with assertions for the log entry fields.
+func suggestEntry(ent Entry) string {
+	sb := strings.Builder{}
+	fields := slices.Sorted(maps.Keys(ent.m))
+	exact := exactEntry(ent).m
+	lvl, lvlErr := HasLevel(ent)
+	if lvlErr == nil {
+		sb.WriteString(fmt.Sprintf("ent.AssertLevel(%s)\n", strconv.Quote(lvl)))
+	}
+	if msg, ok := ent.m[ent.cfg.MessageField].(string); ok {
+		sb.WriteString(fmt.Sprintf("ent.AssertMsg(%s)\n", strconv.Quote(msg)))
+	}
+	for _, field := range fields {
+		switch field {
+		case ent.cfg.TimeField:
+			continue
+		case ent.cfg.LevelField:
+			if lvlErr == nil {
+				continue
+			}
+		case ent.cfg.MessageField:
+			if _, ok := ent.m[field].(string); ok {
+				continue
+			}
+		}
+		name := strconv.Quote(field)
+		switch val := ent.m[field].(type) {
+		case string:
+			code := "ent.AssertStr(%s, %s)\n"
+			sb.WriteString(fmt.Sprintf(code, name, strconv.Quote(val)))
+		case float64:
+			sb.WriteString(suggestNumber(name, val, exact[field]))
+		case bool:
+			sb.WriteString(fmt.Sprintf("ent.AssertBool(%s, %t)\n", name, val))
+		case map[string]any:
+			code := "ent.AssertSubset(map[string]any{%s: %s})\n"
+			lit := suggestValue(val)
+			if raw, ok := exact[field].(map[string]any); ok {
+				lit = suggestValue(raw)
+			}
+			sb.WriteString(fmt.Sprintf(code, name, lit))
+		default:
+			code := "// Field %s not asserted: %s\n"
+			sb.WriteString(fmt.Sprintf(code, name, fieldString(val)))
+		}
+	}
+	return sb.String()
+}
+
+// suggestNumber returns Go code with the assertion for the number field. The
+// number is written with the logged digits. The integral numbers which cannot
+// be represented as float64 are asserted as int64 or uint64 when they fit.
+func suggestNumber(name string, val float64, exact any) string {
+	num := strconv.FormatFloat(val, 'f', -1, 64)
+	if raw, ok := exact.(json.Number); ok {
+		num = raw.String()
+	}
+	switch {
+	case numberEqual(num, val):
+		return fmt.Sprintf("ent.AssertNumber(%s, %s)\n", name, num)
+	case isInt64(num):
+		return fmt.Sprintf("ent.AssertInt64(%s, %s)\n", name, num)
+	case isUint64(num):
+		return fmt.Sprintf("ent.AssertUint64(%s, %s)\n", name, num)
+	default:
+		return fmt.Sprintf("// Field %s not asserted: %s\n", name, num)
+	}
+}
+
+// isInt64 reports whether the number is an integer which fits int64.
+func isInt64(num string) bool {
+	_, err := strconv.ParseInt(num, 10, 64)
+	return err == nil
+}
+
+// isUint64 reports whether the number is an integer which fits uint64.
+func isUint64(num string) bool {
+	_, err := strconv.ParseUint(num, 10, 64)
+	return err == nil
+}
+
+// suggestValue returns the Go literal for the JSON decoded value. Numbers are
+// written as float constants, so they keep the logged digits and compile in
+// the "any" context regardless of their size.
+func suggestValue(val any) string {
+	switch val := val.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(val)
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return floatLiteral(strconv.FormatFloat(val, 'g', -1, 64))
+	case json.Number:
+		return floatLiteral(val.String())
+	case []any:
+		items := make([]string, 0, len(val))
+		for _, item := range val {
+			items = append(items, suggestValue(item))
+		}
+		return "[]any{" + strings.Join(items, ", ") + "}"
+	case map[string]any:
+		items := make([]string, 0, len(val))
+		for _, key := range slices.Sorted(maps.Keys(val)) {
+			item := strconv.Quote(key) + ": " + suggestValue(val[key])
+			items = append(items, item)
+		}
+		return "map[string]any{" + strings.Join(items, ", ") + "}"
+	default:
+		return fmt.Sprintf("%#v", val)
+	}
+}
+
+// floatLiteral returns the number as a float constant literal.
+func floatLiteral(num string) string {
+	if strings.ContainsAny(num, ".eE") {
+		return num
+	}
+	return num + ".0"
+}
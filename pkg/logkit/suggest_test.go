@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Suggest(t *testing.T) {
+	t.Run("with entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(
+			tspy,
+			`{"level": "info", "time": "2000-01-02T03:04:05Z", "message": "msg0"}`,
+			`{"level": "error", "message": "msg1", "B": true, "A": 1.5}`,
+		)
+
+		// --- When ---
+		have := Suggest(ets)
+
+		// --- Then ---
+		want := "" +
+			"ets.AssertLen(2)\n" +
+			"\n" +
+			"ent := ets.Entry(0)\n" +
+			"ent.AssertLevel(\"info\")\n" +
+			"ent.AssertMsg(\"msg0\")\n" +
+			"\n" +
+			"ent = ets.Entry(1)\n" +
+			"ent.AssertLevel(\"error\")\n" +
+			"ent.AssertMsg(\"msg1\")\n" +
+			"ent.AssertNumber(\"A\", 1.5)\n" +
+			"ent.AssertBool(\"B\", true)\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("without entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t, 0)
+		tspy.Close()
+
+		ets := MustEntries(tspy)
+
+		// --- When ---
+		have := Suggest(ets)
+
+		// --- Then ---
+		assert.Equal(t, "ets.AssertLen(0)\n", have)
+	})
+}
+
+func Test_Suggest_generatedCode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a module with the go command")
+	}
+
+	// --- Given ---
+	lines := "" +
+		`{"level": "info", "time": "2000-01-02T03:04:05Z", "message": "msg0", ` +
+		`"str": "say \"hi\"", "bool": true, "num": 1.50, ` +
+		`"big": 12345678901234567890, "neg": -9007199254740993, ` +
+		`"arr": [1, 2]}` + "\n" +
+		`{"level": "error", "message": "msg1", "map": {"int": 1, ` +
+		`"big": 12345678901234567890, "arr": [1, 2.5, 1e3], "null": null, ` +
+		`"obj": {"str": "abc", "bool": false}}}` + "\n"
+	tst := New(t, WithString(lines))
+	code := Suggest(tst.Entries())
+
+	root := must.Value(filepath.Abs("../.."))
+	mod := string(must.Value(os.ReadFile(filepath.Join(root, "go.mod"))))
+	mod = strings.Replace(mod, "module github.com/ctx42/logkit", "module x", 1)
+	mod += "\nrequire github.com/ctx42/logkit v0.0.0\n" +
+		"\nreplace github.com/ctx42/logkit => " + root + "\n"
+	sum := must.Value(os.ReadFile(filepath.Join(root, "go.sum")))
+
+	src := "" +
+		"package x\n" +
+		"\n" +
+		"import (\n" +
+		"\t\"testing\"\n" +
+		"\n" +
+		"\t\"github.com/ctx42/logkit/pkg/logkit\"\n" +
+		")\n" +
+		"\n" +
+		"func Test_Suggested(t *testing.T) {\n" +
+		"\tets := logkit.New(t, logkit.WithString(" +
+		strconv.Quote(lines) + ")).Entries()\n" +
+		code +
+		"}\n"
+
+	dir := t.TempDir()
+	must.Nil(os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0o600))
+	must.Nil(os.WriteFile(filepath.Join(dir, "go.sum"), sum, 0o600))
+	pth := filepath.Join(dir, "x_test.go")
+	must.Nil(os.WriteFile(pth, []byte(src), 0o600))
+
+	// --- When ---
+	cmd := exec.Command("go", "test", "-count=1", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+	out, err := cmd.CombinedOutput()
+
+	// --- Then ---
+	if !assert.NoError(t, err) {
+		t.Log(string(out))
+	}
+	assert.Contain(t, "ok", string(out))
+	assert.Contain(t, "ent.AssertSubset(", code)
+}
+
+func Test_suggestEntry(t *testing.T) {
+	t.Run("all field types", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(
+			tspy,
+			`{"level": "info", "message": "say \"hi\"", "str": "abc", `+
+				`"num": 42, "bool": false, "map": {"A": "a"}, `+
+				`"arr": [1, 2], "null": null}`,
+		).Entry(0)
+
+		// --- When ---
+		have := suggestEntry(ent)
+
+		// --- Then ---
+		want := "" +
+			"ent.AssertLevel(\"info\")\n" +
+			"ent.AssertMsg(\"say \\\"hi\\\"\")\n" +
+			"// Field \"arr\" not asserted: [1,2]\n" +
+			"ent.AssertBool(\"bool\", false)\n" +
+			"ent.AssertSubset(map[string]any{\"map\": " +
+			"map[string]any{\"A\": \"a\"}})\n" +
+			"// Field \"null\" not asserted: null\n" +
+			"ent.AssertNumber(\"num\", 42)\n" +
+			"ent.AssertStr(\"str\", \"abc\")\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("numbers keep logged digits", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(
+			tspy,
+			`{"big": 12345678901234567890, "num": 1.50, `+
+				`"neg": -9007199254740993, "huge": 1234567890123456789012, `+
+				`"map": {"int": 1, "big": 12345678901234567890, `+
+				`"arr": [1, 2.5, 1e3], "null": null, "bool": true}}`,
+		).Entry(0)
+
+		// --- When ---
+		have := suggestEntry(ent)
+
+		// --- Then ---
+		want := "" +
+			"ent.AssertUint64(\"big\", 12345678901234567890)\n" +
+			"// Field \"huge\" not asserted: 1234567890123456789012\n" +
+			"ent.AssertSubset(map[string]any{\"map\": map[string]any{" +
+			"\"arr\": []any{1.0, 2.5, 1e3}, " +
+			"\"big\": 12345678901234567890.0, " +
+			"\"bool\": true, " +
+			"\"int\": 1.0, " +
+			"\"null\": nil}})\n" +
+			"ent.AssertInt64(\"neg\", -9007199254740993)\n" +
+			"ent.AssertNumber(\"num\", 1.50)\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("non string level and message", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		ent := MustEntries(tspy, `{"level": 30, "message": 1}`).Entry(0)
+
+		// --- When ---
+		have := suggestEntry(ent)
+
+		// --- Then ---
+		want := "" +
+			"ent.AssertNumber(\"level\", 30)\n" +
+			"ent.AssertNumber(\"message\", 1)\n"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("level parser", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		lin := `{"level": 30, "msg": "hi"}`
+		tst := New(tspy, WithConfig(BunyanConfig()), WithString(lin))
+		ent := tst.Entries().Entry(0)
+
+		// --- When ---
+		have := suggestEntry(ent)
+
+		// --- Then ---
+		want := "" +
+			"ent.AssertLevel(\"info\")\n" +
+			"ent.AssertMsg(\"hi\")\n"
+		assert.Equal(t, want, have)
+	})
+}
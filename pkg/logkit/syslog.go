@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ctx42/testing/pkg/notice"
+)
+
+// Names of the fields set from the RFC5424 syslog message header.
+const (
+	SyslogFacility = "facility"
+	SyslogSeverity = "severity"
+	SyslogHostname = "hostname"
+	SyslogAppName  = "app_name"
+	SyslogProcID   = "proc_id"
+	SyslogMsgID    = "msg_id"
+)
+
+// SyslogWriter returns an [io.Writer] for RFC5424 syslog messages, which
+// converts each message to a JSON log entry, see [ParseSyslog], written to
+// the [Tester]. Messages framed with the RFC6587 octet counting are split by
+// their length, other messages are split by new lines. Lines which are not
+// valid RFC5424 messages are captured as entries with the line in the
+// [Config.MessageField] field.
+//
+// Example usage:
+//
+//	w := tst.SyslogWriter()
+//	// Configure the agent to write syslog messages to w.
+//	tst.WaitFor("5s", logkit.CheckStr("user", "alice"))
+func (tst *Tester) SyslogWriter() io.Writer {
+	return &syslogWriter{fn: tst.syslogLine}
+}
+
+// syslogLine converts RFC5424 syslog message to a JSON log entry and writes
+// it to the [Tester].
+func (tst *Tester) syslogLine(line []byte) {
+	str := strings.TrimRight(string(line), "\r\n")
+	if strings.TrimSpace(str) == "" {
+		return
+	}
+	m, err := ParseSyslog(tst.cfg, str)
+	if err != nil {
+		m = map[string]any{tst.cfg.MessageField: str}
+	}
+	data, _ := json.Marshal(m) // Map of strings and numbers always marshals.
+	_, _ = tst.Write(append(data, '\n'))
+}
+
+// syslogWriter represents an [io.Writer] which splits written data into
+// syslog messages and calls a function for each complete message.
+type syslogWriter struct {
+	fn   func(msg []byte) // Function called for each message.
+	rest []byte           // Incomplete message written so far.
+	mx   sync.Mutex       // Guards the structure fields.
+}
+
+// Write implements [io.Writer] interface. It never returns an error.
+func (sw *syslogWriter) Write(p []byte) (int, error) {
+	sw.mx.Lock()
+	defer sw.mx.Unlock()
+
+	sw.rest = append(sw.rest, p...)
+	for {
+		msg, n := syslogFrame(sw.rest)
+		if n == 0 {
+			break
+		}
+		sw.fn(msg)
+		sw.rest = sw.rest[n:]
+	}
+	return len(p), nil
+}
+
+// syslogFrame returns the first complete syslog message in the buffer and
+// the number of bytes it takes in the buffer. When the buffer starts with the
+// RFC6587 octet count, the message is the given number of bytes after it.
+// Otherwise, the message is the first line. Returns zero bytes when there is
+// no complete message in the buffer.
+func syslogFrame(buf []byte) ([]byte, int) {
+	if len(buf) > 0 && buf[0] >= '1' && buf[0] <= '9' {
+		if sp := bytes.IndexByte(buf, ' '); sp > 0 {
+			if cnt, err := strconv.Atoi(string(buf[:sp])); err == nil {
+				if len(buf) < sp+1+cnt {
+					return nil, 0
+				}
+				return buf[sp+1 : sp+1+cnt], sp + 1 + cnt
+			}
+		}
+	}
+	idx := bytes.IndexByte(buf, '\n')
+	if idx < 0 {
+		return nil, 0
+	}
+	return buf[:idx+1], idx + 1
+}
+
+// ParseSyslog parses RFC5424 syslog message, optionally prefixed with the
+// RFC6587 octet count, and returns its log entry fields. The timestamp is set
+// in the [Config.TimeField] field formatted with [Config.TimeFormat], the
+// severity is set in the [Config.LevelField] field using the configured level
+// values, and the message is set in the [Config.MessageField] field. Other
+// header values are set in the [SyslogFacility] and other fields, and the
+// structured data parameters are set as fields with their names, unless they
+// collide with the header fields. Header fields with nil values ("-") are not
+// set. Returns an error having [ErrFormat] in its chain if the message is not
+// valid.
+func ParseSyslog(cfg *Config, line string) (map[string]any, error) {
+	msg := syslogUnframe(line)
+	errFn := func(reason string) error {
+		return notice.New("[syslog] expected RFC5424 message").
+			Append("reason", "%s", reason).
+			Append("line", "%s", line).
+			Wrap(ErrFormat)
+	}
+
+	end := strings.IndexByte(msg, '>')
+	if !strings.HasPrefix(msg, "<") || end < 2 || end > 4 {
+		return nil, errFn("invalid priority")
+	}
+	pri, err := strconv.Atoi(msg[1:end])
+	if err != nil || pri > 191 {
+		return nil, errFn("invalid priority")
+	}
+	msg = msg[end+1:]
+
+	hdr := strings.SplitN(msg, " ", 7)
+	if len(hdr) < 7 {
+		return nil, errFn("incomplete header")
+	}
+	if hdr[0] != "1" {
+		return nil, errFn("unsupported version")
+	}
+
+	m := make(map[string]any)
+	rest, ok := syslogSD(hdr[6], m)
+	if !ok || (rest != "" && rest[0] != ' ') {
+		return nil, errFn("invalid structured data")
+	}
+
+	if hdr[1] != "-" {
+		tm, err := time.Parse(time.RFC3339Nano, hdr[1])
+		if err != nil {
+			return nil, errFn("invalid timestamp")
+		}
+		m[cfg.TimeField] = tm.Format(cfg.TimeFormat)
+	}
+	for i, name := range []string{
+		SyslogHostname, SyslogAppName, SyslogProcID, SyslogMsgID,
+	} {
+		if hdr[i+2] != "-" {
+			m[name] = hdr[i+2]
+		}
+	}
+	m[SyslogFacility] = pri / 8
+	m[SyslogSeverity] = pri % 8
+	m[cfg.LevelField] = syslogLevel(cfg, pri%8)
+	if rest != "" {
+		m[cfg.MessageField] = strings.TrimPrefix(rest[1:], "\ufeff")
+	}
+	return m, nil
+}
+
+// syslogUnframe removes the RFC6587 octet count prefix from the message.
+func syslogUnframe(msg string) string {
+	num, rest, ok := strings.Cut(msg, " ")
+	if !ok || !strings.HasPrefix(rest, "<") {
+		return msg
+	}
+	if _, err := strconv.Atoi(num); err != nil {
+		return msg
+	}
+	return rest
+}
+
+// syslogSD parses the structured data at the beginning of the string and
+// sets its parameters in the map. Returns the rest of the string and false if
+// the structured data is not valid.
+func syslogSD(str string, m map[string]any) (string, bool) {
+	if strings.HasPrefix(str, "-") {
+		return str[1:], true
+	}
+	if !strings.HasPrefix(str, "[") {
+		return str, false
+	}
+	for strings.HasPrefix(str, "[") {
+		end := strings.IndexAny(str, " ]")
+		if end < 2 {
+			return str, false
+		}
+		str = str[end:]
+		for {
+			if str == "" {
+				return str, false
+			}
+			if str[0] == ']' {
+				str = str[1:]
+				break
+			}
+			if str[0] != ' ' {
+				return str, false
+			}
+			name, rest, ok := strings.Cut(str[1:], "=")
+			if !ok || name == "" || !strings.HasPrefix(rest, `"`) {
+				return str, false
+			}
+			var val string
+			if val, str, ok = syslogParam(rest[1:]); !ok {
+				return str, false
+			}
+			m[name] = val
+		}
+	}
+	return str, true
+}
+
+// syslogParam returns unescaped structured data parameter value up to the
+// closing quote and the rest of the string after it. Returns false if the
+// closing quote is missing.
+func syslogParam(str string) (string, string, bool) {
+	var buf strings.Builder
+	for i := 0; i < len(str); i++ {
+		switch chr := str[i]; chr {
+		case '\\':
+			if i+1 < len(str) && strings.IndexByte(`"\]`, str[i+1]) >= 0 {
+				i++
+			}
+			buf.WriteByte(str[i])
+		case '"':
+			return buf.String(), str[i+1:], true
+		default:
+			buf.WriteByte(chr)
+		}
+	}
+	return "", str, false
+}
+
+// syslogLevel returns the level value for the syslog severity.
+func syslogLevel(cfg *Config, severity int) string {
+	switch severity {
+	case 0, 1, 2:
+		return cfg.LevelFatalValue
+	case 3:
+		return cfg.LevelErrorValue
+	case 4:
+		return cfg.LevelWarnValue
+	case 7:
+		return cfg.LevelDebugValue
+	default:
+		return cfg.LevelInfoValue
+	}
+}
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_Tester_SyslogWriter(t *testing.T) {
+	t.Run("valid message", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		w := tst.SyslogWriter()
+
+		// --- When ---
+		must.Value(w.Write([]byte("" +
+			`<165>1 2003-10-11T22:14:15.003Z host app 1 ID47 ` +
+			`[ex@32473 iut="3" user="alice"] user logged in` + "\n",
+		)))
+
+		// --- Then ---
+		ent := tst.FirstEntry()
+		assert.True(t, ent.AssertMsg("user logged in"))
+		assert.True(t, ent.AssertLevel("info"))
+		assert.True(t, ent.AssertStr("user", "alice"))
+		assert.True(t, ent.AssertStr(SyslogAppName, "app"))
+	})
+
+	t.Run("octet counting", func(t *testing.T) {
+		// --- Given ---
+		msg0 := `<165>1 - host app - - - msg0`
+		msg1 := "<165>1 - host app - - - multi\nline"
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		w := tst.SyslogWriter()
+		data := fmt.Sprintf("%d %s%d %s", len(msg0), msg0, len(msg1), msg1)
+
+		// --- When ---
+		must.Value(w.Write([]byte(data[:10])))
+		must.Value(w.Write([]byte(data[10:])))
+
+		// --- Then ---
+		assert.Equal(t, 2, tst.Len())
+		assert.True(t, tst.Entries().Entry(0).AssertMsg("msg0"))
+		assert.True(t, tst.Entries().Entry(1).AssertMsg("multi\nline"))
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		w := tst.SyslogWriter()
+
+		// --- When ---
+		must.Value(w.Write([]byte("not syslog\n\n")))
+
+		// --- Then ---
+		assert.Equal(t, `{"message":"not syslog"}`+"\n", tst.String())
+	})
+}
+
+func Test_ParseSyslog(t *testing.T) {
+	t.Run("full message", func(t *testing.T) {
+		// --- Given ---
+		line := "" +
+			`<34>1 2003-10-11T22:14:15.003Z mymachine.example.com su - ` +
+			`ID47 [exampleSDID@32473 iut="3" eventSource="Application"]` +
+			`[origin ip="192.0.2.1"] ` + "\ufeff" + `'su root' failed`
+
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), line)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"time":        "2003-10-11T22:14:15Z",
+			"level":       "fatal",
+			"message":     "'su root' failed",
+			"facility":    4,
+			"severity":    2,
+			"hostname":    "mymachine.example.com",
+			"app_name":    "su",
+			"msg_id":      "ID47",
+			"iut":         "3",
+			"eventSource": "Application",
+			"ip":          "192.0.2.1",
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("nil values and no message", func(t *testing.T) {
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), "<15>1 - - - - - -")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{"level": "debug", "facility": 1, "severity": 7}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("octet counting", func(t *testing.T) {
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), "22 <11>1 - - - - - - msg")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "error", have["level"])
+		assert.Equal(t, "msg", have["message"])
+	})
+
+	t.Run("escaped parameter value", func(t *testing.T) {
+		// --- Given ---
+		line := `<14>1 - - - - - [id a="x\"y\]z\\w\n"]`
+
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), line)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `x"y]z\w\n`, have["a"])
+	})
+
+	t.Run("header fields take precedence", func(t *testing.T) {
+		// --- When ---
+		line := `<14>1 - - - - - [id level="fatal"] msg`
+		have, err := ParseSyslog(DefaultConfig(), line)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "info", have["level"])
+	})
+
+	t.Run("error - invalid priority", func(t *testing.T) {
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), "<192>1 - - - - - -")
+
+		// --- Then ---
+		wMsg := "" +
+			"[syslog] expected RFC5424 message:\n" +
+			"  reason: invalid priority\n" +
+			"    line: <192>1 - - - - - -"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.ErrorIs(t, ErrFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - unsupported version", func(t *testing.T) {
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), "<14>2 - - - - - -")
+
+		// --- Then ---
+		assert.ErrorContain(t, "reason: unsupported version", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - incomplete header", func(t *testing.T) {
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), "<14>1 - - -")
+
+		// --- Then ---
+		assert.ErrorContain(t, "reason: incomplete header", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid timestamp", func(t *testing.T) {
+		// --- When ---
+		have, err := ParseSyslog(DefaultConfig(), "<14>1 abc - - - - -")
+
+		// --- Then ---
+		assert.ErrorContain(t, "reason: invalid timestamp", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid structured data", func(t *testing.T) {
+		tt := []string{
+			`<14>1 - - - - - x`,
+			`<14>1 - - - - - [id`,
+			`<14>1 - - - - - [id a=b]`,
+			`<14>1 - - - - - [id a="b]`,
+			`<14>1 - - - - - []`,
+			`<14>1 - - - - - -x`,
+		}
+
+		for _, line := range tt {
+			// --- When ---
+			have, err := ParseSyslog(DefaultConfig(), line)
+
+			// --- Then ---
+			assert.ErrorContain(t, "reason: invalid structured data", err)
+			assert.Nil(t, have)
+		}
+	})
+}
+
+func Test_syslogFrame(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		buf  string
+		want string
+		n    int
+	}{
+		{"line", "<1>1 a\nrest", "<1>1 a\n", 7},
+		{"incomplete line", "<1>1 a", "", 0},
+		{"octet counting", "6 <1>1 arest", "<1>1 a", 8},
+		{"incomplete octet counting", "6 <1>1", "", 0},
+		{"incomplete octet count", "6", "", 0},
+		{"not octet count", "6a b\n", "6a b\n", 5},
+		{"empty", "", "", 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, n := syslogFrame([]byte(tc.buf))
+
+			// --- Then ---
+			assert.Equal(t, tc.want, string(have))
+			assert.Equal(t, tc.n, n)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+// TailPoll is the default interval [Tail] checks the file for changes.
+const TailPoll = 10 * time.Millisecond
+
+// TailOptions represents options for [Tail].
+type TailOptions struct {
+	poll time.Duration   // Interval to check the file for changes.
+	opts []func(*Tester) // Options for the created [Tester].
+}
+
+// WithTailPoll is an option for [Tail] setting the interval the file is
+// checked for changes. By default, [TailPoll] is used.
+func WithTailPoll(poll time.Duration) func(*TailOptions) {
+	return func(ops *TailOptions) { ops.poll = poll }
+}
+
+// WithTailTester is an option for [Tail] setting options for the created
+// [Tester].
+func WithTailTester(opts ...func(*Tester)) func(*TailOptions) {
+	return func(ops *TailOptions) { ops.opts = append(ops.opts, opts...) }
+}
+
+// Tail creates a new [Tester] and feeds it with log lines written to the file
+// at the given path, starting from its beginning. It follows the file as it
+// grows and handles the file being truncated, rotated, or not existing yet.
+// Tailing stops when the test ends. It allows using [Tester.WaitFor] and
+// other [Tester] methods for processes writing logs to disk.
+//
+// Example usage:
+//
+//	tst := logkit.Tail(t, "app.log")
+//	// Start the process writing to app.log.
+//	tst.WaitFor("5s", logkit.CheckMsg("started"))
+func Tail(t tester.T, pth string, opts ...func(*TailOptions)) *Tester {
+	t.Helper()
+	ops := &TailOptions{poll: TailPoll}
+	for _, opt := range opts {
+		opt(ops)
+	}
+
+	tst := New(t, ops.opts...)
+	tlr := &tailer{pth: pth, tst: tst}
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		defer tlr.close()
+		tick := time.NewTicker(ops.poll)
+		defer tick.Stop()
+		for {
+			if err := tlr.poll(); err != nil {
+				t.Error(err)
+				return
+			}
+			select {
+			case <-stop:
+				return
+			case <-tick.C:
+			}
+		}
+	}()
+
+	t.Cleanup(func() {
+		close(stop)
+		<-done
+	})
+	return tst
+}
+
+// tailer represents the state of the tailed file.
+type tailer struct {
+	pth  string      // Path to the tailed file.
+	fil  *os.File    // Currently open file, nil when not opened yet.
+	inf  fs.FileInfo // Info of the currently open file.
+	off  int64       // Read offset in the currently open file.
+	rest []byte      // Incomplete log line read so far.
+	tst  *Tester     // Tester fed with the log lines.
+}
+
+// poll reads all new log lines from the file and writes them to the [Tester].
+// When the file was rotated, it reads the rest of the old file and switches
+// to the new one.
+func (tlr *tailer) poll() error {
+	if tlr.fil == nil {
+		if err := tlr.open(); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	inf, err := tlr.fil.Stat()
+	if err != nil {
+		return err
+	}
+	if inf.Size() < tlr.off {
+		// File was truncated.
+		tlr.off, tlr.rest = 0, tlr.rest[:0]
+	}
+	if err = tlr.read(); err != nil {
+		return err
+	}
+
+	cur, err := os.Stat(tlr.pth)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if cur == nil || os.SameFile(tlr.inf, cur) {
+		return nil
+	}
+
+	// File was rotated.
+	tlr.flush()
+	tlr.close()
+	return tlr.poll()
+}
+
+// open opens the tailed file.
+func (tlr *tailer) open() error {
+	fil, err := os.Open(tlr.pth)
+	if err != nil {
+		return err
+	}
+	inf, err := fil.Stat()
+	if err != nil {
+		_ = fil.Close()
+		return err
+	}
+	tlr.fil, tlr.inf, tlr.off = fil, inf, 0
+	return nil
+}
+
+// read reads the file from the current offset to its end and writes complete
+// log lines to the [Tester].
+func (tlr *tailer) read() error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := tlr.fil.ReadAt(buf, tlr.off)
+		tlr.off += int64(n)
+		tlr.rest = append(tlr.rest, buf[:n]...)
+		for {
+			idx := bytes.IndexByte(tlr.rest, '\n')
+			if idx < 0 {
+				break
+			}
+			tlr.write(tlr.rest[:idx+1])
+			tlr.rest = tlr.rest[idx+1:]
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// flush writes the incomplete log line, if any, to the [Tester].
+func (tlr *tailer) flush() {
+	if len(tlr.rest) > 0 {
+		tlr.write(append(tlr.rest, '\n'))
+	}
+	tlr.rest = nil
+}
+
+// write writes a non-empty log line to the [Tester].
+func (tlr *tailer) write(line []byte) {
+	if len(bytes.TrimSpace(line)) > 0 {
+		_, _ = tlr.tst.Write(bytes.Clone(line))
+	}
+}
+
+// close closes the currently open file, if any.
+func (tlr *tailer) close() {
+	if tlr.fil != nil {
+		_ = tlr.fil.Close()
+		tlr.fil = nil
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (c) 2025 Rafal Zajac <rzajac@gmail.com>
+// SPDX-License-Identifier: MIT
+
+package logkit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+	"github.com/ctx42/testing/pkg/tester"
+)
+
+func Test_WithTailPoll(t *testing.T) {
+	// --- Given ---
+	ops := &TailOptions{}
+
+	// --- When ---
+	WithTailPoll(time.Second)(ops)
+
+	// --- Then ---
+	assert.Equal(t, time.Second, ops.poll)
+}
+
+func Test_WithTailTester(t *testing.T) {
+	// --- Given ---
+	ops := &TailOptions{}
+
+	// --- When ---
+	WithTailTester(WithMaxEntries(1), WithMaxBytes(1))(ops)
+
+	// --- Then ---
+	assert.Len(t, 2, ops.opts)
+}
+
+func Test_Tail(t *testing.T) {
+	t.Run("existing file", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "app.log")
+		lin0 := `{"level":"info", "message":"msg0"}` + "\n"
+		must.Nil(os.WriteFile(pth, []byte(lin0), 0o600))
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		// --- When ---
+		tst := Tail(tspy, pth, WithTailPoll(time.Millisecond))
+
+		// --- Then ---
+		assert.True(t, tst.WaitForLen("1s", 1))
+		tspy.Finish()
+		assert.Equal(t, lin0, tst.String())
+	})
+
+	t.Run("growing file", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "app.log")
+		fil := must.Value(os.Create(pth))
+		defer func() { _ = fil.Close() }()
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := Tail(tspy, pth, WithTailPoll(time.Millisecond))
+
+		// --- When ---
+		must.Value(fil.WriteString(`{"message":"msg0"}` + "\n"))
+		must.Value(fil.WriteString(`{"message":`))
+		must.Value(fil.WriteString(`"msg1"}` + "\n"))
+
+		// --- Then ---
+		assert.True(t, tst.WaitForLen("1s", 2))
+		ets := tst.Entries().Get()
+		assert.Equal(t, `{"message":"msg0"}`, ets[0].String())
+		assert.Equal(t, `{"message":"msg1"}`, ets[1].String())
+		tspy.Finish()
+	})
+
+	t.Run("file created later", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "app.log")
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := Tail(tspy, pth, WithTailPoll(time.Millisecond))
+
+		// --- When ---
+		must.Nil(os.WriteFile(pth, []byte(`{"message":"msg0"}`+"\n"), 0o600))
+
+		// --- Then ---
+		assert.True(t, tst.WaitForLen("1s", 1))
+		tspy.Finish()
+	})
+
+	t.Run("rotated file", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		pth := filepath.Join(dir, "app.log")
+		lin0 := `{"message":"msg0"}` + "\n"
+		must.Nil(os.WriteFile(pth, []byte(lin0), 0o600))
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := Tail(tspy, pth, WithTailPoll(time.Millisecond))
+		assert.True(t, tst.WaitForLen("1s", 1))
+
+		// --- When ---
+		must.Nil(os.Rename(pth, filepath.Join(dir, "app.log.1")))
+		must.Nil(os.WriteFile(pth, []byte(`{"message":"msg1"}`+"\n"), 0o600))
+
+		// --- Then ---
+		assert.True(t, tst.WaitForLen("1s", 2))
+		ets := tst.Entries().Get()
+		assert.Equal(t, `{"message":"msg1"}`, ets[1].String())
+		tspy.Finish()
+	})
+
+	t.Run("truncated file", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "app.log")
+		lin0 := `{"message":"msg0", "str":"abc"}` + "\n"
+		must.Nil(os.WriteFile(pth, []byte(lin0), 0o600))
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := Tail(tspy, pth, WithTailPoll(time.Millisecond))
+		assert.True(t, tst.WaitForLen("1s", 1))
+
+		// --- When ---
+		must.Nil(os.Truncate(pth, 0))
+		time.Sleep(20 * time.Millisecond)
+		fil := must.Value(os.OpenFile(pth, os.O_WRONLY|os.O_APPEND, 0))
+		must.Value(fil.WriteString(`{"message":"msg1"}` + "\n"))
+		must.Nil(fil.Close())
+
+		// --- Then ---
+		assert.True(t, tst.WaitForLen("1s", 2))
+		ets := tst.Entries().Get()
+		assert.Equal(t, `{"message":"msg1"}`, ets[1].String())
+		tspy.Finish()
+	})
+
+	t.Run("with tester options", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "app.log")
+		lin0 := `{"message":"msg0"}` + "\n"
+		lin1 := `{"message":"msg1"}` + "\n"
+		must.Nil(os.WriteFile(pth, []byte(lin0+lin1), 0o600))
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		// --- When ---
+		tst := Tail(
+			tspy,
+			pth,
+			WithTailPoll(time.Millisecond),
+			WithTailTester(WithMaxEntries(1)),
+		)
+
+		// --- Then ---
+		assert.True(t, tst.WaitForLen("1s", 2))
+		tspy.Finish()
+		assert.Equal(t, lin1, tst.String())
+	})
+	t.Run("error - path is a directory", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("is a directory")
+		tspy.Close()
+
+		// --- When ---
+		Tail(tspy, t.TempDir(), WithTailPoll(time.Millisecond))
+
+		// --- Then ---
+		tspy.Finish()
+	})
+}
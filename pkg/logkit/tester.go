@@ -7,8 +7,14 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"slices"
+	"strconv"
 	"sync"
 	"time"
 
@@ -27,6 +33,46 @@ func WithString(content string) func(*Tester) {
 	return func(tst *Tester) { tst.buf = []byte(content) }
 }
 
+// WithMaxEntries is an option for [New] which limits the number of log entries
+// kept by the [Tester] to the n most recent ones. Older entries are dropped,
+// see [Tester.Dropped]. Zero or negative value means no limit.
+func WithMaxEntries(n int) func(*Tester) {
+	return func(tst *Tester) { tst.maxEnts = n }
+}
+
+// WithMaxBytes is an option for [New] which limits the size of the log
+// entries kept by the [Tester] to n bytes. Older entries are dropped until
+// the kept ones fit the limit, see [Tester.Dropped]. Zero or negative value
+// means no limit.
+func WithMaxBytes(n int) func(*Tester) {
+	return func(tst *Tester) { tst.maxBytes = n }
+}
+
+// WithWriteError is an option for [New] which makes [Tester.Write] and
+// [Tester.ReadFrom] return the error, without writing the log message, after
+// n successful writes. It's useful for testing how the code reacts to a
+// failing log sink.
+func WithWriteError(err error, afterN int) func(*Tester) {
+	return func(tst *Tester) { tst.errWrite, tst.errAfter = err, afterN }
+}
+
+// WithWriteDelay is an option for [New] which makes every [Tester.Write] call
+// and every line ingested by [Tester.ReadFrom] block for the given duration
+// before writing the log message. It's useful for testing how the code
+// handles a slow log sink.
+func WithWriteDelay(d time.Duration) func(*Tester) {
+	return func(tst *Tester) { tst.delay = d }
+}
+
+// WithInterleaveCheck is an option for [New] which makes [Tester.Write] and
+// [Tester.ReadFrom] mark the test as failed when the written payload is not
+// valid JSON, but it is when concatenated with the previous payload. It's a
+// symptom of a log line split into many writes interleaved with other writes,
+// like when many goroutines share a writer without locking.
+func WithInterleaveCheck() func(*Tester) {
+	return func(tst *Tester) { tst.detect = true }
+}
+
 // WithConfig is an option for [New] which sets [Tester] configuration
 func WithConfig(cfg *Config) func(*Tester) {
 	return func(tst *Tester) { tst.cfg = cfg }
@@ -42,13 +88,37 @@ func WithConfig(cfg *Config) func(*Tester) {
 //
 //	tst.Entries().Summary() // Print logged messages.
 type Tester struct {
-	cfg      *Config      // Tester configuration.
-	buf      []byte       // Buffer for logger writes.
-	cnt      int          // Number of all log messages (calls to Write).
-	matchers []*Matcher   // Log line matchers.
-	matchIdx int          // Last matched log entry index (-1 means none).
-	mx       sync.RWMutex // Guards the structure fields.
-	t        tester.T     // Test manager.
+	cfg      *Config            // Tester configuration.
+	buf      []byte             // Buffer for logger writes.
+	cnt      int                // Number of all log messages (calls to Write).
+	dropped  int                // Number of log messages dropped from buf.
+	sizes    []int              // Sizes of log messages in buf when limited.
+	maxEnts  int                // Max number of log messages to keep.
+	maxBytes int                // Max size of log messages to keep.
+	writes   int                // Number of successful calls to Write.
+	errWrite error              // Error returned by Write, when set.
+	errAfter int                // Writes before returning errWrite.
+	delay    time.Duration      // Delay of every call to Write.
+	detect   bool               // Detect interleaved writes.
+	frag     []byte             // Previous payload when it's not valid JSON.
+	synced   bool               // Set when Sync was called.
+	closed   bool               // Set when Close was called.
+	matchers []*Matcher         // Log line matchers.
+	forbid   []*Matcher         // Matchers failing the test on match.
+	seqs     []*SequenceMatcher // Sequence matchers.
+	watch    []*Matcher         // Matchers run on every write.
+	waiters  []lenWaiter        // Waiters for the number of log messages.
+	subs     []*Tester          // Scoped sub-testers, see [Tester.Sub].
+	matchIdx int                // Last matched log entry index (-1 means none).
+	mx       sync.RWMutex       // Guards the structure fields.
+	t        tester.T           // Test manager.
+}
+
+// lenWaiter represents a waiter for the number of log messages written to the
+// [Tester]. The done channel is closed when the number is reached.
+type lenWaiter struct {
+	n    int           // Number of log messages to wait for.
+	done chan struct{} // Closed when n log messages were written.
 }
 
 // New creates a new instance of [Tester].
@@ -73,49 +143,457 @@ func New(t tester.T, opts ...func(*Tester)) *Tester {
 	if err := scn.Err(); err != nil {
 		t.Error(err)
 	}
+	tst.strict(0, tst.buf)
+
+	if tst.limited() {
+		for _, line := range bytes.SplitAfter(tst.buf, []byte{'\n'}) {
+			if len(line) > 0 {
+				tst.sizes = append(tst.sizes, len(line))
+			}
+		}
+		tst.trim()
+	}
 	return tst
 }
 
-// Load loads the existing log from the path.
-func Load(t tester.T, pth string) *Tester {
+// Load loads the existing log from the path. The options are applied to the
+// created [Tester], see [New].
+func Load(t tester.T, pth string, opts ...func(*Tester)) *Tester {
 	t.Helper()
 	buf, err := os.ReadFile(pth)
 	if err != nil {
 		t.Error(err)
 		return nil
 	}
-	return New(t, WithBytes(buf))
+	return New(t, append([]func(*Tester){WithBytes(buf)}, opts...)...)
+}
+
+// LoadReader loads the existing log from the reader. The options are applied
+// to the created [Tester], see [New].
+func LoadReader(t tester.T, r io.Reader, opts ...func(*Tester)) *Tester {
+	t.Helper()
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		t.Error(err)
+		return nil
+	}
+	return New(t, append([]func(*Tester){WithBytes(buf)}, opts...)...)
+}
+
+// LoadFS loads the existing log from the path in the filesystem. It allows
+// loading logs from embedded filesystems. The options are applied to the
+// created [Tester], see [New].
+func LoadFS(
+	t tester.T,
+	fsys fs.FS,
+	pth string,
+	opts ...func(*Tester),
+) *Tester {
+
+	t.Helper()
+	buf, err := fs.ReadFile(fsys, pth)
+	if err != nil {
+		t.Error(err)
+		return nil
+	}
+	return New(t, append([]func(*Tester){WithBytes(buf)}, opts...)...)
+}
+
+// LoadOptions represents options for [LoadGlob].
+type LoadOptions struct {
+	byTime bool            // Sort merged entries by time.
+	opts   []func(*Tester) // Options for the created [Tester].
+}
+
+// WithLoadByTime is an option for [LoadGlob] which sorts the merged log
+// entries by the [Config.TimeField] field. Entries with the same time keep
+// their order.
+func WithLoadByTime() func(*LoadOptions) {
+	return func(ops *LoadOptions) { ops.byTime = true }
+}
+
+// WithLoadTester is an option for [LoadGlob] setting options for the created
+// [Tester].
+func WithLoadTester(opts ...func(*Tester)) func(*LoadOptions) {
+	return func(ops *LoadOptions) { ops.opts = append(ops.opts, opts...) }
+}
+
+// LoadGlob loads the existing logs from all files matching the pattern and
+// merges their entries. By default, the entries are merged in the lexical
+// order of the file names, use [WithLoadByTime] to sort them by time. The
+// pattern syntax is the same as in [filepath.Match].
+func LoadGlob(t tester.T, pattern string, opts ...func(*LoadOptions)) *Tester {
+	t.Helper()
+	ops := &LoadOptions{}
+	for _, opt := range opts {
+		opt(ops)
+	}
+
+	pths, err := filepath.Glob(pattern)
+	if err != nil {
+		t.Error(err)
+		return nil
+	}
+	if len(pths) == 0 {
+		t.Error(fmt.Errorf("no files match %q: %w", pattern, fs.ErrNotExist))
+		return nil
+	}
+
+	tst := New(t, ops.opts...)
+	var ets []Entry
+	var lns []string // Log lines the entries were decoded from.
+	for _, pth := range pths {
+		buf, err := os.ReadFile(pth)
+		if err != nil {
+			t.Error(err)
+			return nil
+		}
+		if tst.cfg.LineDecoder != nil {
+			des, dls, err := decodeLines(tst.cfg, buf)
+			if err != nil {
+				t.Error(err)
+				return nil
+			}
+			ets, lns = append(ets, des...), append(lns, dls...)
+			continue
+		}
+		sub := New(t, WithBytes(buf), WithConfig(tst.cfg))
+		for _, ent := range sub.Entries().Get() {
+			ets, lns = append(ets, ent), append(lns, ent.raw)
+		}
+	}
+
+	ord := make([]int, len(ets)) // Order of the entries to write.
+	for i := range ord {
+		ord[i] = i
+	}
+	if ops.byTime {
+		tms := make([]time.Time, len(ets))
+		for i := range ets {
+			ets[i].t = t
+			tm, err := ets[i].Time(tst.cfg.TimeField)
+			if err != nil {
+				t.Error(err)
+				return nil
+			}
+			tms[i] = tm
+		}
+		slices.SortStableFunc(ord, func(a, b int) int {
+			return tms[a].Compare(tms[b])
+		})
+	}
+
+	for _, i := range ord {
+		tst.write([]byte(lns[i] + "\n"))
+	}
+	return tst
+}
+
+// DumpOnFailure registers a cleanup function which logs the summary of all
+// log entries written to the [Tester], but only when the test has failed.
+//
+// Example usage:
+//
+//	tst := logkit.New(t)
+//	logkit.DumpOnFailure(t, tst)
+func DumpOnFailure(t tester.T, tst *Tester) {
+	t.Helper()
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Log(tst.Entries().Summary())
+		}
+	})
 }
 
 // Write implements [io.Writer] interface. It expects p to be a single log
 // entry which is appended to the buffer. Every time it's called the count, the
 // cnt counter is increased.
 //
-// If there are any matchers, it checks if the message matches the first one.
-// If a match is found, it sets the matchIdx index to the value of cnt and
-// removes the matcher from the "matchers" slice. This logic allows matching
-// log lines in a specific order.
+// Discarded matchers are removed, see [Matcher.Discard]. Every matcher left
+// is checked against the message. Since matchers are registered after
+// checking the already logged entries, each of them only sees the log lines
+// written after its own starting point. When a matcher matches, it sets the
+// matchIdx index to the value of cnt and removes the matcher from the
+// "matchers" slice. This logic allows matching log lines in a specific order
+// while concurrent waits do not starve each other.
 //
-// It returns the number of bytes written and a nil error.
+// It returns the number of bytes written and a nil error. When configured with
+// [WithWriteError], it returns zero and the error without writing p. When
+// configured with [WithWriteDelay], it blocks for the delay before writing.
 func (tst *Tester) Write(p []byte) (n int, err error) {
+	if err = tst.ingest(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ReadFrom implements [io.ReaderFrom] interface. It reads newline-delimited
+// JSON log entries from r until EOF and ingests them the same way as
+// [Tester.Write] does for every non-empty line. It returns the number of bytes
+// read and any error encountered, except [io.EOF]. When configured with
+// [WithWriteError], it stops at the line the error is returned for.
+func (tst *Tester) ReadFrom(r io.Reader) (n int64, err error) {
+	var line []byte
+	rdr := bufio.NewReader(r)
+	for {
+		// The lock is not held while reading, so the reader blocking until
+		// the next line is available doesn't block other Tester methods.
+		line, err = rdr.ReadBytes('\n')
+		n += int64(len(line))
+		if len(bytes.TrimSpace(line)) > 0 {
+			if line[len(line)-1] != '\n' {
+				line = append(line, '\n')
+			}
+			if wErr := tst.ingest(line); wErr != nil {
+				return n, wErr
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return n, nil
+			}
+			return n, err
+		}
+	}
+}
+
+// ingest writes the log line p to the [Tester] applying the [WithWriteDelay],
+// [WithWriteError] and [WithInterleaveCheck] options. It's the ingest path
+// shared by [Tester.Write] and [Tester.ReadFrom].
+func (tst *Tester) ingest(p []byte) error {
+	if tst.delay > 0 {
+		time.Sleep(tst.delay)
+	}
+	tst.mx.Lock()
+	defer tst.mx.Unlock()
+	if tst.errWrite != nil && tst.writes >= tst.errAfter {
+		return tst.errWrite
+	}
+	tst.writes++
+	if tst.detect {
+		tst.interleaved(p)
+	}
+	tst.strict(tst.cnt, p)
+	tst.write(p)
+	return nil
+}
+
+// strict marks the test as failed for every log line in p with duplicate keys
+// when [Config.StrictKeys] is set. The idx is the index of the first log line
+// in p. Lines which cannot be decoded are skipped, they are reported when the
+// log entries are decoded.
+func (tst *Tester) strict(idx int, p []byte) {
+	tst.t.Helper()
+	if !tst.cfg.StrictKeys {
+		return
+	}
+	for _, line := range bytes.Split(p, []byte{'\n'}) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		ent := Entry{raw: string(bytes.TrimSpace(line))}
+		if tst.cfg.LineDecoder != nil {
+			var err error
+			if ent, err = decodeEntry(tst.cfg, line); err != nil {
+				idx++
+				continue
+			}
+		}
+		if err := CheckNoDuplicateKeys()(ent); err != nil {
+			tst.t.Error(notice.From(err).Prepend("index", "%d", idx))
+		}
+		idx++
+	}
+}
+
+// interleaved marks the test as failed when p is not valid JSON, but it is
+// when concatenated with the previous not valid payload. It must be called
+// with the lock held.
+func (tst *Tester) interleaved(p []byte) {
+	tst.t.Helper()
+	prev, cur := tst.frag, bytes.TrimSpace(p)
+	tst.frag = nil
+	if json.Valid(cur) {
+		return
+	}
+	if prev != nil && json.Valid(append(slices.Clone(prev), cur...)) {
+		mHeader := "[log entry] expected log line to be written at once"
+		msg := notice.New(mHeader).
+			Append("previous", "%s", prev).
+			Append("current", "%s", cur)
+		tst.t.Error(msg)
+		return
+	}
+	tst.frag = slices.Clone(cur)
+}
+
+// Sync implements the Sync method of the zap WriteSyncer interface. It only
+// records the call, see [Tester.AssertSynced].
+func (tst *Tester) Sync() error {
 	tst.mx.Lock()
 	defer tst.mx.Unlock()
+	tst.synced = true
+	return nil
+}
+
+// Close implements [io.Closer] interface. It only records the call, see
+// [Tester.AssertClosed]. The [Tester] still accepts writes after it's closed.
+func (tst *Tester) Close() error {
+	tst.mx.Lock()
+	defer tst.mx.Unlock()
+	tst.closed = true
+	return nil
+}
+
+// AssertSynced asserts that [Tester.Sync] was called. Returns true if it was.
+// If not, it marks the test as failed, logs an error message, and returns
+// false.
+func (tst *Tester) AssertSynced() bool {
+	tst.mx.RLock()
+	defer tst.mx.RUnlock()
+	tst.t.Helper()
+	if tst.synced {
+		return true
+	}
+	tst.t.Error(notice.New("[log entry] expected log writer to be synced"))
+	return false
+}
+
+// AssertClosed asserts that [Tester.Close] was called. Returns true if it
+// was. If not, it marks the test as failed, logs an error message, and
+// returns false.
+func (tst *Tester) AssertClosed() bool {
+	tst.mx.RLock()
+	defer tst.mx.RUnlock()
+	tst.t.Helper()
+	if tst.closed {
+		return true
+	}
+	tst.t.Error(notice.New("[log entry] expected log writer to be closed"))
+	return false
+}
 
+// write appends p to the buffer, increases the cnt counter, writes p to the
+// sub-testers, notifies the length waiters, removes discarded matchers and
+// runs all the others. It must be called with the lock held.
+func (tst *Tester) write(p []byte) {
 	tst.cnt++
 	tst.buf = append(tst.buf, p...)
+	if tst.limited() {
+		tst.sizes = append(tst.sizes, len(p))
+		tst.trim()
+	}
 
-	if len(tst.matchers) == 0 {
-		return len(p), nil
+	for _, sub := range tst.subs {
+		sub.mx.Lock()
+		sub.write(slices.Clone(p))
+		sub.mx.Unlock()
 	}
 
-	cpy := slices.Clone(p)
-	m := tst.matchers[0]
-	if ent := m.MatchLine(tst.cnt-1, cpy); !ent.IsZero() {
-		tst.matchIdx = tst.cnt - 1
-		tst.matchers = tst.matchers[1:]
+	tst.waiters = slices.DeleteFunc(tst.waiters, func(w lenWaiter) bool {
+		if tst.cnt < w.n {
+			return false
+		}
+		close(w.done)
+		return true
+	})
+
+	tst.forbid = slices.DeleteFunc(tst.forbid, (*Matcher).Discarded)
+	for _, mcr := range tst.forbid {
+		if ent := mcr.MatchLine(tst.cnt-1, slices.Clone(p)); !ent.IsZero() {
+			msg := notice.New("[log entry] forbidden log entry written").
+				Append("index", "%d", ent.idx).
+				Append("entry", "%s", ent.redacted())
+			tst.t.Error(msg)
+		}
 	}
 
-	return len(p), nil
+	tst.watch = slices.DeleteFunc(tst.watch, (*Matcher).Discarded)
+	for _, mcr := range tst.watch {
+		mcr.MatchLine(tst.cnt-1, slices.Clone(p))
+	}
+
+	tst.seqs = slices.DeleteFunc(tst.seqs, func(seq *SequenceMatcher) bool {
+		return seq.Done() || seq.Discarded()
+	})
+	for _, seq := range tst.seqs {
+		seq.MatchLine(tst.cnt-1, slices.Clone(p))
+	}
+
+	tst.matchers = slices.DeleteFunc(tst.matchers, func(mcr *Matcher) bool {
+		if mcr.Discarded() {
+			return true
+		}
+		if ent := mcr.MatchLine(tst.cnt-1, slices.Clone(p)); !ent.IsZero() {
+			tst.matchIdx = tst.cnt - 1
+			return true
+		}
+		return false
+	})
+}
+
+// limited returns true if the [Tester] keeps a limited number of log messages.
+func (tst *Tester) limited() bool { return tst.maxEnts > 0 || tst.maxBytes > 0 }
+
+// trim drops the oldest log messages from the buffer until the limits are
+// met. It must be called with the lock held.
+func (tst *Tester) trim() {
+	var n, off int
+	for n < len(tst.sizes) {
+		ents := len(tst.sizes) - n
+		size := len(tst.buf) - off
+		if (tst.maxEnts <= 0 || ents <= tst.maxEnts) &&
+			(tst.maxBytes <= 0 || size <= tst.maxBytes) {
+			break
+		}
+		off += tst.sizes[n]
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	tst.buf = tst.buf[off:]
+	tst.sizes = tst.sizes[n:]
+	tst.dropped += n
+}
+
+// Dropped returns the number of the oldest log messages dropped because of
+// the limits set with [WithMaxEntries] or [WithMaxBytes].
+func (tst *Tester) Dropped() int {
+	tst.mx.RLock()
+	defer tst.mx.RUnlock()
+	return tst.dropped
+}
+
+// Sub returns a new [Tester] bound to the given test, which sees only log
+// entries written to tst after the call. The returned tester stops receiving
+// log entries when the test ends. It is useful for subtests sharing a logger,
+// so each of them can assert on its own slice of the logger output. Entry
+// indexes in the returned tester start from zero.
+//
+// Example usage:
+//
+//	t.Run("name", func(t *testing.T) {
+//	    sub := tst.Sub(t)
+//	    // Run the code under test.
+//	    sub.Entries().AssertLen(2)
+//	})
+func (tst *Tester) Sub(t tester.T) *Tester {
+	t.Helper()
+	sub := New(t, WithConfig(tst.cfg))
+
+	tst.mx.Lock()
+	tst.subs = append(tst.subs, sub)
+	tst.mx.Unlock()
+
+	t.Cleanup(func() {
+		tst.mx.Lock()
+		defer tst.mx.Unlock()
+		tst.subs = slices.DeleteFunc(tst.subs, func(s *Tester) bool {
+			return s == sub
+		})
+	})
+	return sub
 }
 
 // Len returns a number of log messages written to the [Tester].
@@ -158,11 +636,22 @@ func (tst *Tester) Entries() Entries {
 func (tst *Tester) entries() Entries {
 	tst.t.Helper()
 
-	ets := make([]Entry, 0, tst.cnt)
+	ets := make([]Entry, 0, tst.cnt-tst.dropped)
+	if tst.cfg.LineDecoder != nil {
+		ets, _, err := decodeLines(tst.cfg, tst.buf)
+		if err != nil {
+			tst.t.Error(err)
+			return Entries{cfg: tst.cfg, t: tst.t}
+		}
+		for i := range ets {
+			ets[i].idx, ets[i].t = tst.dropped+i, tst.t
+		}
+		return Entries{cfg: tst.cfg, ets: ets, t: tst.t}
+	}
 
 	var off int64
 	dec := json.NewDecoder(bytes.NewReader(tst.buf))
-	idx := 0
+	idx := tst.dropped
 	for dec.More() {
 		m := make(map[string]any)
 		if err := dec.Decode(&m); err != nil {
@@ -173,17 +662,54 @@ func (tst *Tester) entries() Entries {
 		tmp := tst.buf[off:dec.InputOffset()]
 		off = dec.InputOffset()
 		ets = append(ets, Entry{
-			cfg: tst.cfg,
-			raw: string(bytes.TrimSpace(tmp)),
-			m:   m,
-			idx: idx,
-			t:   tst.t,
+			cfg:   tst.cfg,
+			raw:   string(bytes.TrimSpace(tmp)),
+			m:     m,
+			idx:   idx,
+			t:     tst.t,
+			exact: &exactFields{},
 		})
 		idx++
 	}
 	return Entries{cfg: tst.cfg, ets: ets, t: tst.t}
 }
 
+// decodeLines decodes the log lines in buf using the [Config.LineDecoder].
+// The partial CRI lines are joined with the following lines of the same
+// stream up to the full line. Returns the log entries and the lines each of
+// them was decoded from.
+func decodeLines(cfg *Config, buf []byte) ([]Entry, []string, error) {
+	var ets []Entry
+	var lns []string
+	parts := make(map[string][]byte)   // Partial CRI lines by stream.
+	partLns := make(map[string]string) // Lines of the partial CRI lines.
+	for _, line := range bytes.Split(buf, []byte{'\n'}) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		raw, meta, err := cfg.LineDecoder(bytes.TrimSpace(line))
+		if err != nil {
+			return nil, nil, err
+		}
+		stream := meta[MetaStream]
+		if meta[MetaTag] == "P" {
+			parts[stream] = append(parts[stream], raw...)
+			partLns[stream] += string(line) + "\n"
+			continue
+		}
+		lin := string(line)
+		if part, ok := parts[stream]; ok {
+			raw = append(part, raw...)
+			lin = partLns[stream] + lin
+			delete(parts, stream)
+			delete(partLns, stream)
+		}
+		ets = append(ets, lineEntry(cfg, raw, meta))
+		lns = append(lns, lin)
+	}
+	return ets, lns, nil
+}
+
 // Filter returns entries matching the provided [Matcher].
 func (tst *Tester) Filter(checks ...Checker) Entries {
 	tst.mx.RLock()
@@ -230,6 +756,68 @@ func (tst *Tester) LastEntry() Entry {
 	return ets[len(ets)-1]
 }
 
+// Forbid registers a matcher for the given checks which marks the test as
+// failed, logging the offending entry, the moment a matching log line is
+// written to the [Tester]. Log lines written before the call are not checked.
+// The returned matcher may be used to check how many forbidden lines were
+// written or discarded to lift the restriction.
+//
+// Example usage:
+//
+//	tst.Forbid(logkit.CheckError()) // No errors allowed from now on.
+func (tst *Tester) Forbid(checks ...Checker) *Matcher {
+	tst.mx.Lock()
+	defer tst.mx.Unlock()
+	tst.t.Helper()
+
+	mcr := NewMatcher(tst.t, tst.cfg, checks...)
+	tst.forbid = append(tst.forbid, mcr)
+	return mcr
+}
+
+// Watch registers the matcher to run on all the log entries already written
+// to the [Tester] and on every log line written later. Unlike the matchers
+// used by [Tester.WaitFor], it doesn't change the last matched log entry
+// index. Use [Matcher.Discard] to stop watching. Returns the matcher.
+//
+// Example usage:
+//
+//	mcr := logkit.NewMatcher(t, nil, logkit.CheckError()).ExpectMatches(1)
+//	tst.Watch(mcr)
+func (tst *Tester) Watch(mcr *Matcher) *Matcher {
+	tst.mx.Lock()
+	defer tst.mx.Unlock()
+	tst.t.Helper()
+
+	for _, ent := range tst.entries().Get() {
+		mcr.MatchEntry(ent)
+	}
+	tst.watch = append(tst.watch, mcr)
+	return mcr
+}
+
+// Sequence registers a [SequenceMatcher] for the given matchers which must be
+// satisfied, in order, by the log lines written to the [Tester] after the
+// call. Use [SequenceMatcher.AssertDone] to check the sequence completed.
+//
+// Example usage:
+//
+//	seq := tst.Sequence(
+//	    logkit.NewMatcher(t, nil, logkit.CheckMsg("started")),
+//	    logkit.NewMatcher(t, nil, logkit.CheckMsg("stopped")),
+//	)
+//	// Run the code under test.
+//	seq.AssertDone()
+func (tst *Tester) Sequence(steps ...*Matcher) *SequenceMatcher {
+	tst.mx.Lock()
+	defer tst.mx.Unlock()
+	tst.t.Helper()
+
+	seq := NewSequenceMatcher(tst.t, steps...)
+	tst.seqs = append(tst.seqs, seq)
+	return seq
+}
+
 // ResetLastMatch resets the value of the matchIdx field to -1. This field is
 // used to keep track of the last successfully matched log line. By resetting
 // it to -1, the matching process starts from the beginning of the log lines.
@@ -255,12 +843,12 @@ func (tst *Tester) WaitFor(timeout string, checks ...Checker) Entry {
 	mcr := NewMatcher(tst.t, tst.cfg, checks...)
 
 	// Check if we already have the entry.
-	for i, ent := range tst.entries().Get() {
-		if i <= tst.matchIdx {
+	for _, ent := range tst.entries().Get() {
+		if ent.idx <= tst.matchIdx {
 			continue
 		}
 		if mcr.MatchEntry(ent) {
-			tst.matchIdx = i
+			tst.matchIdx = ent.idx
 			tst.mx.Unlock()
 			return ent
 		}
@@ -281,7 +869,7 @@ func (tst *Tester) WaitFor(timeout string, checks ...Checker) Entry {
 		}
 
 	case <-timer.C:
-		mcr.NotifyStop()
+		mcr.Discard()
 	}
 
 	if !ent.IsZero() {
@@ -294,6 +882,253 @@ func (tst *Tester) WaitFor(timeout string, checks ...Checker) Entry {
 	return ZeroEntry(tst.t, tst.cfg)
 }
 
+// WaitForN waits for n log entries that satisfy the specified conditions
+// within the given timeout duration. Like [Tester.WaitFor], it only considers
+// log entries written after the last matched one. If n entries are not
+// logged within the given timeout, it will mark the test as failed and return
+// the entries matched so far. When n is negative, it marks the test as failed
+// and returns no entries.
+func (tst *Tester) WaitForN(timeout string, n int, checks ...Checker) Entries {
+	tst.t.Helper()
+	if n < 0 {
+		msg := notice.New("[log entry] expected non-negative number of entries").
+			Append("n", "%d", n)
+		tst.t.Error(msg)
+		return Entries{cfg: tst.cfg, t: tst.t}
+	}
+	steps := make([][]Checker, n)
+	for i := range steps {
+		steps[i] = checks
+	}
+	ets, ok := tst.waitSteps(timeout, steps)
+	if ok {
+		return ets
+	}
+	mHeader := "timeout waiting for log entries reached"
+	msg := notice.New(mHeader).
+		Append("timeout", "%s", timeout).
+		Want("%d", n).
+		Have("%d", len(ets.ets))
+	tst.t.Error(msg)
+	tst.t.Error(tst.Entries().summary(1))
+	return ets
+}
+
+// WaitForSequence waits for log entries that satisfy the specified steps, in
+// order, within the given timeout duration. Each step is a set of conditions
+// a single log entry must satisfy. Like [Tester.WaitFor], it only considers
+// log entries written after the last matched one. If the sequence is not
+// complete within the given timeout, it will mark the test as failed, log the
+// entries matched so far and the step it timed out on, and return the entries
+// matched so far.
+//
+// Example usage:
+//
+//	ets := tst.WaitForSequence("1s", [][]logkit.Checker{
+//	    {logkit.CheckMsg("started")},
+//	    {logkit.CheckMsg("stopped")},
+//	})
+func (tst *Tester) WaitForSequence(timeout string, steps [][]Checker) Entries {
+	tst.t.Helper()
+	ets, ok := tst.waitSteps(timeout, steps)
+	if ok {
+		return ets
+	}
+	mHeader := "timeout waiting for log entries sequence reached"
+	msg := notice.New(mHeader).
+		Append("timeout", "%s", timeout).
+		Append("steps", "%d", len(steps)).
+		Append("matched", "%d", len(ets.ets))
+	for i, ent := range ets.ets {
+		name := "step " + strconv.Itoa(i)
+		msg = msg.Append(name, "%d: %s", ent.idx, ent.redacted())
+	}
+	msg = msg.Append("waiting", "step %d", len(ets.ets))
+	tst.t.Error(msg)
+	tst.t.Error(tst.Entries().summary(1))
+	return ets
+}
+
+// waitSteps waits for log entries that satisfy the specified steps, in order,
+// within the given timeout duration. It returns the matched entries and true
+// when all steps matched. On timeout, it returns the entries matched so far
+// and false. On invalid timeout, it marks the test as failed and returns no
+// entries and true.
+func (tst *Tester) waitSteps(
+	timeout string,
+	steps [][]Checker,
+) (Entries, bool) {
+
+	tst.mx.Lock()
+	tst.t.Helper()
+
+	ets := Entries{cfg: tst.cfg, t: tst.t}
+	to, err := time.ParseDuration(timeout)
+	if err != nil {
+		tst.mx.Unlock()
+		tst.t.Error(err)
+		return ets, true
+	}
+
+	// Check if we already have the entries.
+	for _, ent := range tst.entries().Get() {
+		if len(ets.ets) == len(steps) {
+			break
+		}
+		if ent.idx <= tst.matchIdx {
+			continue
+		}
+		if runChecks(ent, steps[len(ets.ets)]...) {
+			tst.matchIdx = ent.idx
+			ets.ets = append(ets.ets, ent)
+		}
+	}
+	if len(ets.ets) == len(steps) {
+		tst.mx.Unlock()
+		return ets, true
+	}
+
+	// Every matcher matches exactly one entry, so the notification channel
+	// with a buffer of one never drops.
+	rem := steps[len(ets.ets):]
+	mcrs := make([]*Matcher, 0, len(rem))
+	found := make([]<-chan Entry, 0, len(rem))
+	for _, checks := range rem {
+		mcr := NewMatcher(tst.t, tst.cfg, checks...)
+		found = append(found, mcr.NotifyBuffered(1))
+		mcrs = append(mcrs, mcr)
+	}
+	seq := NewSequenceMatcher(tst.t, mcrs...)
+	tst.seqs = append(tst.seqs, seq)
+	timer := time.NewTimer(to)
+	defer timer.Stop()
+	tst.mx.Unlock()
+
+	ok := true
+	for i, mcr := range mcrs {
+		select {
+		case ent := <-found[i]:
+			mcr.NotifyStop()
+			ets.ets = append(ets.ets, ent)
+			continue
+
+		case <-timer.C:
+			seq.Discard()
+			ok = false
+		}
+		break
+	}
+
+	if len(ets.ets) > 0 {
+		tst.mx.Lock()
+		tst.matchIdx = max(tst.matchIdx, ets.ets[len(ets.ets)-1].idx)
+		tst.mx.Unlock()
+	}
+	return ets, ok
+}
+
+// WaitForLen waits until at least n log entries are written to the [Tester]
+// within the given timeout duration. Returns true if the entries were written.
+// If not, it marks the test as failed, logs an error message, and returns
+// false.
+func (tst *Tester) WaitForLen(timeout string, n int) bool {
+	tst.mx.Lock()
+	tst.t.Helper()
+
+	to, err := time.ParseDuration(timeout)
+	if err != nil {
+		tst.mx.Unlock()
+		tst.t.Error(err)
+		return false
+	}
+	if tst.cnt >= n {
+		tst.mx.Unlock()
+		return true
+	}
+
+	w := lenWaiter{n: n, done: make(chan struct{})}
+	tst.waiters = append(tst.waiters, w)
+	timer := time.NewTimer(to)
+	defer timer.Stop()
+	tst.mx.Unlock()
+
+	select {
+	case <-w.done:
+		return true
+
+	case <-timer.C:
+		tst.mx.Lock()
+		tst.waiters = slices.DeleteFunc(tst.waiters, func(lw lenWaiter) bool {
+			return lw.done == w.done
+		})
+		have := tst.cnt
+		tst.mx.Unlock()
+		if have >= n {
+			return true // Written right at the timeout.
+		}
+		mHeader := "timeout waiting for N log entries reached"
+		msg := notice.New(mHeader).
+			Append("timeout", "%s", timeout).
+			Want("%d", n).
+			Have("%d", have)
+		tst.t.Error(msg)
+		return false
+	}
+}
+
+// AssertQuiet asserts no log entry satisfying the specified conditions is
+// written to the [Tester] within the given duration. Log lines written before
+// the call are not checked.
+//
+// Returns true if no matching entry was written. If one was, it marks the
+// test as failed as soon as it's written, logs an error message with the
+// entry, and returns false.
+//
+// Example usage:
+//
+//	tst.AssertQuiet("5s", logkit.CheckMsg("alert")) // No second alert.
+func (tst *Tester) AssertQuiet(duration string, checks ...Checker) bool {
+	tst.mx.Lock()
+	tst.t.Helper()
+
+	dur, err := time.ParseDuration(duration)
+	if err != nil {
+		tst.mx.Unlock()
+		tst.t.Error(err)
+		return false
+	}
+
+	mcr := NewMatcher(tst.t, tst.cfg, checks...)
+	found := mcr.NotifyBuffered(1)
+	tst.watch = append(tst.watch, mcr)
+	timer := time.NewTimer(dur)
+	defer timer.Stop()
+	tst.mx.Unlock()
+
+	select {
+	case ent := <-found:
+		mcr.Discard()
+		mHeader := "[log entry] expected no matching log entry to be written"
+		msg := notice.New(mHeader).
+			Append("duration", "%s", duration).
+			Append("index", "%d", ent.idx).
+			Append("entry", "%s", ent.redacted())
+		tst.t.Error(msg)
+		return false
+
+	case <-timer.C:
+		mcr.Discard()
+		return true
+	}
+}
+
+// AssertSchemaCompliant asserts that every logged entry has all the
+// [Config.RequiredFields] fields, see [Entries.AssertSchemaCompliant].
+func (tst *Tester) AssertSchemaCompliant() bool {
+	tst.t.Helper()
+	return tst.Entries().AssertSchemaCompliant()
+}
+
 // WaitForAny works like [Tester.WaitFor] but resets the last match before it
 // returns. It can be used to match log entries in any order.
 func (tst *Tester) WaitForAny(timeout string, checks ...Checker) Entry {
@@ -320,12 +1155,20 @@ func (tst *Tester) Match(mch *Matcher) Entry {
 	return Entry{t: tst.t}
 }
 
-// Reset resets the Tester.
+// Reset resets the Tester. The matchers registered with [Tester.Forbid],
+// [Tester.Sequence], [Tester.Watch] and [Tester.AssertQuiet] survive the
+// reset and keep checking log entries written after it.
 func (tst *Tester) Reset() {
 	tst.mx.Lock()
 	defer tst.mx.Unlock()
 
 	tst.cnt = 0
+	tst.dropped = 0
+	tst.writes = 0
 	tst.buf = tst.buf[:0]
+	tst.sizes = tst.sizes[:0]
 	tst.matchers = tst.matchers[:0]
+	tst.frag = nil
+	tst.synced = false
+	tst.closed = false
 }
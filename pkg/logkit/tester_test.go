@@ -4,8 +4,15 @@
 package logkit
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
 
 	"github.com/ctx42/testing/pkg/assert"
 	"github.com/ctx42/testing/pkg/must"
@@ -35,6 +42,63 @@ func Test_WithString(t *testing.T) {
 	assert.Equal(t, "{}\n{}\n", tst.String())
 }
 
+func Test_WithMaxEntries(t *testing.T) {
+	// --- Given ---
+	tst := &Tester{}
+
+	// --- When ---
+	WithMaxEntries(2)(tst)
+
+	// --- Then ---
+	assert.Equal(t, 2, tst.maxEnts)
+}
+
+func Test_WithMaxBytes(t *testing.T) {
+	// --- Given ---
+	tst := &Tester{}
+
+	// --- When ---
+	WithMaxBytes(100)(tst)
+
+	// --- Then ---
+	assert.Equal(t, 100, tst.maxBytes)
+}
+
+func Test_WithWriteError(t *testing.T) {
+	// --- Given ---
+	e := errors.New("sink failed")
+	tst := &Tester{}
+
+	// --- When ---
+	WithWriteError(e, 2)(tst)
+
+	// --- Then ---
+	assert.Same(t, e, tst.errWrite)
+	assert.Equal(t, 2, tst.errAfter)
+}
+
+func Test_WithWriteDelay(t *testing.T) {
+	// --- Given ---
+	tst := &Tester{}
+
+	// --- When ---
+	WithWriteDelay(time.Second)(tst)
+
+	// --- Then ---
+	assert.Equal(t, time.Second, tst.delay)
+}
+
+func Test_WithInterleaveCheck(t *testing.T) {
+	// --- Given ---
+	tst := &Tester{}
+
+	// --- When ---
+	WithInterleaveCheck()(tst)
+
+	// --- Then ---
+	assert.True(t, tst.detect)
+}
+
 func Test_WithConfig(t *testing.T) {
 	// --- Given ---
 	cfg := DefaultConfig()
@@ -115,6 +179,19 @@ func Test_Load(t *testing.T) {
 		assert.Equal(t, string(want), tst.String())
 	})
 
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		// --- When ---
+		tst := Load(tspy, "testdata/log.log", WithMaxEntries(1))
+
+		// --- Then ---
+		assert.Len(t, 1, tst.Entries().Get())
+		assert.Equal(t, 1, tst.Dropped())
+	})
+
 	t.Run("error - file does not exist error", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
@@ -131,262 +208,1188 @@ func Test_Load(t *testing.T) {
 	})
 }
 
-func Test_Tester_Write(t *testing.T) {
-	t.Run("write line", func(t *testing.T) {
+func Test_LoadReader(t *testing.T) {
+	t.Run("load log", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-
 		tspy := tester.New(t)
 		tspy.Close()
 
-		tst := New(tspy)
+		want := must.Value(os.ReadFile("testdata/log.log"))
 
 		// --- When ---
-		have, err := tst.Write(lin0)
+		tst := LoadReader(tspy, bytes.NewReader(want))
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.Equal(t, 47, have)
-
-		assert.Equal(t, string(lin0), string(tst.buf))
-		assert.Equal(t, 1, tst.cnt)
-		assert.Equal(t, -1, tst.matchIdx)
+		assert.Equal(t, 2, tst.Len())
+		assert.Equal(t, string(want), tst.String())
 	})
 
-	t.Run("with matchers", func(t *testing.T) {
+	t.Run("error - read error", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
-
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("read error")
 		tspy.Close()
 
-		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
-		mcr2 := NewMatcher(tspy, nil, CheckMsg("msg2"))
-
-		tst := New(tspy)
-		tst.matchers = append(tst.matchers, mcr0, mcr2)
+		rdr := iotest.ErrReader(errors.New("read error"))
 
-		// --- When --- add first line ---
-		have, err := tst.Write(lin0)
+		// --- When ---
+		tst := LoadReader(tspy, rdr)
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.Equal(t, 47, have)
+		assert.Nil(t, tst)
+	})
+}
 
-		assert.Equal(t, string(lin0), string(tst.buf))
-		assert.Equal(t, 1, tst.cnt)
-		assert.Equal(t, 0, tst.matchIdx)
-		assert.Len(t, 1, tst.matchers)
-		assert.Same(t, mcr2, tst.matchers[0])
+func Test_LoadFS(t *testing.T) {
+	t.Run("load log file", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
 
-		// --- When --- add second line ---
-		have, err = tst.Write(lin1)
+		// --- When ---
+		tst := LoadFS(tspy, os.DirFS("testdata"), "log.log")
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.Equal(t, 47, have)
+		assert.Equal(t, 2, tst.Len())
+		want := must.Value(os.ReadFile("testdata/log.log"))
+		assert.Equal(t, string(want), tst.String())
+	})
 
-		wantBuf := append(lin0, lin1...) // nolint: gocritic
-		assert.Equal(t, string(wantBuf), string(tst.buf))
-		assert.Equal(t, 2, tst.cnt)
-		assert.Equal(t, 0, tst.matchIdx)
-		assert.Len(t, 1, tst.matchers)
-		assert.Same(t, mcr2, tst.matchers[0])
+	t.Run("in memory filesystem", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
 
-		// --- When --- add third line ---
-		have, err = tst.Write(lin2)
+		fsys := fstest.MapFS{
+			"app.log": {Data: []byte(`{"message":"msg0"}` + "\n")},
+		}
 
-		// --- Then ---
-		assert.NoError(t, err)
-		assert.Equal(t, 47, have)
+		// --- When ---
+		tst := LoadFS(tspy, fsys, "app.log")
 
-		wantBuf = append(lin0, lin1...) // nolint: gocritic
-		wantBuf = append(wantBuf, lin2...)
-		assert.Equal(t, string(wantBuf), string(tst.buf))
-		assert.Equal(t, 3, tst.cnt)
-		assert.Equal(t, 2, tst.matchIdx)
-		assert.Len(t, 0, tst.matchers)
+		// --- Then ---
+		assert.Equal(t, 1, tst.Len())
 	})
 
-	t.Run("done matcher is not being run", func(t *testing.T) {
+	t.Run("error - file does not exist error", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
-
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "open not_existing.log: file does not exist"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		mcr := NewMatcher(tspy, nil, CheckMsg("msg1"))
-
-		tst := New(tspy)
-		tst.matchers = append(tst.matchers, mcr)
-
 		// --- When ---
-		must.Value(tst.Write(lin0))
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
+		tst := LoadFS(tspy, fstest.MapFS{}, "not_existing.log")
 
 		// --- Then ---
-		assert.Equal(t, string(lin0)+string(lin1)+string(lin2), string(tst.buf))
-		assert.Equal(t, 3, tst.cnt)
-		assert.Equal(t, 1, tst.matchIdx)
+		assert.Nil(t, tst)
 	})
 }
 
-func Test_Tester_Len(t *testing.T) {
-	t.Run("without writes", func(t *testing.T) {
+func Test_WithLoadByTime(t *testing.T) {
+	// --- Given ---
+	ops := &LoadOptions{}
+
+	// --- When ---
+	WithLoadByTime()(ops)
+
+	// --- Then ---
+	assert.True(t, ops.byTime)
+}
+
+func Test_WithLoadTester(t *testing.T) {
+	// --- Given ---
+	ops := &LoadOptions{}
+
+	// --- When ---
+	WithLoadTester(WithMaxEntries(1))(ops)
+
+	// --- Then ---
+	assert.Len(t, 1, ops.opts)
+}
+
+func Test_LoadGlob(t *testing.T) {
+	t.Run("merge in file name order", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		tst := New(tspy)
+		// --- When ---
+		tst := LoadGlob(tspy, "testdata/merge/*.log")
+
+		// --- Then ---
+		assert.Equal(t, 4, tst.Len())
+		ets := tst.Entries()
+		assert.Equal(t, []string{"a0", "a1", "b0", "b1"}, msgs(ets))
+		assert.Equal(t, 3, ets.Get()[3].Index())
+	})
+
+	t.Run("merge by time", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
 
 		// --- When ---
-		have := tst.Len()
+		tst := LoadGlob(tspy, "testdata/merge/*.log", WithLoadByTime())
 
 		// --- Then ---
-		assert.Equal(t, 0, have)
+		assert.Equal(t, 4, tst.Len())
+		ets := tst.Entries()
+		assert.Equal(t, []string{"a0", "b0", "a1", "b1"}, msgs(ets))
+		assert.Equal(t, 3, ets.Get()[3].Index())
 	})
 
-	t.Run("with one writes", func(t *testing.T) {
+	t.Run("merge by time with line decoder", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		tst := New(tspy)
-		must.Value(tst.Write([]byte("test_0")))
+		cfg := NewConfig(WithLineDecoder(CRILine))
+		opt := WithLoadTester(WithConfig(cfg))
 
 		// --- When ---
-		have := tst.Len()
+		tst := LoadGlob(tspy, "testdata/pods/*.log", opt, WithLoadByTime())
 
 		// --- Then ---
-		assert.Equal(t, 1, have)
+		ets := tst.Entries()
+		assert.Equal(t, []string{"a0", "b0", "a1"}, msgs(ets))
+		assert.Equal(t, "stdout", ets.Get()[0].Meta(MetaStream))
+		assert.Equal(t, "stderr", ets.Get()[1].Meta(MetaStream))
+		assert.Equal(t, "stdout", ets.Get()[2].Meta(MetaStream))
+		assert.Equal(t, "F", ets.Get()[2].Meta(MetaTag))
+		assert.Equal(t, 2, ets.Get()[2].Index())
 	})
 
-	t.Run("with multiple writes", func(t *testing.T) {
+	t.Run("with tester options", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
-		tst := New(tspy)
-		must.Value(tst.Write([]byte("test_0")))
-		must.Value(tst.Write([]byte("test_1")))
-		must.Value(tst.Write([]byte("test_2")))
+		opt := WithLoadTester(WithMaxEntries(1))
 
 		// --- When ---
-		have := tst.Len()
+		tst := LoadGlob(tspy, "testdata/merge/*.log", opt)
 
 		// --- Then ---
-		assert.Equal(t, 3, have)
+		assert.Equal(t, 4, tst.Len())
+		assert.Equal(t, 3, tst.Dropped())
 	})
-}
 
-func Test_Tester_String(t *testing.T) {
-	t.Run("without writes", func(t *testing.T) {
+	t.Run("error - entry without time", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("[log entry] expected map to have a key")
 		tspy.Close()
 
-		tst := New(tspy)
-
 		// --- When ---
-		have := tst.String()
+		tst := LoadGlob(tspy, "testdata/merge/*", WithLoadByTime())
 
 		// --- Then ---
-		assert.Equal(t, "", have)
+		assert.Nil(t, tst)
 	})
 
-	t.Run("with writes", func(t *testing.T) {
+	t.Run("error - no files match", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "no files match \"testdata/*.none\": file does not exist"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		tst := New(tspy)
-		must.Value(tst.Write([]byte("test_0")))
-		must.Value(tst.Write([]byte(" test_1")))
+		// --- When ---
+		tst := LoadGlob(tspy, "testdata/*.none")
+
+		// --- Then ---
+		assert.Nil(t, tst)
+	})
+
+	t.Run("error - invalid pattern", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("syntax error in pattern")
+		tspy.Close()
 
 		// --- When ---
-		have := tst.String()
+		tst := LoadGlob(tspy, "testdata/[")
 
 		// --- Then ---
-		assert.Equal(t, "test_0 test_1", have)
+		assert.Nil(t, tst)
 	})
 }
 
-func Test_Tester_Bytes(t *testing.T) {
-	t.Run("without writes", func(t *testing.T) {
+func Test_DumpOnFailure(t *testing.T) {
+	t.Run("test passed", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
 		tspy.Close()
 
 		tst := New(tspy)
+		MustWriteLine(tst, `{"level":"info","message":"msg0"}`)
 
 		// --- When ---
-		have := tst.Bytes()
+		DumpOnFailure(tspy, tst)
 
 		// --- Then ---
-		assert.Len(t, 0, have)
+		tspy.Finish()
 	})
 
-	t.Run("with writes", func(t *testing.T) {
+	t.Run("test failed", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"failure\n" +
+			"entries logged so far:\n" +
+			"  {\"level\":\"info\",\"message\":\"msg0\"}\n"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		tst := New(tspy)
-		must.Value(tst.Write([]byte("test_0")))
-		must.Value(tst.Write([]byte(" test_1")))
+		MustWriteLine(tst, `{"level":"info","message":"msg0"}`)
 
 		// --- When ---
-		have := tst.Bytes()
+		DumpOnFailure(tspy, tst)
 
 		// --- Then ---
-		assert.Equal(t, []byte("test_0 test_1"), have)
+		tspy.Error("failure")
+		tspy.Finish()
 	})
 }
 
-func Test_Tester_Entries(t *testing.T) {
-	t.Run("no entries", func(t *testing.T) {
+// msgs returns the messages of the log entries.
+func msgs(ets Entries) []string {
+	var have []string
+	for _, ent := range ets.Get() {
+		have = append(have, must.Value(ent.Str("message")))
+	}
+	return have
+}
+
+func Test_Tester_Write(t *testing.T) {
+	t.Run("write line", func(t *testing.T) {
 		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+
 		tspy := tester.New(t)
 		tspy.Close()
 
 		tst := New(tspy)
 
 		// --- When ---
-		have := tst.Entries()
+		have, err := tst.Write(lin0)
 
 		// --- Then ---
-		assert.NotNil(t, have)
-		assert.Len(t, 0, have.Get())
+		assert.NoError(t, err)
+		assert.Equal(t, 47, have)
+
+		assert.Equal(t, string(lin0), string(tst.buf))
+		assert.Equal(t, 1, tst.cnt)
+		assert.Equal(t, -1, tst.matchIdx)
 	})
 
-	t.Run("couple of entries", func(t *testing.T) {
+	t.Run("with write error", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+		lin0 := []byte(`{"level":"info", "message":"msg0"}` + "\n")
+		lin1 := []byte(`{"level":"info", "message":"msg1"}` + "\n")
+		e := errors.New("sink failed")
 
 		tspy := tester.New(t)
 		tspy.Close()
 
-		cfg := DefaultConfig()
-		tst := New(tspy, WithConfig(cfg))
+		tst := New(tspy, WithWriteError(e, 1))
 		must.Value(tst.Write(lin0))
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
 
 		// --- When ---
-		have := tst.Entries()
+		have, err := tst.Write(lin1)
 
 		// --- Then ---
-		ent := have.Entry(0)
-		assert.Same(t, cfg, ent.cfg)
-		assert.Equal(t, string(lin0), ent.String())
-		want := map[string]any{
+		assert.Same(t, e, err)
+		assert.Equal(t, 0, have)
+		assert.Equal(t, string(lin0), string(tst.buf))
+		assert.Equal(t, 1, tst.cnt)
+	})
+
+	t.Run("with write delay", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "message":"msg0"}` + "\n")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithWriteDelay(50*time.Millisecond))
+		start := time.Now()
+
+		// --- When ---
+		have, err := tst.Write(lin0)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, len(lin0), have)
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+		assert.Equal(t, string(lin0), string(tst.buf))
+	})
+
+	t.Run("with write error after zero writes", func(t *testing.T) {
+		// --- Given ---
+		e := errors.New("sink failed")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithWriteError(e, 0))
+
+		// --- When ---
+		have, err := tst.Write([]byte(`{"level":"info"}` + "\n"))
+
+		// --- Then ---
+		assert.Same(t, e, err)
+		assert.Equal(t, 0, have)
+		assert.Equal(t, 0, tst.Len())
+	})
+
+	t.Run("with matchers", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		mcr2 := NewMatcher(tspy, nil, CheckMsg("msg2"))
+
+		tst := New(tspy)
+		tst.matchers = append(tst.matchers, mcr0, mcr2)
+
+		// --- When --- add first line ---
+		have, err := tst.Write(lin0)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 47, have)
+
+		assert.Equal(t, string(lin0), string(tst.buf))
+		assert.Equal(t, 1, tst.cnt)
+		assert.Equal(t, 0, tst.matchIdx)
+		assert.Len(t, 1, tst.matchers)
+		assert.Same(t, mcr2, tst.matchers[0])
+
+		// --- When --- add second line ---
+		have, err = tst.Write(lin1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 47, have)
+
+		wantBuf := append(lin0, lin1...) // nolint: gocritic
+		assert.Equal(t, string(wantBuf), string(tst.buf))
+		assert.Equal(t, 2, tst.cnt)
+		assert.Equal(t, 0, tst.matchIdx)
+		assert.Len(t, 1, tst.matchers)
+		assert.Same(t, mcr2, tst.matchers[0])
+
+		// --- When --- add third line ---
+		have, err = tst.Write(lin2)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 47, have)
+
+		wantBuf = append(lin0, lin1...) // nolint: gocritic
+		wantBuf = append(wantBuf, lin2...)
+		assert.Equal(t, string(wantBuf), string(tst.buf))
+		assert.Equal(t, 3, tst.cnt)
+		assert.Equal(t, 2, tst.matchIdx)
+		assert.Len(t, 0, tst.matchers)
+	})
+
+	t.Run("discarded matchers are removed", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		mcr0.Discard()
+		mcr1 := NewMatcher(tspy, nil, CheckMsg("msg1"))
+		mcr2 := NewMatcher(tspy, nil, CheckMsg("msg2"))
+		mcr2.Discard()
+
+		tst := New(tspy)
+		tst.matchers = append(tst.matchers, mcr0, mcr1, mcr2)
+
+		// --- When ---
+		must.Value(tst.Write(lin0))
+
+		// --- Then ---
+		assert.Len(t, 1, tst.matchers)
+		assert.Same(t, mcr1, tst.matchers[0])
+		assert.Equal(t, -1, tst.matchIdx)
+		assert.Equal(t, 0, mcr0.Matched())
+	})
+
+	t.Run("all matchers are run", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr1 := NewMatcher(tspy, nil, CheckMsg("msg1"))
+		mcr0 := NewMatcher(tspy, nil, CheckMsg("msg0"))
+
+		tst := New(tspy)
+		tst.matchers = append(tst.matchers, mcr1, mcr0)
+
+		// --- When ---
+		must.Value(tst.Write(lin0))
+
+		// --- Then ---
+		assert.Len(t, 1, tst.matchers)
+		assert.Same(t, mcr1, tst.matchers[0])
+		assert.Equal(t, 0, tst.matchIdx)
+		assert.Equal(t, 1, mcr0.Matched())
+	})
+
+	t.Run("discarded sequences are removed", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil, CheckMsg("msg0"))
+		seq := NewSequenceMatcher(tspy, mcr)
+		seq.Discard()
+
+		tst := New(tspy)
+		tst.seqs = append(tst.seqs, seq)
+
+		// --- When ---
+		must.Value(tst.Write(lin0))
+
+		// --- Then ---
+		assert.Len(t, 0, tst.seqs)
+		assert.Equal(t, 0, mcr.Matched())
+	})
+
+	t.Run("done matcher is not being run", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil, CheckMsg("msg1"))
+
+		tst := New(tspy)
+		tst.matchers = append(tst.matchers, mcr)
+
+		// --- When ---
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- Then ---
+		assert.Equal(t, string(lin0)+string(lin1)+string(lin2), string(tst.buf))
+		assert.Equal(t, 3, tst.cnt)
+		assert.Equal(t, 1, tst.matchIdx)
+	})
+}
+
+func Test_Tester_ReadFrom(t *testing.T) {
+	t.Run("read lines", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"info", "str":"abc", "message":"msg0"}`
+		lin1 := `{"level":"info", "str":"def", "message":"msg1"}`
+		src := strings.NewReader(lin0 + "\n\n" + lin1)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have, err := tst.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(lin0)+len(lin1)+2), have)
+		assert.Equal(t, lin0+"\n"+lin1+"\n", tst.String())
+		assert.Equal(t, 2, tst.Len())
+		assert.Equal(t, lin1, tst.Entries().Entry(1).String())
+	})
+
+	t.Run("runs matchers", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"info", "str":"abc", "message":"msg0"}`
+		lin1 := `{"level":"info", "str":"def", "message":"msg1"}`
+		src := strings.NewReader(lin0 + "\n" + lin1 + "\n")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		mcr := NewMatcher(tspy, nil, CheckMsg("msg1"))
+
+		tst := New(tspy)
+		tst.matchers = append(tst.matchers, mcr)
+
+		// --- When ---
+		_, err := tst.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 1, tst.matchIdx)
+		assert.Len(t, 0, tst.matchers)
+		assert.Equal(t, 1, mcr.Matched())
+	})
+
+	t.Run("with write error", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"info", "str":"abc", "message":"msg0"}` + "\n"
+		lin1 := `{"level":"info", "str":"def", "message":"msg1"}` + "\n"
+		e := errors.New("sink failed")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithWriteError(e, 1))
+
+		// --- When ---
+		have, err := tst.ReadFrom(strings.NewReader(lin0 + lin1 + lin0))
+
+		// --- Then ---
+		assert.Same(t, e, err)
+		assert.Equal(t, int64(len(lin0)+len(lin1)), have)
+		assert.Equal(t, lin0, tst.String())
+	})
+
+	t.Run("with write delay", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"info", "str":"abc", "message":"msg0"}` + "\n"
+		lin1 := `{"level":"info", "str":"def", "message":"msg1"}` + "\n"
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithWriteDelay(25*time.Millisecond))
+		start := time.Now()
+
+		// --- When ---
+		_, err := tst.ReadFrom(strings.NewReader(lin0 + lin1))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+		assert.Equal(t, lin0+lin1, tst.String())
+	})
+
+	t.Run("does not block while reading", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"info", "str":"abc", "message":"msg0"}`
+		rdr, wrt := io.Pipe()
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = tst.ReadFrom(rdr)
+		}()
+
+		// --- When ---
+		must.Value(wrt.Write([]byte(lin0 + "\n")))
+
+		// --- Then ---
+		assert.True(t, tst.WaitForLen("1s", 1))
+		assert.Equal(t, lin0, tst.FirstEntry().String())
+		must.Nil(wrt.Close())
+		<-done
+	})
+
+	t.Run("error - reader error", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"info", "str":"abc", "message":"msg0"}` + "\n"
+		src := io.MultiReader(
+			strings.NewReader(lin0),
+			iotest.ErrReader(errors.New("test error")),
+		)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have, err := tst.ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "test error", err)
+		assert.Equal(t, int64(len(lin0)), have)
+		assert.Equal(t, 1, tst.Len())
+	})
+}
+
+func Test_Tester_interleaved(t *testing.T) {
+	t.Run("whole lines", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithInterleaveCheck())
+
+		// --- When ---
+		MustWriteLine(tst, `{"level":"info","message":"msg0"}`)
+		MustWriteLine(tst, `{"level":"info","message":"msg1"}`)
+
+		// --- Then ---
+		assert.Nil(t, tst.frag)
+		assert.Equal(t, 2, tst.Len())
+	})
+
+	t.Run("error - line split into writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected log line to be written at once:\n" +
+			"  previous: {\"level\":\"info\",\n" +
+			"   current: \"message\":\"msg0\"}"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy, WithInterleaveCheck())
+
+		// --- When ---
+		must.Value(tst.Write([]byte(`{"level":"info",`)))
+		must.Value(tst.Write([]byte(`"message":"msg0"}` + "\n")))
+
+		// --- Then ---
+		assert.Nil(t, tst.frag)
+	})
+
+	t.Run("error - line split into read lines", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("expected log line to be written at once")
+		tspy.Close()
+
+		tst := New(tspy, WithInterleaveCheck())
+		src := strings.NewReader(`{"level":"info",` + "\n" + `"A":1}` + "\n")
+
+		// --- When ---
+		_, err := tst.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, tst.frag)
+	})
+
+	t.Run("not matching fragments", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithInterleaveCheck())
+
+		// --- When ---
+		must.Value(tst.Write([]byte(`{"level":"info",`)))
+		must.Value(tst.Write([]byte(`{"message":`)))
+
+		// --- Then ---
+		assert.Equal(t, `{"message":`, string(tst.frag))
+	})
+
+	t.Run("not enabled", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		must.Value(tst.Write([]byte(`{"level":"info",`)))
+		must.Value(tst.Write([]byte(`"message":"msg0"}` + "\n")))
+
+		// --- Then ---
+		assert.Nil(t, tst.frag)
+	})
+}
+
+func Test_Tester_Sync(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	tst := New(tspy)
+
+	// --- When ---
+	err := tst.Sync()
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.True(t, tst.synced)
+}
+
+func Test_Tester_Close(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	tst := New(tspy)
+
+	// --- When ---
+	err := tst.Close()
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.True(t, tst.closed)
+	MustWriteLine(tst, `{"level":"info"}`)
+	assert.Equal(t, 1, tst.Len())
+}
+
+func Test_Tester_AssertSynced(t *testing.T) {
+	t.Run("synced", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Nil(tst.Sync())
+
+		// --- When ---
+		have := tst.AssertSynced()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not synced", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("[log entry] expected log writer to be synced")
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.AssertSynced()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Tester_AssertClosed(t *testing.T) {
+	t.Run("closed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Nil(tst.Close())
+
+		// --- When ---
+		have := tst.AssertClosed()
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - not closed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("[log entry] expected log writer to be closed")
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.AssertClosed()
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_Tester_trim(t *testing.T) {
+	t.Run("max entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithMaxEntries(2))
+
+		// --- When ---
+		MustWriteLine(tst, `{"message":"msg0"}`)
+		MustWriteLine(tst, `{"message":"msg1"}`)
+		MustWriteLine(tst, `{"message":"msg2"}`)
+
+		// --- Then ---
+		assert.Equal(t, 3, tst.Len())
+		assert.Equal(t, 1, tst.Dropped())
+		wBuf := `{"message":"msg1"}` + "\n" + `{"message":"msg2"}` + "\n"
+		assert.Equal(t, wBuf, tst.String())
+		ets := tst.Entries().Get()
+		assert.Len(t, 2, ets)
+		assert.Equal(t, 1, ets[0].Index())
+		assert.Equal(t, 2, ets[1].Index())
+	})
+
+	t.Run("max bytes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithMaxBytes(40))
+
+		// --- When ---
+		MustWriteLine(tst, `{"message":"msg0"}`) // 19 bytes.
+		MustWriteLine(tst, `{"message":"msg1"}`)
+		MustWriteLine(tst, `{"message":"msg2"}`)
+
+		// --- Then ---
+		assert.Equal(t, 1, tst.Dropped())
+		wBuf := `{"message":"msg1"}` + "\n" + `{"message":"msg2"}` + "\n"
+		assert.Equal(t, wBuf, tst.String())
+	})
+
+	t.Run("entry bigger than max bytes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithMaxBytes(10))
+
+		// --- When ---
+		MustWriteLine(tst, `{"message":"msg0"}`)
+
+		// --- Then ---
+		assert.Equal(t, 1, tst.Len())
+		assert.Equal(t, 1, tst.Dropped())
+		assert.Equal(t, "", tst.String())
+	})
+
+	t.Run("both limits", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithMaxEntries(1), WithMaxBytes(100))
+
+		// --- When ---
+		MustWriteLine(tst, `{"message":"msg0"}`)
+		MustWriteLine(tst, `{"message":"msg1"}`)
+
+		// --- Then ---
+		assert.Equal(t, 1, tst.Dropped())
+		assert.Equal(t, `{"message":"msg1"}`+"\n", tst.String())
+	})
+
+	t.Run("initial content is limited", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		buf := `{"message":"msg0"}` + "\n" + `{"message":"msg1"}` + "\n"
+
+		// --- When ---
+		tst := New(tspy, WithString(buf), WithMaxEntries(1))
+
+		// --- Then ---
+		assert.Equal(t, 2, tst.Len())
+		assert.Equal(t, 1, tst.Dropped())
+		assert.Equal(t, `{"message":"msg1"}`+"\n", tst.String())
+		assert.Equal(t, []int{19}, tst.sizes)
+	})
+
+	t.Run("wait for skips dropped entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithMaxEntries(2))
+		MustWriteLine(tst, `{"message":"msg"}`)
+		MustWriteLine(tst, `{"message":"msg"}`)
+		MustWriteLine(tst, `{"message":"msg"}`)
+		tst.matchIdx = 1
+
+		// --- When ---
+		have := tst.WaitFor("1s", CheckMsg("msg"))
+
+		// --- Then ---
+		assert.Equal(t, 2, have.Index())
+		assert.Equal(t, 2, tst.matchIdx)
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		MustWriteLine(tst, `{"message":"msg0"}`)
+
+		// --- Then ---
+		assert.Equal(t, 0, tst.Dropped())
+		assert.Nil(t, tst.sizes)
+	})
+}
+
+func Test_Tester_Dropped(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	tst := New(tspy)
+	tst.dropped = 2
+
+	// --- When ---
+	have := tst.Dropped()
+
+	// --- Then ---
+	assert.Equal(t, 2, have)
+}
+
+func Test_Tester_Sub(t *testing.T) {
+	t.Run("sees only entries written after the call", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		sspy := tester.New(t)
+		sspy.ExpectCleanups(1)
+		sspy.Close()
+
+		cfg := DefaultConfig()
+		tst := New(tspy, WithConfig(cfg))
+		MustWriteLine(tst, `{"message":"msg0"}`)
+
+		// --- When ---
+		sub := tst.Sub(sspy)
+
+		// --- Then ---
+		MustWriteLine(tst, `{"message":"msg1"}`)
+		assert.Same(t, cfg, sub.cfg)
+		assert.Same(t, sspy, sub.t)
+		assert.Equal(t, 2, tst.Len())
+		assert.Equal(t, 1, sub.Len())
+		assert.Equal(t, `{"message":"msg1"}`+"\n", sub.String())
+		assert.Equal(t, 0, sub.FirstEntry().Index())
+	})
+
+	t.Run("removed at the test end", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		sspy := tester.New(t)
+		sspy.ExpectCleanups(1)
+		sspy.Close()
+
+		tst := New(tspy)
+		sub := tst.Sub(sspy)
+
+		// --- When ---
+		sspy.Finish()
+
+		// --- Then ---
+		MustWriteLine(tst, `{"message":"msg0"}`)
+		assert.Len(t, 0, tst.subs)
+		assert.Equal(t, 0, sub.Len())
+	})
+
+	t.Run("sub-tester reports to its test", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		sspy := tester.New(t)
+		sspy.ExpectCleanups(1)
+		sspy.ExpectError()
+		sspy.ExpectLogContain("[log entry] forbidden log entry written")
+		sspy.Close()
+
+		tst := New(tspy)
+		sub := tst.Sub(sspy)
+		mcr := sub.Forbid(CheckMsg("msg1"))
+
+		// --- When ---
+		MustWriteLine(tst, `{"message":"msg1"}`)
+
+		// --- Then ---
+		assert.Equal(t, 1, mcr.Matched())
+	})
+}
+
+func Test_Tester_Len(t *testing.T) {
+	t.Run("without writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.Len()
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+	})
+
+	t.Run("with one writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write([]byte("test_0")))
+
+		// --- When ---
+		have := tst.Len()
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+	})
+
+	t.Run("with multiple writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write([]byte("test_0")))
+		must.Value(tst.Write([]byte("test_1")))
+		must.Value(tst.Write([]byte("test_2")))
+
+		// --- When ---
+		have := tst.Len()
+
+		// --- Then ---
+		assert.Equal(t, 3, have)
+	})
+}
+
+func Test_Tester_String(t *testing.T) {
+	t.Run("without writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.String()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("with writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write([]byte("test_0")))
+		must.Value(tst.Write([]byte(" test_1")))
+
+		// --- When ---
+		have := tst.String()
+
+		// --- Then ---
+		assert.Equal(t, "test_0 test_1", have)
+	})
+}
+
+func Test_Tester_Bytes(t *testing.T) {
+	t.Run("without writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.Bytes()
+
+		// --- Then ---
+		assert.Len(t, 0, have)
+	})
+
+	t.Run("with writes", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write([]byte("test_0")))
+		must.Value(tst.Write([]byte(" test_1")))
+
+		// --- When ---
+		have := tst.Bytes()
+
+		// --- Then ---
+		assert.Equal(t, []byte("test_0 test_1"), have)
+	})
+}
+
+func Test_Tester_Entries(t *testing.T) {
+	t.Run("no entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.Entries()
+
+		// --- Then ---
+		assert.NotNil(t, have)
+		assert.Len(t, 0, have.Get())
+	})
+
+	t.Run("couple of entries", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		tst := New(tspy, WithConfig(cfg))
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- When ---
+		have := tst.Entries()
+
+		// --- Then ---
+		ent := have.Entry(0)
+		assert.Same(t, cfg, ent.cfg)
+		assert.Equal(t, string(lin0), ent.String())
+		want := map[string]any{
 			"level":   "info",
 			"str":     "abc",
 			"message": "msg0",
@@ -395,60 +1398,821 @@ func Test_Tester_Entries(t *testing.T) {
 		assert.Equal(t, 0, ent.idx)
 		assert.Same(t, tspy, ent.t)
 
-		ent = have.Entry(1)
-		assert.Same(t, cfg, ent.cfg)
+		ent = have.Entry(1)
+		assert.Same(t, cfg, ent.cfg)
+		assert.Equal(t, string(lin1), ent.String())
+		want = map[string]any{
+			"level":   "info",
+			"str":     "def",
+			"message": "msg1",
+		}
+		assert.Equal(t, want, ent.m)
+		assert.Equal(t, 1, ent.idx)
+		assert.Same(t, tspy, ent.t)
+
+		ent = have.Entry(2)
+		assert.Same(t, cfg, ent.cfg)
+		assert.Equal(t, string(lin2), ent.String())
+		want = map[string]any{
+			"level":   "info",
+			"str":     "ghi",
+			"message": "msg2",
+		}
+		assert.Equal(t, want, ent.m)
+		assert.Equal(t, 2, ent.idx)
+		assert.Same(t, tspy, ent.t)
+
+		assert.Len(t, 3, have.Get())
+	})
+
+	t.Run("error - decoding", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("invalid character")
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		tst := New(tspy, WithConfig(cfg))
+		must.Value(tst.Write([]byte("{!!!}")))
+
+		// --- When ---
+		have := tst.Entries()
+
+		// --- Then ---
+		assert.Same(t, cfg, have.cfg)
+		assert.Len(t, 0, have.Get())
+		assert.Same(t, tspy, have.t)
+	})
+
+	t.Run("strict keys", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithConfig(NewConfig(WithStrictKeys())))
+		MustWriteLine(tst, `{"level":"info","message":"msg0"}`)
+
+		// --- When ---
+		have := tst.Entries()
+
+		// --- Then ---
+		assert.Len(t, 1, have.Get())
+	})
+
+	t.Run("error - strict keys with duplicate keys", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected no duplicate keys:\n" +
+			"  index: 1\n" +
+			"   keys: message"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		cfg := NewConfig(WithStrictKeys())
+		tst := New(tspy, WithConfig(cfg))
+		MustWriteLine(tst, `{"level":"info","message":"msg0"}`)
+		MustWriteLine(tst, `{"level":"info","message":"a","message":"b"}`)
+
+		// --- When ---
+		have := tst.Entries()
+
+		// --- Then ---
+		assert.Same(t, cfg, have.cfg)
+		assert.Len(t, 2, have.Get())
+		assert.Len(t, 2, tst.Entries().Get())
+	})
+
+	t.Run("error - strict keys with line decoder", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("keys: A")
+		tspy.Close()
+
+		cfg := NewConfig(WithStrictKeys(), WithLineDecoder(DockerLine))
+		tst := New(tspy, WithConfig(cfg))
+		MustWriteLine(tst, `{"A": 1, "A": 2}`)
+
+		// --- When ---
+		have := tst.Entries()
+
+		// --- Then ---
+		assert.Len(t, 1, have.Get())
+	})
+
+	t.Run("error - strict keys with loaded log", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected no duplicate keys:\n" +
+			"  index: 0\n" +
+			"   keys: A"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		cfg := NewConfig(WithStrictKeys())
+		buf := `{"A": 1, "A": 2}` + "\n"
+
+		// --- When ---
+		tst := New(tspy, WithString(buf), WithConfig(cfg))
+
+		// --- Then ---
+		assert.Len(t, 1, tst.Entries().Get())
+	})
+
+	t.Run("error - strict keys with matcher", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("keys: A")
+		tspy.Close()
+
+		cfg := NewConfig(WithStrictKeys())
+		tst := New(tspy, WithConfig(cfg))
+		mcr := NewMatcher(tspy, cfg, CheckNumber("A", 2))
+		tst.matchers = append(tst.matchers, mcr)
+
+		// --- When ---
+		MustWriteLine(tst, `{"A": 1, "A": 2}`)
+
+		// --- Then ---
+		assert.Equal(t, 1, mcr.Matched())
+	})
+}
+
+func Test_Tester_Filter(t *testing.T) {
+	t.Run("some found", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- When ---
+		ets := tst.Filter(CheckInfo())
+
+		// --- Then ---
+		assert.Same(t, tspy, ets.t)
+		assert.Len(t, 2, ets.ets)
+
+		ent := ets.ets[0]
+		assert.Equal(t, string(lin0), ent.String())
+		assert.Equal(t, 0, ent.idx)
+
+		ent = ets.ets[1]
+		assert.Equal(t, string(lin2), ent.String())
+		assert.Equal(t, 2, ent.idx)
+	})
+
+	t.Run("with absent field", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"error", "retry":true, "message":"msg0"}`)
+		lin1 := []byte(`{"level":"error", "message":"msg1"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+
+		// --- When ---
+		ets := tst.Filter(CheckError(), CheckAbsent("retry"))
+
+		// --- Then ---
+		assert.Len(t, 1, ets.ets)
+		assert.Equal(t, string(lin1), ets.ets[0].String())
+	})
+
+	t.Run("none found", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- When ---
+		ets := tst.Filter(CheckError())
+
+		// --- Then ---
+		assert.Same(t, tspy, ets.t)
+		assert.Len(t, 0, ets.ets)
+	})
+}
+
+func Test_Tester_FirstEntry(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- When ---
+		have := tst.FirstEntry()
+
+		// --- Then ---
+		assert.Equal(t, string(lin0), have.String())
+		assert.Equal(t, 0, have.idx)
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.FirstEntry()
+
+		// --- Then ---
+		assert.Zero(t, have)
+		assert.Same(t, tspy, have.t)
+	})
+}
+
+func Test_Tester_LastEntry(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- When ---
+		have := tst.LastEntry()
+
+		// --- Then ---
+		assert.Equal(t, string(lin2), have.String())
+		assert.Equal(t, 2, have.idx)
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.LastEntry()
+
+		// --- Then ---
+		assert.Zero(t, have)
+		assert.Same(t, tspy, have.t)
+	})
+}
+
+func Test_Tester_Forbid(t *testing.T) {
+	t.Run("no forbidden entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.Forbid(CheckLevel("error"))
+
+		// --- Then ---
+		must.Value(tst.Write([]byte(`{"level":"info", "message":"msg0"}`)))
+		must.Value(tst.Write([]byte(`{"level":"debug", "message":"msg1"}`)))
+		assert.Equal(t, 0, have.Matched())
+		assert.Len(t, 1, tst.forbid)
+	})
+
+	t.Run("error - forbidden entry written", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"info", "message":"msg0"}`
+		lin1 := `{"level":"error", "message":"msg1"}`
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] forbidden log entry written:\n" +
+			"  index: 1\n" +
+			"  entry: " + lin1
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.Forbid(CheckLevel("error"))
+
+		// --- Then ---
+		must.Value(tst.Write([]byte(lin0)))
+		must.Value(tst.Write([]byte(lin1)))
+		assert.Equal(t, 1, have.Matched())
+	})
+
+	t.Run("error - forbidden entry is redacted", func(t *testing.T) {
+		// --- Given ---
+		lin0 := `{"level":"error", "pass":"secret"}`
+
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] forbidden log entry written:\n" +
+			"  index: 0\n" +
+			"  entry: " + `{"level":"error","pass":"[REDACTED]"}`
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		cfg := DefaultConfig()
+		cfg.RedactFields = []string{"pass"}
+		tst := New(tspy, WithConfig(cfg))
+		tst.Forbid(CheckLevel("error"))
+
+		// --- When ---
+		must.Value(tst.Write([]byte(lin0)))
+	})
+
+	t.Run("lines written before are not checked", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write([]byte(`{"level":"error", "message":"msg0"}`)))
+
+		// --- When ---
+		have := tst.Forbid(CheckLevel("error"))
+
+		// --- Then ---
+		assert.Equal(t, 0, have.Matched())
+	})
+
+	t.Run("discarded matcher is removed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		mcr := tst.Forbid(CheckLevel("error"))
+
+		// --- When ---
+		mcr.Discard()
+
+		// --- Then ---
+		must.Value(tst.Write([]byte(`{"level":"error", "message":"msg0"}`)))
+		assert.Len(t, 0, tst.forbid)
+	})
+}
+
+func Test_Tester_Watch(t *testing.T) {
+	t.Run("existing and future entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		MustWriteLine(tst, `{"level":"error", "message":"msg0"}`)
+		MustWriteLine(tst, `{"level":"info", "message":"msg1"}`)
+		mcr := NewMatcher(tspy, nil, CheckLevel("error"))
+
+		// --- When ---
+		have := tst.Watch(mcr)
+
+		// --- Then ---
+		assert.Same(t, mcr, have)
+		MustWriteLine(tst, `{"level":"error", "message":"msg2"}`)
+		assert.Equal(t, 2, mcr.Matched())
+		assert.Equal(t, -1, tst.matchIdx)
+		assert.Len(t, 1, tst.watch)
+	})
+
+	t.Run("expect matches", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := New(tspy)
+		MustWriteLine(tst, `{"level":"error", "message":"msg0"}`)
+		mcr := NewMatcher(tspy, nil, CheckLevel("error")).ExpectMatches(2)
+
+		// --- When ---
+		tst.Watch(mcr)
+
+		// --- Then ---
+		MustWriteLine(tst, `{"level":"error", "message":"msg1"}`)
+		tspy.Finish()
+	})
+
+	t.Run("error - expect matches", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected matcher to match N times:\n" +
+			"  want: 0\n" +
+			"  have: 1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy)
+		mcr := NewMatcher(tspy, nil, CheckLevel("error")).ExpectMatches(0)
+
+		// --- When ---
+		tst.Watch(mcr)
+
+		// --- Then ---
+		MustWriteLine(tst, `{"level":"error", "message":"msg0"}`)
+		tspy.Finish()
+	})
+
+	t.Run("discarded matcher is removed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		mcr := tst.Watch(NewMatcher(tspy, nil, CheckLevel("error")))
+
+		// --- When ---
+		mcr.Discard()
+
+		// --- Then ---
+		MustWriteLine(tst, `{"level":"error", "message":"msg0"}`)
+		assert.Equal(t, 0, mcr.Matched())
+		assert.Len(t, 0, tst.watch)
+	})
+}
+
+func Test_Tester_ResetLastMatch(t *testing.T) {
+	// --- Given ---
+	tspy := tester.New(t)
+	tspy.Close()
+
+	tst := New(tspy)
+	tst.matchIdx = 3
+
+	// --- When ---
+	tst.ResetLastMatch()
+
+	// --- Then ---
+	assert.Equal(t, -1, tst.matchIdx)
+}
+
+func Test_Tester_WaitFor(t *testing.T) {
+	t.Run("success level error", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var ent Entry
+		go func() {
+			close(started)
+			chk0 := CheckLevel("debug")
+			chk1 := CheckStr("str", "abc")
+			ent = tst.WaitFor("500ms", chk0, chk1)
+			close(exited)
+		}()
+		<-started
+
+		// --- When ---
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- Then ---
+		<-exited
+		assert.Equal(t, 3, tst.cnt)
+		assert.Equal(t, 1, tst.matchIdx)
+
+		assert.Equal(t, string(lin1), ent.String())
+		assert.Same(t, tspy, ent.t)
+		assert.Equal(t, 1, ent.Index())
+	})
+
+	t.Run("match first existing", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var ent Entry
+		go func() {
+			close(started)
+			chk0 := CheckMsg("msg0")
+			ent = tst.WaitFor("500ms", chk0)
+			close(exited)
+		}()
+		<-started
+
+		// --- When ---
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- Then ---
+		<-exited
+		assert.Equal(t, 3, tst.cnt)
+		assert.Equal(t, 0, tst.matchIdx)
+
+		assert.Equal(t, string(lin0), ent.String())
+		assert.Same(t, tspy, ent.t)
+		assert.Equal(t, 0, ent.Index())
+	})
+
+	t.Run("error - wait timeout", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "timeout waiting for log entry reached:\n" +
+			"  timeout: 500ms\n" +
+			"entries logged so far:\n" +
+			"   {\"level\":\"info\", \"str\":\"abc\", \"message\":\"msg0\"}\n" +
+			"   {\"level\":\"debug\", \"str\":\"abc\", \"message\":\"msg1\"}\n" +
+			"   {\"level\":\"info\", \"str\":\"abc\", \"message\":\"msg2\"}\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var ent Entry
+		go func() {
+			close(started)
+			chk0 := CheckLevel("debug")
+			chk1 := CheckStr("str", "xyz")
+			ent = tst.WaitFor("500ms", chk0, chk1)
+			close(exited)
+		}()
+		<-started
+
+		// --- When ---
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		// --- Then ---
+		<-exited
+		assert.Zero(t, ent)
+		assert.Same(t, tspy, ent.t)
+	})
+
+	t.Run("matcher is discarded after timeout", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.IgnoreLogs()
+		tspy.Close()
+
+		tst := New(tspy)
+		tst.WaitFor("10ms", CheckMsg("msg0"))
+
+		// --- When ---
+		must.Value(tst.Write(lin0))
+
+		// --- Then ---
+		assert.Len(t, 0, tst.matchers)
+		assert.Equal(t, -1, tst.matchIdx)
+	})
+
+	t.Run("already existing", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var ent Entry
+		go func() {
+			close(started)
+			chk0 := CheckLevel("debug")
+			chk1 := CheckStr("str", "abc")
+			ent = tst.WaitFor("500ms", chk0, chk1)
+			close(exited)
+		}()
+		<-started
+
+		// --- Then ---
+		<-exited
 		assert.Equal(t, string(lin1), ent.String())
-		want = map[string]any{
-			"level":   "info",
-			"str":     "def",
-			"message": "msg1",
-		}
-		assert.Equal(t, want, ent.m)
-		assert.Equal(t, 1, ent.idx)
 		assert.Same(t, tspy, ent.t)
+		assert.Equal(t, 1, ent.Index())
+	})
+
+	t.Run("concurrent waits do not starve each other", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"info", "str":"def", "message":"msg1"}`)
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
+		tspy.Close()
 
-		ent = have.Entry(2)
-		assert.Same(t, cfg, ent.cfg)
-		assert.Equal(t, string(lin2), ent.String())
-		want = map[string]any{
-			"level":   "info",
-			"str":     "ghi",
-			"message": "msg2",
+		tst := New(tspy)
+
+		var ent0, ent1 Entry
+		done0, done1 := make(chan struct{}), make(chan struct{})
+		go func() {
+			ent1 = tst.WaitForAny("500ms", CheckMsg("msg1"))
+			close(done1)
+		}()
+		go func() {
+			ent0 = tst.WaitForAny("500ms", CheckMsg("msg0"))
+			close(done0)
+		}()
+		for registered := false; !registered; {
+			time.Sleep(time.Millisecond)
+			tst.mx.RLock()
+			registered = len(tst.matchers) == 2
+			tst.mx.RUnlock()
 		}
-		assert.Equal(t, want, ent.m)
-		assert.Equal(t, 2, ent.idx)
-		assert.Same(t, tspy, ent.t)
 
-		assert.Len(t, 3, have.Get())
+		// --- When ---
+		must.Value(tst.Write(lin0))
+
+		// --- Then ---
+		<-done0
+		assert.Equal(t, string(lin0), ent0.String())
+
+		must.Value(tst.Write(lin1))
+		<-done1
+		assert.Equal(t, string(lin1), ent1.String())
 	})
 
-	t.Run("error - decoding", func(t *testing.T) {
+	t.Run("error - order matters", func(t *testing.T) {
 		// --- Given ---
+		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
+		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
+		lin2 := []byte(`{"level":"info", "str":"def", "message":"msg2"}`)
+
 		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
 		tspy.ExpectError()
-		tspy.ExpectLogContain("invalid character")
+		wMsg := "timeout waiting for log entry reached:\n" +
+			"  timeout: 50ms\n" +
+			"entries logged so far:\n" +
+			"   {\"level\":\"info\", \"str\":\"abc\", \"message\":\"msg0\"}\n" +
+			"   {\"level\":\"debug\", \"str\":\"abc\", \"message\":\"msg1\"}\n" +
+			"   {\"level\":\"info\", \"str\":\"def\", \"message\":\"msg2\"}\n"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
-		cfg := DefaultConfig()
-		tst := New(tspy, WithConfig(cfg))
-		must.Value(tst.Write([]byte("{!!!}")))
+		tst := New(tspy)
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var ent0, ent1 Entry
+		go func() {
+			close(started)
+			// Start waiting for the last log entry.
+			chk00 := CheckLevel("info")
+			chk01 := CheckStr("str", "def")
+			ent0 = tst.WaitFor("50ms", chk00, chk01)
+
+			// Start waiting for first log entry which will fail since we
+			// always wait for the log entry after the last matched entry.
+			chk10 := CheckLevel("info")
+			chk11 := CheckStr("str", "abc")
+			ent1 = tst.WaitFor("50ms", chk10, chk11)
+			close(exited)
+		}()
+		<-started
 
 		// --- When ---
-		have := tst.Entries()
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
 
 		// --- Then ---
-		assert.Same(t, cfg, have.cfg)
-		assert.Len(t, 0, have.Get())
+		<-exited
+		assert.Equal(t, string(lin2), ent0.String())
+		assert.Same(t, tspy, ent0.t)
+		assert.Zero(t, ent1)
+		assert.Same(t, tspy, ent1.t)
+	})
+
+	t.Run("error - invalid time duration", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("time: invalid duration \"abc\"")
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.WaitFor("abc")
+
+		// --- Then ---
+		assert.Zero(t, have)
 		assert.Same(t, tspy, have.t)
 	})
 }
 
-func Test_Tester_Filter(t *testing.T) {
-	t.Run("some found", func(t *testing.T) {
+func Test_Tester_WaitForN(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+		lin0 := []byte(`{"level":"info", "message":"processed"}`)
+		lin1 := []byte(`{"level":"debug", "message":"skipped"}`)
+		lin2 := []byte(`{"level":"info", "message":"processed"}`)
+		lin3 := []byte(`{"level":"info", "message":"processed"}`)
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var ets Entries
+		go func() {
+			close(started)
+			ets = tst.WaitForN("500ms", 3, CheckMsg("processed"))
+			close(exited)
+		}()
+		<-started
+
+		// --- When ---
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+		must.Value(tst.Write(lin3))
+
+		// --- Then ---
+		<-exited
+		assert.Equal(t, 3, tst.matchIdx)
+		assert.Same(t, tspy, ets.t)
+		have := ets.Get()
+		assert.Len(t, 3, have)
+		assert.Equal(t, 0, have[0].Index())
+		assert.Equal(t, 2, have[1].Index())
+		assert.Equal(t, 3, have[2].Index())
+	})
+
+	t.Run("match existing", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "message":"processed"}`)
+		lin1 := []byte(`{"level":"info", "message":"processed"}`)
+		lin2 := []byte(`{"level":"info", "message":"processed"}`)
 
 		tspy := tester.New(t)
 		tspy.Close()
@@ -459,50 +2223,155 @@ func Test_Tester_Filter(t *testing.T) {
 		must.Value(tst.Write(lin2))
 
 		// --- When ---
-		ets := tst.Filter(CheckInfo())
+		ets := tst.WaitForN("500ms", 2, CheckMsg("processed"))
 
 		// --- Then ---
-		assert.Same(t, tspy, ets.t)
-		assert.Len(t, 2, ets.ets)
+		assert.Equal(t, 1, tst.matchIdx)
+		have := ets.Get()
+		assert.Len(t, 2, have)
+		assert.Equal(t, 0, have[0].Index())
+		assert.Equal(t, 1, have[1].Index())
+	})
 
-		ent := ets.ets[0]
-		assert.Equal(t, string(lin0), ent.String())
-		assert.Equal(t, 0, ent.idx)
+	t.Run("starts after the last match", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "message":"processed"}`)
+		lin1 := []byte(`{"level":"info", "message":"processed"}`)
 
-		ent = ets.ets[1]
-		assert.Equal(t, string(lin2), ent.String())
-		assert.Equal(t, 2, ent.idx)
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+		tst.matchIdx = 0
+
+		// --- When ---
+		ets := tst.WaitForN("500ms", 1, CheckMsg("processed"))
+
+		// --- Then ---
+		have := ets.Get()
+		assert.Len(t, 1, have)
+		assert.Equal(t, 1, have[0].Index())
 	})
 
-	t.Run("none found", func(t *testing.T) {
+	t.Run("error - timeout", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+		lin0 := []byte(`{"level":"info", "message":"processed"}`)
+		lin1 := []byte(`{"level":"info", "message":"skipped"}`)
 
 		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
+		tspy.ExpectError()
+		wMsg := "timeout waiting for log entries reached:\n" +
+			"  timeout: 50ms\n" +
+			"     want: 3\n" +
+			"     have: 1\n" +
+			"entries logged so far:\n" +
+			"   {\"level\":\"info\", \"message\":\"processed\"}\n" +
+			"   {\"level\":\"info\", \"message\":\"skipped\"}\n"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		tst := New(tspy)
 		must.Value(tst.Write(lin0))
 		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
 
 		// --- When ---
-		ets := tst.Filter(CheckError())
+		ets := tst.WaitForN("50ms", 3, CheckMsg("processed"))
 
 		// --- Then ---
-		assert.Same(t, tspy, ets.t)
-		assert.Len(t, 0, ets.ets)
+		assert.Len(t, 1, ets.Get())
+		assert.Equal(t, 0, tst.matchIdx)
+		assert.Len(t, 1, tst.seqs)
+		assert.True(t, tst.seqs[0].Discarded())
+	})
+
+	t.Run("error - invalid time duration", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("time: invalid duration \"abc\"")
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.WaitForN("abc", 1)
+
+		// --- Then ---
+		assert.Len(t, 0, have.Get())
+		assert.Same(t, tspy, have.t)
+	})
+	t.Run("error - negative number of entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected non-negative number of entries:\n" +
+			"  n: -1"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.WaitForN("1s", -1)
+
+		// --- Then ---
+		assert.Len(t, 0, have.Get())
+		assert.Same(t, tspy, have.t)
 	})
 }
 
-func Test_Tester_FirstEntry(t *testing.T) {
-	t.Run("found", func(t *testing.T) {
+func Test_Tester_WaitForSequence(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
+		lin0 := []byte(`{"level":"info", "message":"started"}`)
+		lin1 := []byte(`{"level":"info", "message":"stopped"}`)
+		lin2 := []byte(`{"level":"info", "message":"working"}`)
+		lin3 := []byte(`{"level":"info", "message":"stopped"}`)
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var ets Entries
+		go func() {
+			close(started)
+			ets = tst.WaitForSequence("500ms", [][]Checker{
+				{CheckMsg("started")},
+				{CheckMsg("working")},
+				{CheckMsg("stopped")},
+			})
+			close(exited)
+		}()
+		<-started
+
+		// --- When ---
+		must.Value(tst.Write(lin1))
+		must.Value(tst.Write(lin2))
+		must.Value(tst.Write(lin3))
+
+		// --- Then ---
+		<-exited
+		assert.Equal(t, 3, tst.matchIdx)
+		have := ets.Get()
+		assert.Len(t, 3, have)
+		assert.Equal(t, 0, have[0].Index())
+		assert.Equal(t, 2, have[1].Index())
+		assert.Equal(t, 3, have[2].Index())
+	})
+
+	t.Run("match existing", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "message":"stopped"}`)
+		lin1 := []byte(`{"level":"info", "message":"started"}`)
+		lin2 := []byte(`{"level":"info", "message":"stopped"}`)
 
 		tspy := tester.New(t)
 		tspy.Close()
@@ -513,284 +2382,263 @@ func Test_Tester_FirstEntry(t *testing.T) {
 		must.Value(tst.Write(lin2))
 
 		// --- When ---
-		have := tst.FirstEntry()
+		ets := tst.WaitForSequence("500ms", [][]Checker{
+			{CheckMsg("started")},
+			{CheckMsg("stopped")},
+		})
 
 		// --- Then ---
-		assert.Equal(t, string(lin0), have.String())
-		assert.Equal(t, 0, have.idx)
+		assert.Equal(t, 2, tst.matchIdx)
+		have := ets.Get()
+		assert.Len(t, 2, have)
+		assert.Equal(t, 1, have[0].Index())
+		assert.Equal(t, 2, have[1].Index())
 	})
 
-	t.Run("no entries", func(t *testing.T) {
+	t.Run("error - timeout", func(t *testing.T) {
+		// --- Given ---
+		lin0 := []byte(`{"level":"info", "message":"started"}`)
+		lin1 := []byte(`{"level":"info", "message":"stopped"}`)
+
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(2)
+		tspy.ExpectError()
+		wMsg := "timeout waiting for log entries sequence reached:\n" +
+			"  timeout: 50ms\n" +
+			"    steps: 3\n" +
+			"  matched: 1\n" +
+			"   step 0: 0: {\"level\":\"info\", \"message\":\"started\"}\n" +
+			"  waiting: step 1\n" +
+			"entries logged so far:\n" +
+			"   {\"level\":\"info\", \"message\":\"started\"}\n" +
+			"   {\"level\":\"info\", \"message\":\"stopped\"}\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Value(tst.Write(lin0))
+		must.Value(tst.Write(lin1))
+
+		// --- When ---
+		ets := tst.WaitForSequence("50ms", [][]Checker{
+			{CheckMsg("started")},
+			{CheckMsg("working")},
+			{CheckMsg("stopped")},
+		})
+
+		// --- Then ---
+		assert.Len(t, 1, ets.Get())
+	})
+
+	t.Run("error - invalid time duration", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("time: invalid duration \"abc\"")
+		tspy.Close()
+
+		tst := New(tspy)
+
+		// --- When ---
+		have := tst.WaitForSequence("abc", [][]Checker{{CheckMsg("A")}})
+
+		// --- Then ---
+		assert.Len(t, 0, have.Get())
+	})
+}
+
+func Test_Tester_WaitForLen(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.Close()
 
 		tst := New(tspy)
+		must.Value(tst.Write([]byte(`{"message":"msg0"}`)))
+
+		started, exited := make(chan struct{}), make(chan struct{})
+		var have bool
+		go func() {
+			close(started)
+			have = tst.WaitForLen("500ms", 3)
+			close(exited)
+		}()
+		<-started
 
 		// --- When ---
-		have := tst.FirstEntry()
+		must.Value(tst.Write([]byte(`{"message":"msg1"}`)))
+		must.Value(tst.Write([]byte(`{"message":"msg2"}`)))
 
 		// --- Then ---
-		assert.Zero(t, have)
-		assert.Same(t, tspy, have.t)
+		<-exited
+		assert.True(t, have)
+		assert.Len(t, 0, tst.waiters)
 	})
-}
 
-func Test_Tester_LastEntry(t *testing.T) {
-	t.Run("found", func(t *testing.T) {
+	t.Run("already written", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"def", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"ghi", "message":"msg2"}`)
-
 		tspy := tester.New(t)
 		tspy.Close()
 
 		tst := New(tspy)
-		must.Value(tst.Write(lin0))
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
+		must.Value(tst.Write([]byte(`{"message":"msg0"}`)))
+		must.Value(tst.Write([]byte(`{"message":"msg1"}`)))
 
 		// --- When ---
-		have := tst.LastEntry()
+		have := tst.WaitForLen("500ms", 2)
 
 		// --- Then ---
-		assert.Equal(t, string(lin2), have.String())
-		assert.Equal(t, 2, have.idx)
+		assert.True(t, have)
 	})
 
-	t.Run("no entries", func(t *testing.T) {
+	t.Run("error - timeout", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"timeout waiting for N log entries reached:\n" +
+			"  timeout: 50ms\n" +
+			"     want: 2\n" +
+			"     have: 1"
+		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		tst := New(tspy)
+		must.Value(tst.Write([]byte(`{"message":"msg0"}`)))
 
 		// --- When ---
-		have := tst.LastEntry()
+		have := tst.WaitForLen("50ms", 2)
 
 		// --- Then ---
-		assert.Zero(t, have)
-		assert.Same(t, tspy, have.t)
+		assert.False(t, have)
+		assert.Len(t, 0, tst.waiters)
 	})
-}
 
-func Test_Tester_ResetLastMatch(t *testing.T) {
-	// --- Given ---
-	tspy := tester.New(t)
-	tspy.Close()
-
-	tst := New(tspy)
-	tst.matchIdx = 3
-
-	// --- When ---
-	tst.ResetLastMatch()
-
-	// --- Then ---
-	assert.Equal(t, -1, tst.matchIdx)
-}
-
-func Test_Tester_WaitFor(t *testing.T) {
-	t.Run("success level error", func(t *testing.T) {
+	t.Run("error - invalid time duration", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
-
 		tspy := tester.New(t)
-		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogEqual("time: invalid duration \"abc\"")
 		tspy.Close()
 
 		tst := New(tspy)
 
-		started, exited := make(chan struct{}), make(chan struct{})
-		var ent Entry
-		go func() {
-			close(started)
-			chk0 := CheckLevel("debug")
-			chk1 := CheckStr("str", "abc")
-			ent = tst.WaitFor("500ms", chk0, chk1)
-			close(exited)
-		}()
-		<-started
-
 		// --- When ---
-		must.Value(tst.Write(lin0))
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
+		have := tst.WaitForLen("abc", 1)
 
 		// --- Then ---
-		<-exited
-		assert.Equal(t, 3, tst.cnt)
-		assert.Equal(t, 1, tst.matchIdx)
-
-		assert.Equal(t, string(lin1), ent.String())
-		assert.Same(t, tspy, ent.t)
-		assert.Equal(t, 1, ent.Index())
+		assert.False(t, have)
 	})
+}
 
-	t.Run("match first existing", func(t *testing.T) {
+func Test_Tester_AssertSchemaCompliant(t *testing.T) {
+	t.Run("compliant", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
-
 		tspy := tester.New(t)
 		tspy.Close()
 
 		tst := New(tspy)
-		must.Value(tst.Write(lin0))
-
-		started, exited := make(chan struct{}), make(chan struct{})
-		var ent Entry
-		go func() {
-			close(started)
-			chk0 := CheckMsg("msg0")
-			ent = tst.WaitFor("500ms", chk0)
-			close(exited)
-		}()
-		<-started
+		MustWriteLine(tst, `{"time":"T", "level":"info", "message":"msg"}`)
 
 		// --- When ---
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
+		have := tst.AssertSchemaCompliant()
 
 		// --- Then ---
-		<-exited
-		assert.Equal(t, 3, tst.cnt)
-		assert.Equal(t, 0, tst.matchIdx)
-
-		assert.Equal(t, string(lin0), ent.String())
-		assert.Same(t, tspy, ent.t)
-		assert.Equal(t, 0, ent.Index())
+		assert.True(t, have)
 	})
 
-	t.Run("error - wait timeout", func(t *testing.T) {
+	t.Run("error - missing fields", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
-
 		tspy := tester.New(t)
-		tspy.ExpectCleanups(1)
 		tspy.ExpectError()
-		wMsg := "timeout waiting for log entry reached:\n" +
-			"  timeout: 500ms\n" +
-			"entries logged so far:\n" +
-			"   {\"level\":\"info\", \"str\":\"abc\", \"message\":\"msg0\"}\n" +
-			"   {\"level\":\"debug\", \"str\":\"abc\", \"message\":\"msg1\"}\n" +
-			"   {\"level\":\"info\", \"str\":\"abc\", \"message\":\"msg2\"}\n"
+		wMsg := "" +
+			"[log entry] expected log entries to have required fields:\n" +
+			"  required: time, level, message\n" +
+			"   entry 1: time"
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		tst := New(tspy)
-
-		started, exited := make(chan struct{}), make(chan struct{})
-		var ent Entry
-		go func() {
-			close(started)
-			chk0 := CheckLevel("debug")
-			chk1 := CheckStr("str", "xyz")
-			ent = tst.WaitFor("500ms", chk0, chk1)
-			close(exited)
-		}()
-		<-started
+		MustWriteLine(tst, `{"time":"T", "level":"info", "message":"msg0"}`)
+		MustWriteLine(tst, `{"level":"info", "message":"msg1"}`)
 
 		// --- When ---
-		must.Value(tst.Write(lin0))
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
+		have := tst.AssertSchemaCompliant()
 
 		// --- Then ---
-		<-exited
-		assert.Zero(t, ent)
-		assert.Same(t, tspy, ent.t)
+		assert.False(t, have)
 	})
+}
 
-	t.Run("already existing", func(t *testing.T) {
+func Test_Tester_AssertQuiet(t *testing.T) {
+	t.Run("quiet", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"abc", "message":"msg2"}`)
-
 		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
 		tspy.Close()
 
 		tst := New(tspy)
-		must.Value(tst.Write(lin0))
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
+		must.Value(tst.Write([]byte(`{"level":"info", "message":"alert"}`)))
 
 		started, exited := make(chan struct{}), make(chan struct{})
-		var ent Entry
+		var have bool
 		go func() {
 			close(started)
-			chk0 := CheckLevel("debug")
-			chk1 := CheckStr("str", "abc")
-			ent = tst.WaitFor("500ms", chk0, chk1)
+			have = tst.AssertQuiet("50ms", CheckMsg("alert"))
 			close(exited)
 		}()
 		<-started
 
+		// --- When ---
+		must.Value(tst.Write([]byte(`{"level":"info", "message":"msg"}`)))
+
 		// --- Then ---
 		<-exited
-		assert.Equal(t, string(lin1), ent.String())
-		assert.Same(t, tspy, ent.t)
-		assert.Equal(t, 1, ent.Index())
+		assert.True(t, have)
+		assert.True(t, tst.watch[0].Discarded())
 	})
 
-	t.Run("error - order matters", func(t *testing.T) {
+	t.Run("error - matching entry written", func(t *testing.T) {
 		// --- Given ---
-		lin0 := []byte(`{"level":"info", "str":"abc", "message":"msg0"}`)
-		lin1 := []byte(`{"level":"debug", "str":"abc", "message":"msg1"}`)
-		lin2 := []byte(`{"level":"info", "str":"def", "message":"msg2"}`)
+		lin0 := `{"level":"info", "message":"alert"}`
 
 		tspy := tester.New(t)
-		tspy.ExpectCleanups(2)
+		tspy.ExpectCleanups(1)
 		tspy.ExpectError()
-		wMsg := "timeout waiting for log entry reached:\n" +
-			"  timeout: 50ms\n" +
-			"entries logged so far:\n" +
-			"   {\"level\":\"info\", \"str\":\"abc\", \"message\":\"msg0\"}\n" +
-			"   {\"level\":\"debug\", \"str\":\"abc\", \"message\":\"msg1\"}\n" +
-			"   {\"level\":\"info\", \"str\":\"def\", \"message\":\"msg2\"}\n"
+		wMsg := "" +
+			"[log entry] expected no matching log entry to be written:\n" +
+			"  duration: 1s\n" +
+			"     index: 0\n" +
+			"     entry: " + lin0
 		tspy.ExpectLogEqual(wMsg)
 		tspy.Close()
 
 		tst := New(tspy)
 
 		started, exited := make(chan struct{}), make(chan struct{})
-		var ent0, ent1 Entry
+		var have bool
 		go func() {
 			close(started)
-			// Start waiting for the last log entry.
-			chk00 := CheckLevel("info")
-			chk01 := CheckStr("str", "def")
-			ent0 = tst.WaitFor("50ms", chk00, chk01)
-
-			// Start waiting for first log entry which will fail since we
-			// always wait for the log entry after the last matched entry.
-			chk10 := CheckLevel("info")
-			chk11 := CheckStr("str", "abc")
-			ent1 = tst.WaitFor("50ms", chk10, chk11)
+			have = tst.AssertQuiet("1s", CheckMsg("alert"))
 			close(exited)
 		}()
 		<-started
+		for registered := false; !registered; {
+			time.Sleep(time.Millisecond)
+			tst.mx.RLock()
+			registered = len(tst.watch) > 0
+			tst.mx.RUnlock()
+		}
 
 		// --- When ---
-		must.Value(tst.Write(lin0))
-		must.Value(tst.Write(lin1))
-		must.Value(tst.Write(lin2))
+		must.Value(tst.Write([]byte(lin0)))
 
 		// --- Then ---
 		<-exited
-		assert.Equal(t, string(lin2), ent0.String())
-		assert.Same(t, tspy, ent0.t)
-		assert.Zero(t, ent1)
-		assert.Same(t, tspy, ent1.t)
+		assert.False(t, have)
 	})
 
-	t.Run("error - invalid time duration", func(t *testing.T) {
+	t.Run("error - invalid duration", func(t *testing.T) {
 		// --- Given ---
 		tspy := tester.New(t)
 		tspy.ExpectError()
@@ -800,11 +2648,10 @@ func Test_Tester_WaitFor(t *testing.T) {
 		tst := New(tspy)
 
 		// --- When ---
-		have := tst.WaitFor("abc")
+		have := tst.AssertQuiet("abc")
 
 		// --- Then ---
-		assert.Zero(t, have)
-		assert.Same(t, tspy, have.t)
+		assert.False(t, have)
 	})
 }
 
@@ -945,22 +2792,111 @@ func Test_Tester_Match(t *testing.T) {
 }
 
 func Test_Tester_Reset(t *testing.T) {
-	// --- Given ---
-	tspy := tester.New(t)
-	tspy.Close()
+	t.Run("reset", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
 
-	mcr := NewMatcher(t, nil, CheckLevel("info"))
+		mcr := NewMatcher(t, nil, CheckLevel("info"))
 
-	tst := New(tspy)
-	tst.matchers = append(tst.matchers, mcr)
-	MustWriteLine(tst, `{"level": "info", "A": 1}`)
-	MustWriteLine(tst, `{"level": "error", "B": 1}`)
+		tst := New(tspy)
+		tst.matchers = append(tst.matchers, mcr)
+		MustWriteLine(tst, `{"level": "info", "A": 1}`)
+		MustWriteLine(tst, `{"level": "error", "B": 1}`)
 
-	// --- When ---
-	tst.Reset()
+		// --- When ---
+		tst.Reset()
 
-	// --- Then ---
-	assert.Equal(t, 0, tst.Len())
-	assert.Equal(t, "", tst.String())
-	assert.Len(t, 0, tst.matchers)
+		// --- Then ---
+		assert.Equal(t, 0, tst.Len())
+		assert.Equal(t, "", tst.String())
+		assert.Len(t, 0, tst.matchers)
+	})
+
+	t.Run("clears fragment", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithInterleaveCheck())
+		must.Value(tst.Write([]byte(`{"level":"info",`)))
+
+		// --- When ---
+		tst.Reset()
+
+		// --- Then ---
+		assert.Nil(t, tst.frag)
+		must.Value(tst.Write([]byte(`"message":"msg0"}` + "\n")))
+	})
+
+	t.Run("clears synced and closed", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		must.Nil(tst.Sync())
+		must.Nil(tst.Close())
+
+		// --- When ---
+		tst.Reset()
+
+		// --- Then ---
+		assert.False(t, tst.synced)
+		assert.False(t, tst.closed)
+	})
+
+	t.Run("clears write count", func(t *testing.T) {
+		// --- Given ---
+		e := errors.New("sink failed")
+
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithWriteError(e, 1))
+		MustWriteLine(tst, `{"level": "info", "A": 1}`)
+
+		// --- When ---
+		tst.Reset()
+
+		// --- Then ---
+		assert.Equal(t, 0, tst.writes)
+		_, err := tst.Write([]byte(`{"level": "info", "B": 1}` + "\n"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("keeps forbidden matchers", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy)
+		mcr := tst.Forbid(CheckLevel("error"))
+
+		// --- When ---
+		tst.Reset()
+
+		// --- Then ---
+		assert.Len(t, 1, tst.forbid)
+		assert.Same(t, mcr, tst.forbid[0])
+	})
+
+	t.Run("with max entries", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		tst := New(tspy, WithMaxEntries(1))
+		MustWriteLine(tst, `{"level": "info", "A": 1}`)
+		MustWriteLine(tst, `{"level": "error", "B": 1}`)
+
+		// --- When ---
+		tst.Reset()
+
+		// --- Then ---
+		assert.Equal(t, 0, tst.Len())
+		assert.Equal(t, 0, tst.Dropped())
+		assert.Equal(t, "", tst.String())
+		assert.Len(t, 0, tst.sizes)
+	})
 }
@@ -5,6 +5,7 @@ package logkit
 
 import (
 	"io"
+	"slices"
 
 	"github.com/ctx42/testing/pkg/notice"
 	"github.com/ctx42/testing/pkg/tester"
@@ -19,16 +20,32 @@ type Trait struct {
 	// Treat logs as inspected unless there are messages with an error level.
 	ignoreNonErrors bool
 
+	// Treat logs as inspected unless there are messages with this or higher
+	// level, see [Trait.FailOnLevelAtLeast].
+	failLevel string
+
+	// Log unexamined logs instead of failing the test.
+	dump bool
+
+	// Indexes of log entries examined with [Trait.WaitFor] or [Trait.Match].
+	examined map[int]bool
+
 	// Log tester.
 	tlog *Tester
 }
 
-// NewTrait returns new instance of [Trait].
-func NewTrait(t tester.T) *Trait {
+// NewTrait returns new instance of [Trait]. The options are applied to the
+// [Tester] the logs are written to, see [New].
+//
+// Example usage:
+//
+//	tr := logkit.NewTrait(t, logkit.WithConfig(logkit.SlogConfig()))
+//	log := slog.New(slog.NewJSONHandler(tr.LogWriter(), nil))
+func NewTrait(t tester.T, opts ...func(*Tester)) *Trait {
 	t.Helper()
 
 	tr := &Trait{
-		tlog:     New(t),
+		tlog:     New(t, opts...),
 		accessed: false,
 	}
 
@@ -41,27 +58,33 @@ func NewTrait(t tester.T) *Trait {
 		if tr.accessed || n == 0 {
 			return
 		}
+		ets := tr.unexamined()
+		if len(ets) == 0 {
+			return
+		}
 
-		// Mark the test as failed only if messages with error or panic
-		// log level were logged.
-		if tr.ignoreNonErrors {
-			var hasErrors bool
-			for _, ent := range tr.tlog.Entries().Get() {
-				val, _ := HasStr(ent, tr.tlog.cfg.LevelField)
-				if val == tr.tlog.cfg.LevelErrorValue ||
-					val == tr.tlog.cfg.LevelPanicValue {
-					hasErrors = true
-					break
-				}
-			}
-			if !hasErrors {
+		// Mark the test as failed only if messages with failing log levels
+		// were logged.
+		if tr.ignoreNonErrors || tr.failLevel != "" {
+			if !slices.ContainsFunc(ets, tr.failing) {
 				return
 			}
 		}
 
+		log := tr.tlog.String()
+		if len(tr.examined) > 0 {
+			n, log = len(ets), ""
+			for _, ent := range ets {
+				log += ent.raw + "\n"
+			}
+		}
 		msg := notice.New("expected logs to be examined").
 			Append("message cnt", "%d", n).
-			Append("log", "\n%s", notice.Indent(1, ' ', tr.tlog.String()))
+			Append("log", "\n%s", notice.Indent(1, ' ', log))
+		if tr.dump {
+			t.Log(msg)
+			return
+		}
 		t.Error(msg)
 	})
 	return tr
@@ -77,6 +100,45 @@ func (tr *Trait) ExamineLog() *Tester {
 	return tr.tlog
 }
 
+// WaitFor works like [Tester.WaitFor] but marks the matched log entry as
+// examined. Unlike [Trait.ExamineLog], it doesn't mark the other log entries
+// as examined.
+func (tr *Trait) WaitFor(timeout string, checks ...Checker) Entry {
+	tr.tlog.t.Helper()
+	return tr.examine(tr.tlog.WaitFor(timeout, checks...))
+}
+
+// Match works like [Tester.Match] but marks the matched log entry as
+// examined. Unlike [Trait.ExamineLog], it doesn't mark the other log entries
+// as examined.
+func (tr *Trait) Match(mch *Matcher) Entry {
+	tr.tlog.t.Helper()
+	return tr.examine(tr.tlog.Match(mch))
+}
+
+// examine marks the log entry as examined, unless it's a zero value entry.
+func (tr *Trait) examine(ent Entry) Entry {
+	if !ent.IsZero() {
+		if tr.examined == nil {
+			tr.examined = make(map[int]bool)
+		}
+		tr.examined[ent.idx] = true
+	}
+	return ent
+}
+
+// unexamined returns log entries not examined with [Trait.WaitFor] or
+// [Trait.Match].
+func (tr *Trait) unexamined() []Entry {
+	ets := tr.tlog.Entries().Get()
+	if len(tr.examined) == 0 {
+		return ets
+	}
+	return slices.DeleteFunc(ets, func(ent Entry) bool {
+		return tr.examined[ent.idx]
+	})
+}
+
 // IgnoreLogs doesn't mark the test as failed when the logs weren't examined.
 func (tr *Trait) IgnoreLogs() *Trait {
 	tr.accessed = true
@@ -90,9 +152,49 @@ func (tr *Trait) IgnoreNonErrorLogs() *Trait {
 	return tr
 }
 
-// ResetLog deletes all logged messages and resets the accessed flag.
+// FailOnLevelAtLeast doesn't mark the test as failed when the logs weren't
+// examined, and there are no log messages with the given or higher log level.
+// The level must be one of the configured level values, like
+// [Config.LevelWarnValue]; otherwise, the test is marked as failed.
+func (tr *Trait) FailOnLevelAtLeast(level string) *Trait {
+	tr.tlog.t.Helper()
+	if tr.tlog.cfg.levelRank(level) < 0 {
+		msg := notice.New("[log entry] expected configured log level").
+			Append("level", "%s", level).
+			Wrap(ErrValue)
+		tr.tlog.t.Error(msg)
+		return tr
+	}
+	tr.failLevel = level
+	return tr
+}
+
+// failing returns true if the log entry level fails the test when the logs
+// weren't examined.
+func (tr *Trait) failing(ent Entry) bool {
+	cfg := tr.tlog.cfg
+	val, _ := HasLevel(ent)
+	if tr.failLevel != "" {
+		rank := cfg.levelRank(val)
+		return rank >= 0 && rank >= cfg.levelRank(tr.failLevel)
+	}
+	return val == cfg.LevelErrorValue || val == cfg.LevelPanicValue
+}
+
+// DumpUnexamined doesn't mark the test as failed when the logs weren't
+// examined, but writes them to the test log instead, which is visible only
+// for failed tests or in the verbose mode. It allows adopting the [Trait]
+// gradually in large codebases.
+func (tr *Trait) DumpUnexamined() *Trait {
+	tr.dump = true
+	return tr
+}
+
+// ResetLog deletes all logged messages and resets the accessed flag and the
+// examined log entries.
 func (tr *Trait) ResetLog() *Trait {
 	tr.accessed = false
+	tr.examined = nil
 	tr.tlog.Reset()
 	return tr
 }
@@ -43,6 +43,22 @@ func Test_NewTrait(t *testing.T) {
 		MustWriteLine(tr.tlog, `{"level":"debug","message":"msg0"}`)
 		MustWriteLine(tr.tlog, `{"level":"info","message":"msg1"}`)
 	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		cfg := ZapConfig()
+
+		// --- When ---
+		tr := NewTrait(tspy, WithConfig(cfg), WithMaxEntries(1))
+
+		// --- Then ---
+		assert.Same(t, cfg, tr.tlog.cfg)
+		assert.Equal(t, 1, tr.tlog.maxEnts)
+	})
 }
 
 func Test_Trait_LogWriter(t *testing.T) {
@@ -80,6 +96,118 @@ func Test_Trait_ExamineLog(t *testing.T) {
 	assert.Len(t, 2, ets.Get())
 }
 
+func Test_Trait_WaitFor(t *testing.T) {
+	t.Run("all entries examined", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg0"}`)
+
+		// --- When ---
+		have := tr.WaitFor("1s", CheckMsg("msg0"))
+
+		// --- Then ---
+		assert.Equal(t, `{"level":"info","message":"msg0"}`, have.String())
+	})
+
+	t.Run("error - other entries not examined", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"expected logs to be examined:\n" +
+			"  message cnt: 1\n" +
+			"          log:\n" +
+			"                {\"level\":\"info\",\"message\":\"msg1\"}\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg0"}`)
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg1"}`)
+
+		// --- When ---
+		have := tr.WaitFor("1s", CheckMsg("msg0"))
+
+		// --- Then ---
+		assert.Equal(t, 0, have.Index())
+	})
+
+	t.Run("error - not examined with error level", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("message cnt: 1")
+		tspy.Close()
+
+		tr := NewTrait(tspy).IgnoreNonErrorLogs()
+		MustWriteLine(tr.tlog, `{"level":"error","message":"msg0"}`)
+		MustWriteLine(tr.tlog, `{"level":"error","message":"msg1"}`)
+
+		// --- When ---
+		tr.WaitFor("1s", CheckMsg("msg0"))
+	})
+
+	t.Run("examined entry with error level", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tr := NewTrait(tspy).IgnoreNonErrorLogs()
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg0"}`)
+		MustWriteLine(tr.tlog, `{"level":"error","message":"msg1"}`)
+
+		// --- When ---
+		have := tr.WaitFor("1s", CheckMsg("msg1"))
+
+		// --- Then ---
+		assert.Equal(t, 1, have.Index())
+	})
+}
+
+func Test_Trait_Match(t *testing.T) {
+	t.Run("all entries examined", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg0"}`)
+
+		// --- When ---
+		have := tr.Match(NewMatcher(tspy, nil, CheckMsg("msg0")))
+
+		// --- Then ---
+		assert.Equal(t, `{"level":"info","message":"msg0"}`, have.String())
+	})
+
+	t.Run("error - not matched", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		tspy.ExpectLogContain("log entry not found")
+		tspy.ExpectLogContain("expected logs to be examined")
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg0"}`)
+
+		// --- When ---
+		have := tr.Match(NewMatcher(tspy, nil, CheckMsg("msg1")))
+
+		// --- Then ---
+		assert.True(t, have.IsZero())
+	})
+}
+
 func Test_Trait_IgnoreLogs(t *testing.T) {
 	// --- Given ---
 	tspy := tester.New(t)
@@ -144,6 +272,112 @@ func Test_Trait_IgnoreNonErrorLogs(t *testing.T) {
 	})
 }
 
+func Test_Trait_FailOnLevelAtLeast(t *testing.T) {
+	t.Run("error - with level at least", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"expected logs to be examined:\n" +
+			"  message cnt: 2\n" +
+			"          log:\n" +
+			"                {\"level\":\"info\",\"message\":\"msg0\"}\n" +
+			"                {\"level\":\"warn\",\"message\":\"msg1\"}\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg0"}`)
+		MustWriteLine(tr.tlog, `{"level":"warn","message":"msg1"}`)
+
+		// --- When ---
+		have := tr.FailOnLevelAtLeast("warn")
+
+		// --- Then ---
+		assert.Same(t, tr, have)
+	})
+
+	t.Run("below level", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+		MustWriteLine(tr.tlog, `{"level":"debug","message":"msg0"}`)
+		MustWriteLine(tr.tlog, `{"level":"info","message":"msg1"}`)
+		MustWriteLine(tr.tlog, `{"message":"msg2"}`)
+
+		// --- When ---
+		have := tr.FailOnLevelAtLeast("warn")
+
+		// --- Then ---
+		assert.Same(t, tr, have)
+	})
+
+	t.Run("error - unknown level", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[log entry] expected configured log level:\n" +
+			"  level: warning"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+
+		// --- When ---
+		have := tr.FailOnLevelAtLeast("warning")
+
+		// --- Then ---
+		assert.Same(t, tr, have)
+		assert.Equal(t, "", tr.failLevel)
+	})
+}
+
+func Test_Trait_DumpUnexamined(t *testing.T) {
+	t.Run("logs not examined", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		wMsg := "" +
+			"expected logs to be examined:\n" +
+			"  message cnt: 1\n" +
+			"          log:\n" +
+			"                {\"level\":\"error\",\"message\":\"msg0\"}\n"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		tr := NewTrait(tspy)
+		MustWriteLine(tr.tlog, `{"level":"error","message":"msg0"}`)
+
+		// --- When ---
+		have := tr.DumpUnexamined()
+
+		// --- Then ---
+		assert.Same(t, tr, have)
+	})
+
+	t.Run("logs examined", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectCleanups(1)
+		tspy.Close()
+
+		tr := NewTrait(tspy).DumpUnexamined()
+		MustWriteLine(tr.tlog, `{"level":"error","message":"msg0"}`)
+
+		// --- When ---
+		have := tr.ExamineLog()
+
+		// --- Then ---
+		assert.Same(t, tr.tlog, have)
+	})
+}
+
 func Test_Trait_ResetLog(t *testing.T) {
 	// --- Given ---
 	tspy := tester.New(t)
@@ -159,5 +393,6 @@ func Test_Trait_ResetLog(t *testing.T) {
 
 	// --- Then ---
 	assert.Same(t, tr, have)
+	assert.Nil(t, tr.examined)
 	assert.Len(t, 0, tr.ExamineLog().Entries().Get())
 }